@@ -0,0 +1,97 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// AuditCommand records a command received at the specified time in the audit trail, when the audit is enabled.  The
+// record is completed with the time and the latency of the command, and is persisted asynchronously so that the command
+// response isn't delayed by the database.
+func AuditCommand(record models.CommandAuditRecord, received time.Time, dic *di.Container) {
+	dbClient := commandContainer.DBClientFrom(dic.Get)
+	auditInfo := commandContainer.ConfigurationFrom(dic.Get).Audit
+	if dbClient == nil || !auditInfo.Enabled {
+		return
+	}
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	maxCount := auditInfo.MaxCount
+
+	record.Created = received.UnixMilli()
+	record.Latency = time.Since(received).Milliseconds()
+	go func() {
+		if _, err := dbClient.AddCommandAuditRecord(record); err != nil {
+			lc.Errorf("failed to record the %s command %s of device %s in the audit trail: %v", record.Method, record.CommandName, record.DeviceName, err)
+			return
+		}
+		if maxCount <= 0 {
+			return
+		}
+		if err := dbClient.TrimCommandAuditRecords(maxCount); err != nil {
+			lc.Errorf("failed to trim the command audit trail: %v", err)
+		}
+	}()
+}
+
+// AuditedDeviceCommandIssuer returns the DeviceCommandIssuer recording each command issued by issue in the audit
+// trail, the records being completed from the template record
+func AuditedDeviceCommandIssuer(issue DeviceCommandIssuer, template models.CommandAuditRecord, dic *di.Container) DeviceCommandIssuer {
+	return func(ctx context.Context, device dtos.Device, service dtos.DeviceService, request pkgDtos.BatchCommandRequest) (*dtos.Event, errors.EdgeX) {
+		received := time.Now()
+		record := template
+		record.DeviceName = device.Name
+		record.CommandName = request.CommandName
+		record.Method = request.Method
+		record.QueryParams = request.QueryParams
+		record.Settings = request.Settings
+
+		event, err := issue(ctx, device, service, request)
+		SetCommandAuditResult(&record, err)
+		AuditCommand(record, received, dic)
+		return event, err
+	}
+}
+
+// SetCommandAuditResult sets the status code and the message of an audit record from the error a command failed with,
+// or to success when err is nil
+func SetCommandAuditResult(record *models.CommandAuditRecord, err errors.EdgeX) {
+	if err == nil {
+		record.StatusCode = http.StatusOK
+		record.Message = ""
+		return
+	}
+	record.StatusCode = err.Code()
+	record.Message = err.Error()
+}
+
+// CommandAuditRecordsByTimeRange queries the command audit records of a device, or of all devices when deviceName is
+// empty, by time range, offset, and limit
+func CommandAuditRecordsByTimeRange(deviceName string, start int, end int, offset int, limit int, dic *di.Container) (records []pkgDtos.CommandAuditRecord, totalCount uint32, err errors.EdgeX) {
+	dbClient := commandContainer.DBClientFrom(dic.Get)
+	if dbClient == nil || !commandContainer.ConfigurationFrom(dic.Get).Audit.Enabled {
+		return nil, 0, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "the command audit is disabled", nil)
+	}
+	auditRecords, totalCount, err := dbClient.CommandAuditRecordsByTimeRange(deviceName, start, end, offset, limit)
+	if err != nil {
+		return nil, 0, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query command audit records of device '%s'", deviceName), err)
+	}
+	records = make([]pkgDtos.CommandAuditRecord, len(auditRecords))
+	for i, r := range auditRecords {
+		records[i] = pkgDtos.FromCommandAuditRecordModelToDTO(r)
+	}
+	return records, totalCount, nil
+}
@@ -0,0 +1,104 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+func newAuditMockDIC(dbClient *dbMock.DBClient) *di.Container {
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		commandContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{Audit: config.AuditInfo{Enabled: true, MaxCount: 100}}
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+	})
+	if dbClient != nil {
+		dic.Update(di.ServiceConstructorMap{
+			commandContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+				return dbClient
+			},
+		})
+	}
+	return dic
+}
+
+func TestAuditCommand(t *testing.T) {
+	received := time.Now().Add(-10 * time.Millisecond)
+	record := models.CommandAuditRecord{
+		DeviceName:  testDeviceName,
+		CommandName: command1,
+		Method:      "set",
+		Settings:    map[string]any{resource1: "value"},
+		Caller:      "operator",
+		Transport:   models.CommandAuditTransportREST,
+	}
+	SetCommandAuditResult(&record, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device not found", nil))
+
+	persistedRecords := make(chan models.CommandAuditRecord, 1)
+	trimmed := make(chan struct{})
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddCommandAuditRecord", mock.Anything).Return(models.CommandAuditRecord{}, nil).Run(func(args mock.Arguments) {
+		persistedRecords <- args.Get(0).(models.CommandAuditRecord)
+	})
+	dbClientMock.On("TrimCommandAuditRecords", 100).Return(nil).Run(func(args mock.Arguments) {
+		close(trimmed)
+	})
+	AuditCommand(record, received, newAuditMockDIC(dbClientMock))
+
+	var persisted models.CommandAuditRecord
+	select {
+	case persisted = <-persistedRecords:
+	case <-time.After(time.Second):
+		require.Fail(t, "the audit record was not persisted")
+	}
+	select {
+	case <-trimmed:
+	case <-time.After(time.Second):
+		require.Fail(t, "the audit records were not trimmed")
+	}
+	assert.Equal(t, received.UnixMilli(), persisted.Created)
+	assert.GreaterOrEqual(t, persisted.Latency, int64(10))
+	assert.Equal(t, http.StatusNotFound, persisted.StatusCode)
+	assert.Contains(t, persisted.Message, "device not found")
+	assert.Equal(t, "operator", persisted.Caller)
+
+	// the audit is a no-op when it is disabled
+	assert.NotPanics(t, func() {
+		AuditCommand(record, received, newAuditMockDIC(nil))
+	})
+}
+
+func TestCommandAuditRecordsByTimeRange(t *testing.T) {
+	records := []models.CommandAuditRecord{{Id: "id1", DeviceName: testDeviceName, StatusCode: http.StatusOK}}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("CommandAuditRecordsByTimeRange", testDeviceName, 0, 100, 0, 20).Return(records, uint32(1), nil)
+
+	result, totalCount, err := CommandAuditRecordsByTimeRange(testDeviceName, 0, 100, 0, 20, newAuditMockDIC(dbClientMock))
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), totalCount)
+	require.Len(t, result, 1)
+	assert.Equal(t, "id1", result[0].Id)
+
+	_, _, err = CommandAuditRecordsByTimeRange(testDeviceName, 0, 100, 0, 20, newAuditMockDIC(nil))
+	require.Error(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, err.Code())
+}
@@ -0,0 +1,234 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sync"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+)
+
+const defaultBatchMaxParallelism = 10
+
+// DeviceCommandIssuer issues the command of a batch request to one device of the batch, the device service managing
+// the device being given.  It returns the event read by a get command, which is nil when no event is returned.
+type DeviceCommandIssuer func(ctx context.Context, device dtos.Device, service dtos.DeviceService, request pkgDtos.BatchCommandRequest) (*dtos.Event, errors.EdgeX)
+
+// HttpDeviceCommandIssuer returns the DeviceCommandIssuer issuing the commands through the REST API of the device services
+func HttpDeviceCommandIssuer(dic *di.Container) DeviceCommandIssuer {
+	return func(ctx context.Context, device dtos.Device, service dtos.DeviceService, request pkgDtos.BatchCommandRequest) (*dtos.Event, errors.EdgeX) {
+		dscc := bootstrapContainer.DeviceServiceCommandClientFrom(dic.Get)
+		if dscc == nil {
+			return nil, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceServiceCommandClient returned", nil)
+		}
+		queryParams := url.Values{}
+		for k, v := range request.QueryParams {
+			queryParams.Set(k, v)
+		}
+
+		if request.Method == pkgDtos.BatchCommandMethodSet {
+			_, err := dscc.SetCommandWithObject(ctx, service.BaseAddress, device.Name, request.CommandName, queryParams.Encode(), request.Settings)
+			if err != nil {
+				return nil, errors.NewCommonEdgeXWrapper(err)
+			}
+			return nil, nil
+		}
+		res, err := dscc.GetCommand(ctx, service.BaseAddress, device.Name, request.CommandName, queryParams.Encode())
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		if res == nil {
+			return nil, nil
+		}
+		return &res.Event, nil
+	}
+}
+
+// ValidatingDeviceCommandIssuer returns the DeviceCommandIssuer validating the settings of each set command against the
+// profile of the device before it is issued by issue
+func ValidatingDeviceCommandIssuer(issue DeviceCommandIssuer, dic *di.Container) DeviceCommandIssuer {
+	return func(ctx context.Context, device dtos.Device, service dtos.DeviceService, request pkgDtos.BatchCommandRequest) (*dtos.Event, errors.EdgeX) {
+		if request.Method == pkgDtos.BatchCommandMethodSet {
+			if err := validateDeviceSetCommandSettings(ctx, device, request.CommandName, request.Settings, dic); err != nil {
+				return nil, err
+			}
+		}
+		return issue(ctx, device, service, request)
+	}
+}
+
+// IssueBatchCommand issues the command of the request to each device it selects, at most BatchCommand.MaxParallelism
+// devices at a time, and returns the result of the command for each device in the order the devices were selected.
+// The command failing for some devices doesn't fail the batch; an error is only returned when the devices can't be
+// selected.
+func IssueBatchCommand(ctx context.Context, request pkgDtos.BatchCommandRequest, issue DeviceCommandIssuer, dic *di.Container) ([]pkgDtos.BatchCommandResult, errors.EdgeX) {
+	if err := request.Validate(); err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	dc := bootstrapContainer.DeviceClientFrom(dic.Get)
+	if dc == nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceClient returned", nil)
+	}
+	dsc := bootstrapContainer.DeviceServiceClientFrom(dic.Get)
+	if dsc == nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceServiceClient returned", nil)
+	}
+
+	// the devices selected by name are retrieved concurrently along with the commands being issued
+	var devices []dtos.Device
+	switch {
+	case request.ProfileName != "":
+		var err errors.EdgeX
+		devices, err = allDevices(func(offset int) (responses.MultiDevicesResponse, errors.EdgeX) {
+			return dc.DevicesByProfileName(ctx, request.ProfileName, offset, -1)
+		})
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+	case len(request.Labels) > 0:
+		var err errors.EdgeX
+		devices, err = allDevices(func(offset int) (responses.MultiDevicesResponse, errors.EdgeX) {
+			return dc.AllDevices(ctx, request.Labels, offset, -1)
+		})
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+	case request.DeviceGroupName != "":
+		dgc := commandContainer.DeviceGroupClientFrom(dic.Get)
+		if dgc == nil {
+			return nil, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceGroupClient returned", nil)
+		}
+		var err errors.EdgeX
+		devices, err = allDevices(func(offset int) (responses.MultiDevicesResponse, errors.EdgeX) {
+			return dgc.DevicesByDeviceGroupName(ctx, request.DeviceGroupName, offset, -1)
+		})
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+	default:
+		devices = make([]dtos.Device, len(request.DeviceNames))
+		for i, name := range request.DeviceNames {
+			devices[i].Name = name
+		}
+	}
+
+	parallelism := commandContainer.ConfigurationFrom(dic.Get).BatchCommand.MaxParallelism
+	if parallelism <= 0 {
+		parallelism = defaultBatchMaxParallelism
+	}
+	services := &deviceServiceCache{dsc: dsc, entries: make(map[string]*deviceServiceEntry)}
+	results := make([]pkgDtos.BatchCommandResult, len(devices))
+	semaphore := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+	for i := range devices {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			results[i] = issueDeviceCommand(ctx, devices[i], request, issue, dc, services)
+		}(i)
+	}
+	wg.Wait()
+
+	return results, nil
+}
+
+// allDevices queries the selected devices page by page from the offset of the next page, as core-metadata returns at
+// most its MaxResultCount devices per query, until the total count of the selected devices is reached.  A device
+// moved between the pages by a concurrent change is only selected once.
+func allDevices(query func(offset int) (responses.MultiDevicesResponse, errors.EdgeX)) ([]dtos.Device, errors.EdgeX) {
+	var devices []dtos.Device
+	selected := make(map[string]bool)
+	for offset := 0; ; {
+		res, err := query(offset)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		for _, device := range res.Devices {
+			if !selected[device.Name] {
+				selected[device.Name] = true
+				devices = append(devices, device)
+			}
+		}
+		offset += len(res.Devices)
+		if len(res.Devices) == 0 || offset >= int(res.TotalCount) {
+			return devices, nil
+		}
+	}
+}
+
+func issueDeviceCommand(ctx context.Context, device dtos.Device, request pkgDtos.BatchCommandRequest, issue DeviceCommandIssuer, dc interfaces.DeviceClient, services *deviceServiceCache) pkgDtos.BatchCommandResult {
+	result := pkgDtos.BatchCommandResult{DeviceName: device.Name}
+	fail := func(err errors.EdgeX) pkgDtos.BatchCommandResult {
+		result.StatusCode = err.Code()
+		result.Message = err.Error()
+		return result
+	}
+
+	if device.ServiceName == "" {
+		res, err := dc.DeviceByName(ctx, device.Name)
+		if err != nil {
+			return fail(err)
+		}
+		device = res.Device
+	}
+	service, err := services.get(ctx, device.ServiceName)
+	if err != nil {
+		return fail(err)
+	}
+	event, err := issue(ctx, device, service, request)
+	if err != nil {
+		return fail(err)
+	}
+	result.StatusCode = http.StatusOK
+	result.Event = event
+	return result
+}
+
+// deviceServiceCache retrieves each device service once per batch, however many of its devices the batch selects
+type deviceServiceCache struct {
+	dsc     interfaces.DeviceServiceClient
+	mutex   sync.Mutex
+	entries map[string]*deviceServiceEntry
+}
+
+type deviceServiceEntry struct {
+	once    sync.Once
+	service dtos.DeviceService
+	err     errors.EdgeX
+}
+
+func (c *deviceServiceCache) get(ctx context.Context, name string) (dtos.DeviceService, errors.EdgeX) {
+	c.mutex.Lock()
+	entry, ok := c.entries[name]
+	if !ok {
+		entry = &deviceServiceEntry{}
+		c.entries[name] = entry
+	}
+	c.mutex.Unlock()
+
+	entry.once.Do(func() {
+		res, err := c.dsc.DeviceServiceByName(ctx, name)
+		if err != nil {
+			entry.err = errors.NewCommonEdgeXWrapper(err)
+			return
+		}
+		entry.service = res.Service
+	})
+	return entry.service, entry.err
+}
@@ -0,0 +1,128 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	commandMocks "github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces/mocks"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+)
+
+func TestIssueBatchCommand(t *testing.T) {
+	const (
+		testProfileName = "testProfile"
+		testServiceName = "testService"
+		testGroupName   = "testGroup"
+		missingDevice   = "missingDevice"
+		failingDevice   = "failingDevice"
+	)
+	devices := []dtos.Device{
+		{Name: "device1", ProfileName: testProfileName, ServiceName: testServiceName},
+		{Name: "device2", ProfileName: testProfileName, ServiceName: testServiceName},
+		{Name: failingDevice, ProfileName: testProfileName, ServiceName: testServiceName},
+	}
+
+	dcMock := &mocks.DeviceClient{}
+	for _, device := range devices {
+		dcMock.On("DeviceByName", mock.Anything, device.Name).Return(responses.DeviceResponse{Device: device}, nil)
+	}
+	dcMock.On("DeviceByName", mock.Anything, missingDevice).Return(responses.DeviceResponse{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	// core-metadata returns the devices of the profile in several pages
+	dcMock.On("DevicesByProfileName", mock.Anything, testProfileName, 0, -1).Return(responses.NewMultiDevicesResponse("", "", http.StatusOK, 3, devices[:2]), nil)
+	dcMock.On("DevicesByProfileName", mock.Anything, testProfileName, 2, -1).Return(responses.NewMultiDevicesResponse("", "", http.StatusOK, 3, devices[2:]), nil)
+	dgcMock := &commandMocks.DeviceGroupClient{}
+	dgcMock.On("DevicesByDeviceGroupName", mock.Anything, testGroupName, 0, -1).Return(responses.NewMultiDevicesResponse("", "", http.StatusOK, 2, devices[:1]), nil)
+	dgcMock.On("DevicesByDeviceGroupName", mock.Anything, testGroupName, 1, -1).Return(responses.NewMultiDevicesResponse("", "", http.StatusOK, 2, devices[1:2]), nil)
+	dscMock := &mocks.DeviceServiceClient{}
+	dscMock.On("DeviceServiceByName", mock.Anything, testServiceName).Return(responses.DeviceServiceResponse{Service: dtos.DeviceService{Name: testServiceName}}, nil)
+
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		commandContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{BatchCommand: config.BatchCommandInfo{MaxParallelism: 2}}
+		},
+		bootstrapContainer.DeviceClientName: func(get di.Get) interface{} {
+			return dcMock
+		},
+		bootstrapContainer.DeviceServiceClientName: func(get di.Get) interface{} {
+			return dscMock
+		},
+		commandContainer.DeviceGroupClientName: func(get di.Get) interface{} {
+			return dgcMock
+		},
+	})
+
+	var running, maxRunning int32
+	var mutex sync.Mutex
+	issuer := func(ctx context.Context, device dtos.Device, service dtos.DeviceService, request pkgDtos.BatchCommandRequest) (*dtos.Event, errors.EdgeX) {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		mutex.Lock()
+		if n > maxRunning {
+			maxRunning = n
+		}
+		mutex.Unlock()
+		if device.Name == failingDevice {
+			return nil, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "device unreachable", nil)
+		}
+		return &dtos.Event{DeviceName: device.Name, SourceName: request.CommandName}, nil
+	}
+
+	tests := []struct {
+		name                string
+		request             pkgDtos.BatchCommandRequest
+		errorExpected       bool
+		expectedStatusCodes []int
+	}{
+		{"valid - by device names", pkgDtos.BatchCommandRequest{DeviceNames: []string{"device1", missingDevice, failingDevice}, CommandName: "cmd", Method: "GET"}, false,
+			[]int{http.StatusOK, http.StatusNotFound, http.StatusServiceUnavailable}},
+		{"valid - by profile name", pkgDtos.BatchCommandRequest{ProfileName: testProfileName, CommandName: "cmd", Method: "get"}, false,
+			[]int{http.StatusOK, http.StatusOK, http.StatusServiceUnavailable}},
+		{"valid - by device group name", pkgDtos.BatchCommandRequest{DeviceGroupName: testGroupName, CommandName: "cmd", Method: "get"}, false,
+			[]int{http.StatusOK, http.StatusOK}},
+		{"invalid - several selectors", pkgDtos.BatchCommandRequest{DeviceNames: []string{"device1"}, ProfileName: testProfileName, CommandName: "cmd", Method: "get"}, true, nil},
+		{"invalid - no selector", pkgDtos.BatchCommandRequest{CommandName: "cmd", Method: "get"}, true, nil},
+		{"invalid - unknown method", pkgDtos.BatchCommandRequest{ProfileName: testProfileName, CommandName: "cmd", Method: "put"}, true, nil},
+		{"invalid - set without settings", pkgDtos.BatchCommandRequest{ProfileName: testProfileName, CommandName: "cmd", Method: "set"}, true, nil},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			results, err := IssueBatchCommand(context.Background(), testCase.request, issuer, dic)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, http.StatusBadRequest, err.Code())
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, results, len(testCase.expectedStatusCodes))
+			for i, result := range results {
+				assert.Equal(t, testCase.expectedStatusCodes[i], result.StatusCode, result.DeviceName)
+				if result.StatusCode == http.StatusOK {
+					require.NotNil(t, result.Event)
+					assert.Equal(t, result.DeviceName, result.Event.DeviceName)
+				} else {
+					assert.NotEmpty(t, result.Message)
+				}
+			}
+		})
+	}
+	assert.LessOrEqual(t, maxRunning, int32(2))
+	dscMock.AssertNumberOfCalls(t, "DeviceServiceByName", 3)
+}
@@ -218,6 +218,11 @@ func IssueGetCommandByName(deviceName string, commandName string, queryParams st
 	if dscc == nil {
 		return res, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceServiceCommandClient returned", nil)
 	}
+	release, err := AcquireDeviceCommand(context.Background(), deviceResponse.Device, dic)
+	if err != nil {
+		return res, err
+	}
+	defer release()
 	res, err = dscc.GetCommand(context.Background(), deviceServiceResponse.Service.BaseAddress, deviceName, commandName, queryParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
@@ -227,7 +232,8 @@ func IssueGetCommandByName(deviceName string, commandName string, queryParams st
 }
 
 // IssueSetCommandByName issues the specified set(write) command referenced by the command name to the device/sensor, also
-// referenced by name.
+// referenced by name.  The settings are validated against the device profile first, a SettingsValidationError being
+// returned when some settings are invalid.
 func IssueSetCommandByName(deviceName string, commandName string, queryParams string, settings map[string]interface{}, dic *di.Container) (response commonDTO.BaseResponse, err errors.EdgeX) {
 	if deviceName == "" {
 		return response, errors.NewCommonEdgeX(errors.KindContractInvalid, "device name cannot be empty", nil)
@@ -247,6 +253,12 @@ func IssueSetCommandByName(deviceName string, commandName string, queryParams st
 		return response, errors.NewCommonEdgeXWrapper(err)
 	}
 
+	// validate the settings against the device profile rather than letting the device service fail on them
+	err = validateDeviceSetCommandSettings(context.Background(), deviceResponse.Device, commandName, settings, dic)
+	if err != nil {
+		return response, err
+	}
+
 	// retrieve device service information through Metadata DeviceClient
 	dsc := bootstrapContainer.DeviceServiceClientFrom(dic.Get)
 	if dsc == nil {
@@ -262,5 +274,10 @@ func IssueSetCommandByName(deviceName string, commandName string, queryParams st
 	if dscc == nil {
 		return response, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceServiceCommandClient returned", nil)
 	}
+	release, err := AcquireDeviceCommand(context.Background(), deviceResponse.Device, dic)
+	if err != nil {
+		return response, err
+	}
+	defer release()
 	return dscc.SetCommandWithObject(context.Background(), deviceServiceResponse.Service.BaseAddress, deviceName, commandName, queryParams, settings)
 }
@@ -0,0 +1,182 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// AddCommandJob validates the command of the new job against its device, persists the job and schedules its first run
+func AddCommandJob(job models.CommandJob, ctx context.Context, dic *di.Container) (id string, edgeXerr errors.EdgeX) {
+	dbClient := commandContainer.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	if job.Method == pkgDtos.BatchCommandMethodSet {
+		edgeXerr = ValidateSetCommandSettings(ctx, job.DeviceName, job.CommandName, job.Settings, dic)
+	} else {
+		edgeXerr = deviceExists(ctx, job.DeviceName, dic)
+	}
+	if edgeXerr != nil {
+		return "", edgeXerr
+	}
+
+	now := time.Now()
+	if job.Start == 0 {
+		job.Start = now.UnixMilli()
+	}
+	nextRun, ok := CommandJobNextRun(job, now)
+	if !ok {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("command job %s ends before its next run", job.Name), nil)
+	}
+	job.Status = models.CommandJobStatusScheduled
+	job.NextRun = nextRun
+
+	addedJob, edgeXerr := dbClient.AddCommandJob(job)
+	if edgeXerr != nil {
+		return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	edgeXerr = commandContainer.CommandJobSchedulerFrom(dic.Get).Schedule(addedJob)
+	if edgeXerr != nil {
+		return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	lc.Debugf("CommandJob created on DB successfully. CommandJob ID: %s, Correlation-ID: %s ",
+		addedJob.Id,
+		correlation.FromContext(ctx))
+
+	return addedJob.Id, nil
+}
+
+func deviceExists(ctx context.Context, deviceName string, dic *di.Container) errors.EdgeX {
+	dc := bootstrapContainer.DeviceClientFrom(dic.Get)
+	if dc == nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceClient returned", nil)
+	}
+	if _, err := dc.DeviceByName(ctx, deviceName); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// CommandJobByName query the command job by name
+func CommandJobByName(name string, dic *di.Container) (dto pkgDtos.CommandJob, edgeXerr errors.EdgeX) {
+	if name == "" {
+		return dto, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := commandContainer.DBClientFrom(dic.Get)
+	job, edgeXerr := dbClient.CommandJobByName(name)
+	if edgeXerr != nil {
+		return dto, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return pkgDtos.FromCommandJobModelToDTO(job), nil
+}
+
+// AllCommandJobs query the command jobs with offset and limit
+func AllCommandJobs(offset int, limit int, dic *di.Container) (jobDTOs []pkgDtos.CommandJob, totalCount uint32, edgeXerr errors.EdgeX) {
+	dbClient := commandContainer.DBClientFrom(dic.Get)
+	jobs, edgeXerr := dbClient.AllCommandJobs(offset, limit)
+	if edgeXerr == nil {
+		totalCount, edgeXerr = dbClient.CommandJobTotalCount()
+	}
+	if edgeXerr != nil {
+		return jobDTOs, totalCount, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	jobDTOs = make([]pkgDtos.CommandJob, len(jobs))
+	for i, job := range jobs {
+		jobDTOs[i] = pkgDtos.FromCommandJobModelToDTO(job)
+	}
+	return jobDTOs, totalCount, nil
+}
+
+// DeleteCommandJobByName deletes the command job by name, cancelling its next run
+func DeleteCommandJobByName(name string, ctx context.Context, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := commandContainer.DBClientFrom(dic.Get)
+	edgeXerr := dbClient.DeleteCommandJobByName(name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	commandContainer.CommandJobSchedulerFrom(dic.Get).Unschedule(name)
+	bootstrapContainer.LoggingClientFrom(dic.Get).Debugf("CommandJob %s deleted on DB successfully. Correlation-ID: %s", name, correlation.FromContext(ctx))
+	return nil
+}
+
+// LoadCommandJobs schedules the command jobs persisted in the database which have runs left, so that the jobs survive
+// the restarts of the service.  A one-shot job whose run was missed is run immediately, while the missed runs of a
+// repeating job are skipped.
+func LoadCommandJobs(dic *di.Container) errors.EdgeX {
+	dbClient := commandContainer.DBClientFrom(dic.Get)
+	scheduler := commandContainer.CommandJobSchedulerFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	jobs, edgeXerr := dbClient.AllCommandJobs(0, -1)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	now := time.Now()
+	for _, job := range jobs {
+		if job.Status != models.CommandJobStatusScheduled {
+			continue
+		}
+		if job.Interval != "" && job.NextRun < now.UnixMilli() {
+			nextRun, ok := CommandJobNextRun(job, now)
+			if ok {
+				job.NextRun = nextRun
+			} else {
+				job.Status = models.CommandJobStatusCompleted
+				job.NextRun = 0
+			}
+			if edgeXerr = dbClient.UpdateCommandJob(job); edgeXerr != nil {
+				return errors.NewCommonEdgeXWrapper(edgeXerr)
+			}
+			if !ok {
+				lc.Infof("CommandJob %s ended while the service was stopped", job.Name)
+				continue
+			}
+		}
+		if edgeXerr = scheduler.Schedule(job); edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+	}
+	lc.Infof("%d command jobs loaded", len(jobs))
+	return nil
+}
+
+// CommandJobNextRun returns the Unix timestamp in milliseconds of the first run of the job at or after from, and false
+// when the job has no run left.  A one-shot job only runs at its start, even when from is later.
+func CommandJobNextRun(job models.CommandJob, from time.Time) (int64, bool) {
+	if job.Interval == "" {
+		return job.Start, true
+	}
+	interval, err := time.ParseDuration(job.Interval)
+	if err != nil || interval <= 0 {
+		return 0, false
+	}
+
+	nextRun := job.Start
+	if fromMilli := from.UnixMilli(); fromMilli > nextRun {
+		intervalMilli := interval.Milliseconds()
+		if intervalMilli == 0 {
+			intervalMilli = 1
+		}
+		runs := (fromMilli - job.Start + intervalMilli - 1) / intervalMilli
+		nextRun = job.Start + runs*intervalMilli
+	}
+	if job.End != 0 && nextRun > job.End {
+		return 0, false
+	}
+	return nextRun, true
+}
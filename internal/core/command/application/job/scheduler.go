@@ -0,0 +1,206 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package job
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+type scheduler struct {
+	dic     *di.Container
+	mutex   sync.Mutex
+	entries map[string]*entry
+	stopped bool
+}
+
+// entry is the scheduled run of a job, which is no longer current once the job is unscheduled or scheduled again
+type entry struct {
+	timer *time.Timer
+}
+
+// NewScheduler creates a new scheduler running each command job with a timer armed for its next run
+func NewScheduler(dic *di.Container) interfaces.CommandJobScheduler {
+	return &scheduler{
+		dic:     dic,
+		entries: make(map[string]*entry),
+	}
+}
+
+// Schedule schedules the next run of the job, replacing the run of the job with the same name already scheduled.  The
+// job is run immediately when its next run is due.
+func (s *scheduler) Schedule(job models.CommandJob) errors.EdgeX {
+	if job.Status != models.CommandJobStatusScheduled {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "only the scheduled command jobs can be scheduled", nil)
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.stopped {
+		return errors.NewCommonEdgeX(errors.KindServiceUnavailable, "the command job scheduler is stopped", nil)
+	}
+	if e, ok := s.entries[job.Name]; ok {
+		e.timer.Stop()
+	}
+	e := &entry{}
+	s.arm(job, e)
+	s.entries[job.Name] = e
+	return nil
+}
+
+// Unschedule cancels the next run of the job, a run in progress not being rescheduled
+func (s *scheduler) Unschedule(name string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if e, ok := s.entries[name]; ok {
+		e.timer.Stop()
+		delete(s.entries, name)
+	}
+}
+
+// Stop cancels the next run of all jobs
+func (s *scheduler) Stop() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.stopped = true
+	for name, e := range s.entries {
+		e.timer.Stop()
+		delete(s.entries, name)
+	}
+}
+
+// arm arms the timer of the entry for the next run of the job, the caller holding the mutex
+func (s *scheduler) arm(job models.CommandJob, e *entry) {
+	e.timer = time.AfterFunc(time.Until(time.UnixMilli(job.NextRun)), func() {
+		s.run(job, e)
+	})
+}
+
+// isCurrent checks whether the entry is the scheduled run of the job, the caller holding the mutex
+func (s *scheduler) isCurrent(name string, e *entry) bool {
+	return !s.stopped && s.entries[name] == e
+}
+
+func (s *scheduler) run(job models.CommandJob, e *entry) {
+	lc := bootstrapContainer.LoggingClientFrom(s.dic.Get)
+	s.mutex.Lock()
+	current := s.isCurrent(job.Name, e)
+	s.mutex.Unlock()
+	if !current {
+		return
+	}
+
+	lc.Debugf("running command job %s", job.Name)
+	result := s.execute(job)
+	job.RunCount++
+	job.LastResult = &result
+	nextRun, ok := application.CommandJobNextRun(job, time.UnixMilli(result.Timestamp+1))
+	if job.Interval == "" || !ok {
+		job.Status = models.CommandJobStatusCompleted
+		job.NextRun = 0
+	} else {
+		job.NextRun = nextRun
+	}
+
+	// the job is persisted before its next run is scheduled so that the runs are recorded in order
+	if err := commandContainer.DBClientFrom(s.dic.Get).UpdateCommandJob(job); err != nil {
+		if errors.Kind(err) == errors.KindEntityDoesNotExist {
+			lc.Debugf("command job %s was deleted while running", job.Name)
+			return
+		}
+		lc.Errorf("failed to record the run of command job %s: %v", job.Name, err)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if !s.isCurrent(job.Name, e) {
+		return
+	}
+	if job.Status == models.CommandJobStatusCompleted {
+		delete(s.entries, job.Name)
+		lc.Debugf("command job %s completed", job.Name)
+		return
+	}
+	s.arm(job, e)
+}
+
+// execute issues the command of the job, recording it in the audit trail, and returns its result
+func (s *scheduler) execute(job models.CommandJob) models.CommandJobResult {
+	received := time.Now()
+	result := models.CommandJobResult{Timestamp: received.UnixMilli()}
+	audit := models.CommandAuditRecord{
+		DeviceName:  job.DeviceName,
+		CommandName: job.CommandName,
+		Method:      job.Method,
+		QueryParams: job.QueryParams,
+		Settings:    job.Settings,
+		Caller:      job.Name,
+		Transport:   models.CommandAuditTransportCommandJob,
+	}
+	queryParams := url.Values{}
+	for k, v := range job.QueryParams {
+		queryParams.Set(k, v)
+	}
+
+	var err errors.EdgeX
+	if job.Method == pkgDtos.BatchCommandMethodSet {
+		var response commonDTO.BaseResponse
+		response, err = application.IssueSetCommandByName(job.DeviceName, job.CommandName, queryParams.Encode(), job.Settings, s.dic)
+		result.StatusCode = response.StatusCode
+	} else {
+		result.StatusCode = http.StatusOK
+		res, getErr := application.IssueGetCommandByName(job.DeviceName, job.CommandName, queryParams.Encode(), s.dic)
+		if getErr == nil && res != nil {
+			result.EventId = res.Event.Id
+			result.Readings = readingValues(res.Event)
+		}
+		err = getErr
+	}
+	application.SetCommandAuditResult(&audit, err)
+	application.AuditCommand(audit, received, s.dic)
+	if err != nil {
+		result.StatusCode = err.Code()
+		result.Message = err.Error()
+	}
+	return result
+}
+
+// readingValues returns the values of the readings of the event by resource name, the object values being JSON encoded
+// and the binary values being left out
+func readingValues(event dtos.Event) map[string]string {
+	values := make(map[string]string, len(event.Readings))
+	for _, r := range event.Readings {
+		switch r.ValueType {
+		case common.ValueTypeBinary:
+			continue
+		case common.ValueTypeObject:
+			b, err := json.Marshal(r.ObjectValue)
+			if err != nil {
+				continue
+			}
+			values[r.ResourceName] = string(b)
+		default:
+			values[r.ResourceName] = r.Value
+		}
+	}
+	return values
+}
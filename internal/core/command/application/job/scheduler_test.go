@@ -0,0 +1,108 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package job
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+const testDeviceName = "testDevice"
+
+func newMockDIC(dbClient *dbMock.DBClient) *di.Container {
+	dcMock := &mocks.DeviceClient{}
+	dcMock.On("DeviceByName", context.Background(), testDeviceName).Return(responses.DeviceResponse{},
+		errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device not found", nil))
+
+	return di.NewContainer(di.ServiceConstructorMap{
+		commandContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{}
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		bootstrapContainer.DeviceClientName: func(get di.Get) interface{} {
+			return dcMock
+		},
+		commandContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClient
+		},
+	})
+}
+
+func TestScheduleOneShotJob(t *testing.T) {
+	job := models.CommandJob{
+		Name:        "job1",
+		DeviceName:  testDeviceName,
+		CommandName: "cmd1",
+		Method:      "get",
+		Start:       time.Now().UnixMilli(),
+		NextRun:     time.Now().UnixMilli(),
+		Status:      models.CommandJobStatusScheduled,
+	}
+
+	updatedJobs := make(chan models.CommandJob, 1)
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("UpdateCommandJob", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		updatedJobs <- args.Get(0).(models.CommandJob)
+	})
+	s := NewScheduler(newMockDIC(dbClientMock))
+	defer s.Stop()
+	require.NoError(t, s.Schedule(job))
+
+	var updated models.CommandJob
+	select {
+	case updated = <-updatedJobs:
+	case <-time.After(time.Second):
+		require.Fail(t, "the command job was not run")
+	}
+	assert.Equal(t, models.CommandJobStatusCompleted, updated.Status)
+	assert.Equal(t, int64(1), updated.RunCount)
+	assert.Zero(t, updated.NextRun)
+	require.NotNil(t, updated.LastResult)
+	assert.Equal(t, http.StatusNotFound, updated.LastResult.StatusCode)
+	assert.NotEmpty(t, updated.LastResult.Message)
+}
+
+func TestUnscheduleJob(t *testing.T) {
+	job := models.CommandJob{
+		Name:        "job1",
+		DeviceName:  testDeviceName,
+		CommandName: "cmd1",
+		Method:      "get",
+		NextRun:     time.Now().Add(50 * time.Millisecond).UnixMilli(),
+		Status:      models.CommandJobStatusScheduled,
+	}
+
+	dbClientMock := &dbMock.DBClient{}
+	s := NewScheduler(newMockDIC(dbClientMock))
+	defer s.Stop()
+	require.NoError(t, s.Schedule(job))
+	s.Unschedule(job.Name)
+
+	time.Sleep(100 * time.Millisecond)
+	dbClientMock.AssertNotCalled(t, "UpdateCommandJob", mock.Anything)
+
+	s.Stop()
+	err := s.Schedule(job)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, err.Code())
+}
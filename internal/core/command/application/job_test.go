@@ -0,0 +1,108 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+func TestCommandJobNextRun(t *testing.T) {
+	start := int64(1_000_000)
+	tests := []struct {
+		name            string
+		interval        string
+		end             int64
+		from            int64
+		expectedNextRun int64
+		expectedOk      bool
+	}{
+		{"one-shot before start", "", 0, start - 500, start, true},
+		{"one-shot after start", "", 0, start + 500, start, true},
+		{"repeating before start", "1s", 0, start - 500, start, true},
+		{"repeating at a run", "1s", 0, start + 2000, start + 2000, true},
+		{"repeating between runs", "1s", 0, start + 2001, start + 3000, true},
+		{"repeating before end", "1s", start + 3000, start + 2500, start + 3000, true},
+		{"repeating after end", "1s", start + 3000, start + 3001, 0, false},
+		{"invalid interval", "1x", 0, start, 0, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			job := models.CommandJob{Start: start, End: testCase.end, Interval: testCase.interval}
+			nextRun, ok := CommandJobNextRun(job, time.UnixMilli(testCase.from))
+			assert.Equal(t, testCase.expectedOk, ok)
+			assert.Equal(t, testCase.expectedNextRun, nextRun)
+		})
+	}
+}
+
+func TestAddCommandJob(t *testing.T) {
+	unknownDeviceName := "unknownDevice"
+	pastStart := time.Now().Add(-time.Hour).UnixMilli()
+
+	dcMock := &mocks.DeviceClient{}
+	dcMock.On("DeviceByName", context.Background(), testDeviceName).Return(responses.DeviceResponse{}, nil)
+	dcMock.On("DeviceByName", context.Background(), unknownDeviceName).Return(responses.DeviceResponse{},
+		errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device not found", nil))
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddCommandJob", mock.Anything).Return(func(job models.CommandJob) (models.CommandJob, errors.EdgeX) {
+		job.Id = "id"
+		return job, nil
+	})
+	schedulerMock := &dbMock.CommandJobScheduler{}
+	schedulerMock.On("Schedule", mock.Anything).Return(nil)
+
+	dic := newAuditMockDIC(dbClientMock)
+	dic.Update(di.ServiceConstructorMap{
+		bootstrapContainer.DeviceClientName: func(get di.Get) interface{} {
+			return dcMock
+		},
+		commandContainer.CommandJobSchedulerName: func(get di.Get) interface{} {
+			return schedulerMock
+		},
+	})
+
+	tests := []struct {
+		name               string
+		job                models.CommandJob
+		expectedStatusCode int
+	}{
+		{"valid, deferred", models.CommandJob{Name: "job1", DeviceName: testDeviceName, CommandName: command1, Method: "get"}, 0},
+		{"valid, repeating", models.CommandJob{Name: "job2", DeviceName: testDeviceName, CommandName: command1, Method: "get", Interval: "1h", Start: pastStart}, 0},
+		{"invalid, device not found", models.CommandJob{Name: "job3", DeviceName: unknownDeviceName, CommandName: command1, Method: "get"}, http.StatusNotFound},
+		{"invalid, ended", models.CommandJob{Name: "job4", DeviceName: testDeviceName, CommandName: command1, Method: "get", Interval: "1h", Start: pastStart, End: pastStart + 1}, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			id, err := AddCommandJob(testCase.job, context.Background(), dic)
+			if testCase.expectedStatusCode != 0 {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, err.Code())
+				schedulerMock.AssertNotCalled(t, "Schedule", mock.MatchedBy(func(job models.CommandJob) bool { return job.Name == testCase.job.Name }))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "id", id)
+			schedulerMock.AssertCalled(t, "Schedule", mock.MatchedBy(func(job models.CommandJob) bool {
+				return job.Name == testCase.job.Name && job.Status == models.CommandJobStatusScheduled &&
+					job.NextRun >= job.Start && job.NextRun <= time.Now().Add(time.Hour).UnixMilli()
+			}))
+		})
+	}
+}
@@ -0,0 +1,56 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+)
+
+// AcquireDeviceCommand waits until the command limits of the device allow a command to be issued to it, and returns the
+// function to call once the command completes.  The error returned when the limits don't allow the command is not
+// wrapped, so that its 429 status code is kept.
+func AcquireDeviceCommand(ctx context.Context, device dtos.Device, dic *di.Container) (func(), errors.EdgeX) {
+	limiter := commandContainer.CommandLimiterFrom(dic.Get)
+	if limiter == nil {
+		return func() {}, nil
+	}
+	return limiter.Acquire(ctx, device.Name, device.ProfileName)
+}
+
+// AcquireDeviceCommandByName is AcquireDeviceCommand for the device referenced by name
+func AcquireDeviceCommandByName(ctx context.Context, deviceName string, dic *di.Container) (func(), errors.EdgeX) {
+	if commandContainer.CommandLimiterFrom(dic.Get) == nil {
+		return func() {}, nil
+	}
+	dc := bootstrapContainer.DeviceClientFrom(dic.Get)
+	if dc == nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceClient returned", nil)
+	}
+	deviceResponse, err := dc.DeviceByName(ctx, deviceName)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	return AcquireDeviceCommand(ctx, deviceResponse.Device, dic)
+}
+
+// LimitedDeviceCommandIssuer returns the DeviceCommandIssuer holding each command to the command limits of its device
+// while it is issued by issue
+func LimitedDeviceCommandIssuer(issue DeviceCommandIssuer, dic *di.Container) DeviceCommandIssuer {
+	return func(ctx context.Context, device dtos.Device, service dtos.DeviceService, request pkgDtos.BatchCommandRequest) (*dtos.Event, errors.EdgeX) {
+		release, err := AcquireDeviceCommand(ctx, device, dic)
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+		return issue(ctx, device, service, request)
+	}
+}
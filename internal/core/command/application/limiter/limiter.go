@@ -0,0 +1,205 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package limiter
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"golang.org/x/time/rate"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces"
+)
+
+// LimitExceededError is the error a command fails with when a limit of its device, or of the profile of its device,
+// doesn't allow it within the queue timeout
+type LimitExceededError struct {
+	errors.CommonEdgeX
+}
+
+// Code returns 429 Too Many Requests, which has no error kind of its own
+func (e LimitExceededError) Code() int {
+	return http.StatusTooManyRequests
+}
+
+// Unwrap returns the CommonEdgeX the error kind is taken from
+func (e LimitExceededError) Unwrap() error {
+	return e.CommonEdgeX
+}
+
+// limit enforces a CommandLimit, slots being nil when the concurrency is unlimited and bucket being nil when the rate is
+// unlimited
+type limit struct {
+	slots  chan struct{}
+	bucket *rate.Limiter
+}
+
+func newLimit(cl config.CommandLimit) *limit {
+	l := &limit{}
+	if cl.MaxConcurrent > 0 {
+		l.slots = make(chan struct{}, cl.MaxConcurrent)
+	}
+	if cl.Rate > 0 {
+		burst := cl.Burst
+		if burst <= 0 {
+			burst = 1
+		}
+		l.bucket = rate.NewLimiter(rate.Limit(cl.Rate), burst)
+	}
+	return l
+}
+
+// acquireSlot takes a concurrency slot, waiting for it until ctx is done when wait is true
+func (l *limit) acquireSlot(ctx context.Context, wait bool) bool {
+	if l.slots == nil {
+		return true
+	}
+	if wait {
+		select {
+		case l.slots <- struct{}{}:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	select {
+	case l.slots <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+// reserveToken reserves a token at now, returning nil when the rate is unlimited
+func (l *limit) reserveToken(now time.Time) *rate.Reservation {
+	if l.bucket == nil {
+		return nil
+	}
+	return l.bucket.ReserveN(now, 1)
+}
+
+func (l *limit) release() {
+	if l.slots != nil {
+		<-l.slots
+	}
+}
+
+type commandLimiter struct {
+	queueTimeout time.Duration
+	devices      map[string]*limit
+	profiles     map[string]*limit
+}
+
+// NewCommandLimiter creates a new CommandLimiter enforcing the limits of the configuration.  The limits are fixed once
+// created, the devices and profiles without limits being unlimited.
+func NewCommandLimiter(limitsInfo config.CommandLimitsInfo) (interfaces.CommandLimiter, errors.EdgeX) {
+	var queueTimeout time.Duration
+	if limitsInfo.QueueTimeout != "" {
+		var err error
+		queueTimeout, err = time.ParseDuration(limitsInfo.QueueTimeout)
+		if err != nil || queueTimeout < 0 {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid CommandLimits.QueueTimeout %s", limitsInfo.QueueTimeout), err)
+		}
+	}
+
+	l := &commandLimiter{
+		queueTimeout: queueTimeout,
+		devices:      make(map[string]*limit, len(limitsInfo.Devices)),
+		profiles:     make(map[string]*limit, len(limitsInfo.Profiles)),
+	}
+	for name, cl := range limitsInfo.Devices {
+		l.devices[name] = newLimit(cl)
+	}
+	for name, cl := range limitsInfo.Profiles {
+		l.profiles[name] = newLimit(cl)
+	}
+	return l, nil
+}
+
+// Acquire waits at most the queue timeout for the concurrency slots and then for the tokens of the limits of the device
+// and of its profile, the command being rejected with a LimitExceededError when they don't allow it in time.  The tokens
+// of all the limits are reserved together and given back when any of them isn't available in time, so that a command
+// rejected by the limit of the profile doesn't use up a token of the device.
+func (l *commandLimiter) Acquire(ctx context.Context, deviceName string, profileName string) (func(), errors.EdgeX) {
+	var limits []*limit
+	if dl, ok := l.devices[deviceName]; ok {
+		limits = append(limits, dl)
+	}
+	if pl, ok := l.profiles[profileName]; ok {
+		limits = append(limits, pl)
+	}
+	release := func() {
+		for _, acquired := range limits {
+			acquired.release()
+		}
+	}
+	if len(limits) == 0 {
+		return release, nil
+	}
+	limitExceeded := LimitExceededError{errors.NewCommonEdgeX(errors.KindLimitExceeded,
+		fmt.Sprintf("too many commands issued to device %s, retry later", deviceName), nil)}
+
+	wait := l.queueTimeout > 0
+	if wait {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, l.queueTimeout)
+		defer cancel()
+	}
+	for i, lim := range limits {
+		if !lim.acquireSlot(ctx, wait) {
+			for _, acquired := range limits[:i] {
+				acquired.release()
+			}
+			return nil, limitExceeded
+		}
+	}
+
+	now := time.Now()
+	var reservations []*rate.Reservation
+	var delay time.Duration
+	allowed := true
+	for _, lim := range limits {
+		r := lim.reserveToken(now)
+		if r == nil {
+			continue
+		}
+		reservations = append(reservations, r)
+		if !r.OK() {
+			allowed = false
+			continue
+		}
+		if d := r.DelayFrom(now); d > delay {
+			delay = d
+		}
+	}
+	if delay > 0 {
+		if !wait {
+			allowed = false
+		} else if deadline, ok := ctx.Deadline(); ok && now.Add(delay).After(deadline) {
+			allowed = false
+		}
+	}
+	if allowed && delay > 0 {
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			now = time.Now()
+			allowed = false
+		}
+	}
+	if !allowed {
+		for _, r := range reservations {
+			r.CancelAt(now)
+		}
+		release()
+		return nil, limitExceeded
+	}
+	return release, nil
+}
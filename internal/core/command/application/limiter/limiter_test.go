@@ -0,0 +1,143 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package limiter
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+)
+
+const (
+	testDeviceName  = "testDevice"
+	testProfileName = "testProfile"
+)
+
+func TestNewCommandLimiter(t *testing.T) {
+	_, err := NewCommandLimiter(config.CommandLimitsInfo{QueueTimeout: "invalid"})
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+
+	_, err = NewCommandLimiter(config.CommandLimitsInfo{QueueTimeout: "-1s"})
+	require.Error(t, err)
+}
+
+func TestAcquireUnlimited(t *testing.T) {
+	l, err := NewCommandLimiter(config.CommandLimitsInfo{})
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		_, err = l.Acquire(context.Background(), testDeviceName, testProfileName)
+		require.NoError(t, err)
+	}
+}
+
+func TestAcquireMaxConcurrent(t *testing.T) {
+	l, err := NewCommandLimiter(config.CommandLimitsInfo{
+		Devices: map[string]config.CommandLimit{testDeviceName: {MaxConcurrent: 1}},
+	})
+	require.NoError(t, err)
+
+	release, err := l.Acquire(context.Background(), testDeviceName, testProfileName)
+	require.NoError(t, err)
+	_, err = l.Acquire(context.Background(), testDeviceName, testProfileName)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, err.Code())
+
+	// the other devices are not limited
+	_, err = l.Acquire(context.Background(), "otherDevice", testProfileName)
+	require.NoError(t, err)
+
+	release()
+	_, err = l.Acquire(context.Background(), testDeviceName, testProfileName)
+	require.NoError(t, err)
+}
+
+func TestAcquireProfileMaxConcurrent(t *testing.T) {
+	l, err := NewCommandLimiter(config.CommandLimitsInfo{
+		Devices:  map[string]config.CommandLimit{testDeviceName: {MaxConcurrent: 2}},
+		Profiles: map[string]config.CommandLimit{testProfileName: {MaxConcurrent: 1}},
+	})
+	require.NoError(t, err)
+
+	release, err := l.Acquire(context.Background(), "otherDevice", testProfileName)
+	require.NoError(t, err)
+	_, err = l.Acquire(context.Background(), testDeviceName, testProfileName)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, err.Code())
+
+	// the device slot taken before the profile limit was exceeded is released
+	release()
+	release1, err := l.Acquire(context.Background(), testDeviceName, testProfileName)
+	require.NoError(t, err)
+	release1()
+	release2, err := l.Acquire(context.Background(), testDeviceName, testProfileName)
+	require.NoError(t, err)
+	release2()
+}
+
+func TestAcquireRate(t *testing.T) {
+	l, err := NewCommandLimiter(config.CommandLimitsInfo{
+		Devices: map[string]config.CommandLimit{testDeviceName: {Rate: 1, Burst: 2}},
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		_, err = l.Acquire(context.Background(), testDeviceName, testProfileName)
+		require.NoError(t, err)
+	}
+	_, err = l.Acquire(context.Background(), testDeviceName, testProfileName)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, err.Code())
+}
+
+func TestAcquireProfileRate(t *testing.T) {
+	l, err := NewCommandLimiter(config.CommandLimitsInfo{
+		Devices:  map[string]config.CommandLimit{testDeviceName: {Rate: 1, Burst: 1}},
+		Profiles: map[string]config.CommandLimit{testProfileName: {Rate: 1, Burst: 1}},
+	})
+	require.NoError(t, err)
+
+	_, err = l.Acquire(context.Background(), "otherDevice", testProfileName)
+	require.NoError(t, err)
+	_, err = l.Acquire(context.Background(), testDeviceName, testProfileName)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, err.Code())
+
+	// the device token reserved before the profile limit was exceeded is given back
+	_, err = l.Acquire(context.Background(), testDeviceName, "otherProfile")
+	require.NoError(t, err)
+}
+
+func TestAcquireQueueTimeout(t *testing.T) {
+	l, err := NewCommandLimiter(config.CommandLimitsInfo{
+		QueueTimeout: "500ms",
+		Devices:      map[string]config.CommandLimit{testDeviceName: {MaxConcurrent: 1}},
+	})
+	require.NoError(t, err)
+
+	release, err := l.Acquire(context.Background(), testDeviceName, testProfileName)
+	require.NoError(t, err)
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		release()
+	}()
+	// the command is queued until the first command completes
+	release, err = l.Acquire(context.Background(), testDeviceName, testProfileName)
+	require.NoError(t, err)
+
+	start := time.Now()
+	_, err = l.Acquire(context.Background(), testDeviceName, testProfileName)
+	require.Error(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, err.Code())
+	assert.GreaterOrEqual(t, time.Since(start), 500*time.Millisecond)
+	release()
+}
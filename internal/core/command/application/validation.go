@@ -0,0 +1,237 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+)
+
+// SettingsValidationError is the error a set command fails with when its settings don't conform to the device profile,
+// Fields reporting the error of each invalid setting
+type SettingsValidationError struct {
+	errors.EdgeX
+	Fields []pkgDtos.SettingValidationError
+}
+
+// Unwrap returns the CommonEdgeX the error kind is taken from
+func (e SettingsValidationError) Unwrap() error {
+	return e.EdgeX
+}
+
+// ValidateSetCommandSettings validates the settings of the set command referenced by the command name against the
+// profile of the device, also referenced by name.  A SettingsValidationError is returned when some settings are
+// invalid.
+func ValidateSetCommandSettings(ctx context.Context, deviceName string, commandName string, settings map[string]any, dic *di.Container) errors.EdgeX {
+	dc := bootstrapContainer.DeviceClientFrom(dic.Get)
+	if dc == nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceClient returned", nil)
+	}
+	deviceResponse, err := dc.DeviceByName(ctx, deviceName)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return validateDeviceSetCommandSettings(ctx, deviceResponse.Device, commandName, settings, dic)
+}
+
+func validateDeviceSetCommandSettings(ctx context.Context, device dtos.Device, commandName string, settings map[string]any, dic *di.Container) errors.EdgeX {
+	dpc := bootstrapContainer.DeviceProfileClientFrom(dic.Get)
+	if dpc == nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceProfileClient returned", nil)
+	}
+	profileResponse, err := dpc.DeviceProfileByName(ctx, device.ProfileName)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	fields, err := validateSettings(profileResponse.Profile, commandName, settings)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	details := make([]string, len(fields))
+	for i, f := range fields {
+		details[i] = fmt.Sprintf("%s: %s", f.Field, f.Message)
+	}
+	message := fmt.Sprintf("invalid settings of command %s of device %s: %s", commandName, device.Name, strings.Join(details, "; "))
+	return SettingsValidationError{
+		EdgeX:  errors.NewCommonEdgeX(errors.KindContractInvalid, message, nil),
+		Fields: fields,
+	}
+}
+
+// validateSettings validates the settings of the set command against the ResourceOperations of the device command, or
+// against the device resource when the command is a device resource, and returns the error of each invalid setting
+func validateSettings(profile dtos.DeviceProfile, commandName string, settings map[string]any) ([]pkgDtos.SettingValidationError, errors.EdgeX) {
+	var readWrite string
+	var operations []dtos.ResourceOperation
+	if command, ok := deviceCommandByName(profile.DeviceCommands, commandName); ok {
+		readWrite = command.ReadWrite
+		operations = command.ResourceOperations
+	} else if resource, ok := deviceResourcesByName(profile.DeviceResources, commandName); ok {
+		readWrite = resource.Properties.ReadWrite
+		operations = []dtos.ResourceOperation{{DeviceResource: resource.Name}}
+	} else {
+		return nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("command %s doesn't exist in device profile %s", commandName, profile.Name), nil)
+	}
+	if !strings.Contains(readWrite, common.ReadWrite_W) {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("command %s of device profile %s is read-only", commandName, profile.Name), nil)
+	}
+
+	var fields []pkgDtos.SettingValidationError
+	invalid := func(field string, format string, args ...any) {
+		fields = append(fields, pkgDtos.SettingValidationError{Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+	operationResources := make(map[string]bool, len(operations))
+	for _, ro := range operations {
+		operationResources[ro.DeviceResource] = true
+		resource, ok := deviceResourcesByName(profile.DeviceResources, ro.DeviceResource)
+		if !ok {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device command's resource %s doesn't match any device resource", ro.DeviceResource), nil)
+		}
+		value, ok := settings[ro.DeviceResource]
+		if !ok {
+			// the device service writes the default value of the resource when no value is set
+			if ro.DefaultValue == "" && resource.Properties.DefaultValue == "" {
+				invalid(ro.DeviceResource, "value is required as the resource has no default value")
+			}
+			continue
+		}
+		if message := validateSettingValue(value, resource.Properties, ro.Mappings); message != "" {
+			invalid(ro.DeviceResource, message)
+		}
+	}
+
+	var unknown []string
+	for name := range settings {
+		if !operationResources[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+	for _, name := range unknown {
+		invalid(name, "not a resource of command %s", commandName)
+	}
+	return fields, nil
+}
+
+func deviceCommandByName(commands []dtos.DeviceCommand, name string) (dtos.DeviceCommand, bool) {
+	for _, command := range commands {
+		if command.Name == name {
+			return command, true
+		}
+	}
+	return dtos.DeviceCommand{}, false
+}
+
+// validateSettingValue validates a setting value against the value type and the range of the resource, the value being
+// first mapped through the mappings of the resource operation like the device service does.  It returns why the value
+// is invalid, or an empty string when it is valid.
+func validateSettingValue(value any, properties dtos.ResourceProperties, mappings map[string]string) string {
+	valueType := properties.ValueType
+	switch valueType {
+	case common.ValueTypeObject:
+		if _, ok := value.(map[string]any); ok {
+			return ""
+		}
+		if s, ok := value.(string); ok && json.Unmarshal([]byte(s), &map[string]any{}) == nil {
+			return ""
+		}
+		return fmt.Sprintf("value %v is not a valid %s", value, valueType)
+	case common.ValueTypeBinary:
+		return ""
+	}
+
+	elementType, isArray := strings.CutSuffix(valueType, "Array")
+	if !isArray {
+		s := settingValueString(value)
+		if mapped, ok := mappings[s]; ok {
+			s = mapped
+		}
+		return validateScalarValue(s, elementType, properties)
+	}
+
+	elements, ok := value.([]any)
+	if !ok {
+		s, isString := value.(string)
+		if !isString || json.Unmarshal([]byte(s), &elements) != nil {
+			return fmt.Sprintf("value %v is not a valid %s", value, valueType)
+		}
+	}
+	for i, element := range elements {
+		if message := validateScalarValue(settingValueString(element), elementType, properties); message != "" {
+			return fmt.Sprintf("element %d: %s", i, message)
+		}
+	}
+	return ""
+}
+
+// settingValueString returns the string representation the device service parses a setting value from
+func settingValueString(value any) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(b)
+}
+
+func validateScalarValue(s string, valueType string, properties dtos.ResourceProperties) string {
+	var number float64
+	var err error
+	switch valueType {
+	case common.ValueTypeString:
+		return ""
+	case common.ValueTypeBool:
+		_, err = strconv.ParseBool(s)
+	case common.ValueTypeUint8, common.ValueTypeUint16, common.ValueTypeUint32, common.ValueTypeUint64:
+		var u uint64
+		u, err = strconv.ParseUint(s, 10, bitSize(valueType))
+		number = float64(u)
+	case common.ValueTypeInt8, common.ValueTypeInt16, common.ValueTypeInt32, common.ValueTypeInt64:
+		var i int64
+		i, err = strconv.ParseInt(s, 10, bitSize(valueType))
+		number = float64(i)
+	case common.ValueTypeFloat32, common.ValueTypeFloat64:
+		number, err = strconv.ParseFloat(s, bitSize(valueType))
+	default:
+		return fmt.Sprintf("value type %s of the resource is not supported", valueType)
+	}
+	if err != nil {
+		return fmt.Sprintf("value %s is not a valid %s", s, valueType)
+	}
+	if valueType == common.ValueTypeBool {
+		return ""
+	}
+	if properties.Minimum != nil && number < *properties.Minimum {
+		return fmt.Sprintf("value %s is less than the minimum %v", s, *properties.Minimum)
+	}
+	if properties.Maximum != nil && number > *properties.Maximum {
+		return fmt.Sprintf("value %s is greater than the maximum %v", s, *properties.Maximum)
+	}
+	return ""
+}
+
+// bitSize returns the size in bits of a numeric value type, e.g. 16 for Int16
+func bitSize(valueType string) int {
+	size, _ := strconv.Atoi(strings.TrimLeftFunc(valueType, unicode.IsLetter))
+	return size
+}
@@ -0,0 +1,77 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSettings(t *testing.T) {
+	minimum, maximum := float64(-10), float64(100)
+	profile := dtos.DeviceProfile{
+		DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: "testProfile"},
+		DeviceResources: []dtos.DeviceResource{
+			{Name: resource1, Properties: dtos.ResourceProperties{ValueType: common.ValueTypeString, ReadWrite: common.ReadWrite_R}},
+			{Name: resource2, Properties: dtos.ResourceProperties{ValueType: common.ValueTypeInt16, ReadWrite: common.ReadWrite_W, Minimum: &minimum, Maximum: &maximum}},
+			{Name: resource3, Properties: dtos.ResourceProperties{ValueType: common.ValueTypeBool, ReadWrite: common.ReadWrite_RW}},
+			{Name: resource4, Properties: dtos.ResourceProperties{ValueType: common.ValueTypeUint8Array, ReadWrite: common.ReadWrite_RW}},
+			{Name: resource5, Properties: dtos.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_RW, DefaultValue: "0"}},
+		},
+		DeviceCommands: []dtos.DeviceCommand{
+			{
+				Name:      command1,
+				ReadWrite: common.ReadWrite_RW,
+				ResourceOperations: []dtos.ResourceOperation{
+					{DeviceResource: resource2},
+					{DeviceResource: resource3, Mappings: map[string]string{"on": "true", "off": "false"}},
+					{DeviceResource: resource5},
+				},
+			},
+			{Name: command2, ReadWrite: common.ReadWrite_R, ResourceOperations: []dtos.ResourceOperation{{DeviceResource: resource1}}},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		commandName    string
+		settings       map[string]any
+		expectedFields []string
+		expectedCode   int
+	}{
+		{"valid - device command", command1, map[string]any{resource2: "50", resource3: true}, nil, 0},
+		{"valid - mapped value", command1, map[string]any{resource2: float64(-10), resource3: "on"}, nil, 0},
+		{"valid - device resource", resource4, map[string]any{resource4: []any{float64(1), float64(255)}}, nil, 0},
+		{"valid - encoded array", resource4, map[string]any{resource4: "[1, 2, 3]"}, nil, 0},
+		{"invalid - out of range", command1, map[string]any{resource2: "101", resource3: "true"}, []string{resource2}, 0},
+		{"invalid - value type", command1, map[string]any{resource2: "1.5", resource3: "maybe"}, []string{resource2, resource3}, 0},
+		{"invalid - missing value without default", command1, map[string]any{resource2: "1"}, []string{resource3}, 0},
+		{"invalid - unknown resource", command1, map[string]any{resource2: "1", resource3: "true", resource1: "a"}, []string{resource1}, 0},
+		{"invalid - array element", resource4, map[string]any{resource4: []any{float64(1), float64(256)}}, []string{resource4}, 0},
+		{"invalid - read-only command", command2, map[string]any{resource1: "a"}, nil, http.StatusBadRequest},
+		{"invalid - read-only resource", resource1, map[string]any{resource1: "a"}, nil, http.StatusBadRequest},
+		{"invalid - unknown command", "nonExist", map[string]any{}, nil, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			fields, err := validateSettings(profile, testCase.commandName, testCase.settings)
+			if testCase.expectedCode != 0 {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedCode, err.Code())
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, fields, len(testCase.expectedFields))
+			for i, field := range testCase.expectedFields {
+				assert.Equal(t, field, fields[i].Field)
+				assert.NotEmpty(t, fields[i].Message)
+			}
+		})
+	}
+}
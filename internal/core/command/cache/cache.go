@@ -0,0 +1,131 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+)
+
+// MetadataCache keeps the devices, device profiles and device services core-command retrieves from core-metadata, by
+// name.  Entries are evicted by the metadata system events notifying their change, and expire after the TTL as a
+// safeguard against missed system events.  A zero TTL never expires the entries.
+type MetadataCache struct {
+	devices  *store[dtos.Device]
+	profiles *store[dtos.DeviceProfile]
+	services *store[dtos.DeviceService]
+}
+
+// NewMetadataCache creates an empty MetadataCache whose entries expire after ttl
+func NewMetadataCache(ttl time.Duration) *MetadataCache {
+	return &MetadataCache{
+		devices:  newStore(ttl, func(d dtos.Device) string { return d.Id }),
+		profiles: newStore(ttl, func(p dtos.DeviceProfile) string { return p.Id }),
+		services: newStore(ttl, func(s dtos.DeviceService) string { return s.Id }),
+	}
+}
+
+// Invalidate evicts the entity a metadata system event notifies the change of.  Entities are evicted by id as well as
+// by name, so that an entity renamed by an update doesn't stay cached under its previous name.
+func (c *MetadataCache) Invalidate(event dtos.SystemEvent) error {
+	switch event.Type {
+	case common.DeviceSystemEventType:
+		var device dtos.Device
+		if err := event.DecodeDetails(&device); err != nil {
+			return err
+		}
+		c.devices.evict(device.Name, device.Id)
+	case common.DeviceProfileSystemEventType:
+		var profile dtos.DeviceProfile
+		if err := event.DecodeDetails(&profile); err != nil {
+			return err
+		}
+		c.profiles.evict(profile.Name, profile.Id)
+	case common.DeviceServiceSystemEventType:
+		var service dtos.DeviceService
+		if err := event.DecodeDetails(&service); err != nil {
+			return err
+		}
+		c.services.evict(service.Name, service.Id)
+	}
+	return nil
+}
+
+// Clear evicts every entry of the cache
+func (c *MetadataCache) Clear() {
+	c.devices.clear()
+	c.profiles.clear()
+	c.services.clear()
+}
+
+type entry[T any] struct {
+	value   T
+	expires time.Time
+}
+
+// store keeps the entries of one kind of entity.  Its generation changes on every eviction, so that a value retrieved
+// while an eviction happened, which may be stale, is not stored.
+type store[T any] struct {
+	mutex      sync.RWMutex
+	ttl        time.Duration
+	idOf       func(T) string
+	entries    map[string]entry[T]
+	generation uint64
+}
+
+func newStore[T any](ttl time.Duration, idOf func(T) string) *store[T] {
+	return &store[T]{ttl: ttl, idOf: idOf, entries: make(map[string]entry[T])}
+}
+
+func (s *store[T]) get(name string) (T, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	e, ok := s.entries[name]
+	if !ok || (s.ttl > 0 && time.Now().After(e.expires)) {
+		var zero T
+		return zero, false
+	}
+	return e.value, true
+}
+
+func (s *store[T]) currentGeneration() uint64 {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.generation
+}
+
+// put stores the value unless an eviction happened since the generation it was retrieved at
+func (s *store[T]) put(name string, value T, generation uint64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if generation != s.generation {
+		return
+	}
+	s.entries[name] = entry[T]{value: value, expires: time.Now().Add(s.ttl)}
+}
+
+func (s *store[T]) evict(name string, id string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.generation++
+	delete(s.entries, name)
+	if id == "" {
+		return
+	}
+	for n, e := range s.entries {
+		if s.idOf(e.value) == id {
+			delete(s.entries, n)
+		}
+	}
+}
+
+func (s *store[T]) clear() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.generation++
+	s.entries = make(map[string]entry[T])
+}
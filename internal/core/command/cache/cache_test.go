@@ -0,0 +1,124 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testDeviceName  = "testDevice"
+	testProfileName = "testProfile"
+	testServiceName = "testService"
+)
+
+func TestDeviceClient(t *testing.T) {
+	device := dtos.Device{Id: "device-id", Name: testDeviceName, ProfileName: testProfileName, ServiceName: testServiceName}
+	renamed := device
+	renamed.Name = "renamedDevice"
+
+	dcMock := &mocks.DeviceClient{}
+	dcMock.On("DeviceByName", mock.Anything, testDeviceName).Return(responses.NewDeviceResponse("", "", http.StatusOK, device), nil)
+	dcMock.On("DeviceByName", mock.Anything, "nonExist").Return(responses.DeviceResponse{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+
+	metadataCache := NewMetadataCache(0)
+	dc := metadataCache.DeviceClient(dcMock)
+
+	for i := 0; i < 2; i++ {
+		res, err := dc.DeviceByName(context.Background(), testDeviceName)
+		require.NoError(t, err)
+		assert.Equal(t, device, res.Device)
+	}
+	dcMock.AssertNumberOfCalls(t, "DeviceByName", 1)
+
+	// errors are not cached
+	for i := 0; i < 2; i++ {
+		_, err := dc.DeviceByName(context.Background(), "nonExist")
+		require.Error(t, err)
+	}
+	dcMock.AssertNumberOfCalls(t, "DeviceByName", 3)
+
+	// a device renamed by an update is evicted by id
+	event := dtos.NewSystemEvent(common.DeviceSystemEventType, common.SystemEventActionUpdate, common.CoreMetaDataServiceKey, testServiceName, nil, renamed)
+	require.NoError(t, metadataCache.Invalidate(event))
+	_, err := dc.DeviceByName(context.Background(), testDeviceName)
+	require.NoError(t, err)
+	dcMock.AssertNumberOfCalls(t, "DeviceByName", 4)
+}
+
+func TestDeviceProfileClient(t *testing.T) {
+	profile := dtos.DeviceProfile{DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Id: "profile-id", Name: testProfileName}}
+
+	dpcMock := &mocks.DeviceProfileClient{}
+	dpcMock.On("DeviceProfileByName", mock.Anything, testProfileName).Return(responses.NewDeviceProfileResponse("", "", http.StatusOK, profile), nil)
+
+	metadataCache := NewMetadataCache(0)
+	dpc := metadataCache.DeviceProfileClient(dpcMock)
+
+	_, err := dpc.DeviceProfileByName(context.Background(), testProfileName)
+	require.NoError(t, err)
+	_, err = dpc.DeviceProfileByName(context.Background(), testProfileName)
+	require.NoError(t, err)
+	dpcMock.AssertNumberOfCalls(t, "DeviceProfileByName", 1)
+
+	// the events of other entity types don't evict the profile
+	event := dtos.NewSystemEvent(common.DeviceServiceSystemEventType, common.SystemEventActionDelete, common.CoreMetaDataServiceKey, testServiceName, nil, dtos.DeviceService{Name: testProfileName})
+	require.NoError(t, metadataCache.Invalidate(event))
+	_, err = dpc.DeviceProfileByName(context.Background(), testProfileName)
+	require.NoError(t, err)
+	dpcMock.AssertNumberOfCalls(t, "DeviceProfileByName", 1)
+
+	event = dtos.NewSystemEvent(common.DeviceProfileSystemEventType, common.SystemEventActionUpdate, common.CoreMetaDataServiceKey, common.CoreMetaDataServiceKey, nil, profile)
+	require.NoError(t, metadataCache.Invalidate(event))
+	_, err = dpc.DeviceProfileByName(context.Background(), testProfileName)
+	require.NoError(t, err)
+	dpcMock.AssertNumberOfCalls(t, "DeviceProfileByName", 2)
+}
+
+func TestDeviceServiceClient(t *testing.T) {
+	service := dtos.DeviceService{Id: "service-id", Name: testServiceName, BaseAddress: "http://localhost:59900"}
+
+	dscMock := &mocks.DeviceServiceClient{}
+	dscMock.On("DeviceServiceByName", mock.Anything, testServiceName).Return(responses.NewDeviceServiceResponse("", "", http.StatusOK, service), nil)
+
+	metadataCache := NewMetadataCache(time.Millisecond)
+	dsc := metadataCache.DeviceServiceClient(dscMock)
+
+	res, err := dsc.DeviceServiceByName(context.Background(), testServiceName)
+	require.NoError(t, err)
+	assert.Equal(t, service.BaseAddress, res.Service.BaseAddress)
+
+	// the entry expires after the TTL
+	time.Sleep(5 * time.Millisecond)
+	_, err = dsc.DeviceServiceByName(context.Background(), testServiceName)
+	require.NoError(t, err)
+	dscMock.AssertNumberOfCalls(t, "DeviceServiceByName", 2)
+}
+
+func TestStorePutAfterEviction(t *testing.T) {
+	s := newStore(0, func(d dtos.Device) string { return d.Id })
+
+	// a value retrieved before an eviction may be stale and is not stored
+	generation := s.currentGeneration()
+	s.evict(testDeviceName, "")
+	s.put(testDeviceName, dtos.Device{Name: testDeviceName}, generation)
+	_, ok := s.get(testDeviceName)
+	assert.False(t, ok)
+
+	s.put(testDeviceName, dtos.Device{Name: testDeviceName}, s.currentGeneration())
+	_, ok = s.get(testDeviceName)
+	assert.True(t, ok)
+}
@@ -0,0 +1,110 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// DeviceClient returns a DeviceClient answering DeviceByName from the cache, the other methods being those of client
+func (c *MetadataCache) DeviceClient(client interfaces.DeviceClient) interfaces.DeviceClient {
+	return &deviceClient{DeviceClient: client, cache: c}
+}
+
+// DeviceProfileClient returns a DeviceProfileClient answering DeviceProfileByName from the cache, the other methods
+// being those of client
+func (c *MetadataCache) DeviceProfileClient(client interfaces.DeviceProfileClient) interfaces.DeviceProfileClient {
+	return &deviceProfileClient{DeviceProfileClient: client, cache: c}
+}
+
+// DeviceServiceClient returns a DeviceServiceClient answering DeviceServiceByName from the cache, the other methods
+// being those of client
+func (c *MetadataCache) DeviceServiceClient(client interfaces.DeviceServiceClient) interfaces.DeviceServiceClient {
+	return &deviceServiceClient{DeviceServiceClient: client, cache: c}
+}
+
+type deviceClient struct {
+	interfaces.DeviceClient
+	cache *MetadataCache
+}
+
+func (dc *deviceClient) DeviceByName(ctx context.Context, name string) (responses.DeviceResponse, errors.EdgeX) {
+	if device, ok := dc.cache.devices.get(name); ok {
+		return responses.NewDeviceResponse("", "", http.StatusOK, device), nil
+	}
+	generation := dc.cache.devices.currentGeneration()
+	res, err := dc.DeviceClient.DeviceByName(ctx, name)
+	if err != nil {
+		return res, err
+	}
+	dc.cache.devices.put(name, res.Device, generation)
+	return res, nil
+}
+
+// AllDevices warms the cache with the devices it returns
+func (dc *deviceClient) AllDevices(ctx context.Context, labels []string, offset int, limit int) (responses.MultiDevicesResponse, errors.EdgeX) {
+	generation := dc.cache.devices.currentGeneration()
+	res, err := dc.DeviceClient.AllDevices(ctx, labels, offset, limit)
+	if err != nil {
+		return res, err
+	}
+	for _, device := range res.Devices {
+		dc.cache.devices.put(device.Name, device, generation)
+	}
+	return res, nil
+}
+
+// DevicesByProfileName warms the cache with the devices it returns
+func (dc *deviceClient) DevicesByProfileName(ctx context.Context, name string, offset int, limit int) (responses.MultiDevicesResponse, errors.EdgeX) {
+	generation := dc.cache.devices.currentGeneration()
+	res, err := dc.DeviceClient.DevicesByProfileName(ctx, name, offset, limit)
+	if err != nil {
+		return res, err
+	}
+	for _, device := range res.Devices {
+		dc.cache.devices.put(device.Name, device, generation)
+	}
+	return res, nil
+}
+
+type deviceProfileClient struct {
+	interfaces.DeviceProfileClient
+	cache *MetadataCache
+}
+
+func (dpc *deviceProfileClient) DeviceProfileByName(ctx context.Context, name string) (responses.DeviceProfileResponse, errors.EdgeX) {
+	if profile, ok := dpc.cache.profiles.get(name); ok {
+		return responses.NewDeviceProfileResponse("", "", http.StatusOK, profile), nil
+	}
+	generation := dpc.cache.profiles.currentGeneration()
+	res, err := dpc.DeviceProfileClient.DeviceProfileByName(ctx, name)
+	if err != nil {
+		return res, err
+	}
+	dpc.cache.profiles.put(name, res.Profile, generation)
+	return res, nil
+}
+
+type deviceServiceClient struct {
+	interfaces.DeviceServiceClient
+	cache *MetadataCache
+}
+
+func (dsc *deviceServiceClient) DeviceServiceByName(ctx context.Context, name string) (responses.DeviceServiceResponse, errors.EdgeX) {
+	if service, ok := dsc.cache.services.get(name); ok {
+		return responses.NewDeviceServiceResponse("", "", http.StatusOK, service), nil
+	}
+	generation := dsc.cache.services.currentGeneration()
+	res, err := dsc.DeviceServiceClient.DeviceServiceByName(ctx, name)
+	if err != nil {
+		return res, err
+	}
+	dsc.cache.services.put(name, res.Service, generation)
+	return res, nil
+}
@@ -22,13 +22,65 @@ import (
 
 // ConfigurationStruct contains the configuration properties for the core-command service.
 type ConfigurationStruct struct {
-	Writable     WritableInfo
-	Clients      bootstrapConfig.ClientsCollection
-	Databases    map[string]bootstrapConfig.Database
-	Registry     bootstrapConfig.RegistryInfo
-	Service      bootstrapConfig.ServiceInfo
-	MessageBus   bootstrapConfig.MessageBusInfo
-	ExternalMQTT bootstrapConfig.ExternalMQTTInfo
+	Writable      WritableInfo
+	Clients       bootstrapConfig.ClientsCollection
+	Databases     map[string]bootstrapConfig.Database
+	Database      bootstrapConfig.Database
+	Registry      bootstrapConfig.RegistryInfo
+	Service       bootstrapConfig.ServiceInfo
+	MessageBus    bootstrapConfig.MessageBusInfo
+	ExternalMQTT  bootstrapConfig.ExternalMQTTInfo
+	BatchCommand  BatchCommandInfo
+	MetadataCache MetadataCacheInfo
+	Audit         AuditInfo
+	CommandLimits CommandLimitsInfo
+}
+
+// BatchCommandInfo contains the configuration properties of the commands issued to many devices at once
+type BatchCommandInfo struct {
+	// MaxParallelism is the maximum number of devices a batch command is issued to concurrently
+	MaxParallelism int
+}
+
+// MetadataCacheInfo contains the configuration properties of the cache of the devices, device profiles and device
+// services retrieved from core-metadata
+type MetadataCacheInfo struct {
+	Enabled bool
+	// TTL is the duration after which the cached entries expire, as a safeguard against missed system events.  Entries
+	// never expire when empty or 0.
+	TTL string
+}
+
+// AuditInfo contains the configuration properties of the audit trail of the commands issued through core-command,
+// which is kept in the database
+type AuditInfo struct {
+	Enabled bool
+	// MaxCount is the number of audit records kept, the oldest records being deleted beyond it
+	MaxCount int
+}
+
+// CommandLimitsInfo contains the configuration properties limiting the commands issued to the devices, so that the
+// devices which can't handle simultaneous or frequent commands aren't overwhelmed
+type CommandLimitsInfo struct {
+	// QueueTimeout is how long a command exceeding a limit waits for the limit to allow it before being rejected with
+	// 429 Too Many Requests.  The command is rejected immediately when empty or 0.
+	QueueTimeout string
+	// Devices are the limits of the commands issued to each device, by device name
+	Devices map[string]CommandLimit
+	// Profiles are the limits of the commands issued to all the devices of each device profile together, by device
+	// profile name
+	Profiles map[string]CommandLimit
+}
+
+// CommandLimit contains the limits of the commands issued to a device, or to the devices of a device profile
+type CommandLimit struct {
+	// MaxConcurrent is the maximum number of commands in progress at once.  It is unlimited when 0.
+	MaxConcurrent int
+	// Rate is the number of commands per second the token bucket is refilled with.  It is unlimited when 0.
+	Rate float64
+	// Burst is the size of the token bucket, i.e. the number of commands which can be issued at once after a pause.  It
+	// is 1 when 0.
+	Burst int
 }
 
 // WritableInfo contains configuration properties that can be updated and applied without restarting the service.
@@ -80,6 +132,7 @@ func (c *ConfigurationStruct) GetBootstrap() bootstrapConfig.BootstrapConfigurat
 		Registry:     &c.Registry,
 		MessageBus:   &c.MessageBus,
 		ExternalMQTT: &c.ExternalMQTT,
+		Database:     &c.Database,
 	}
 }
 
@@ -93,9 +146,9 @@ func (c *ConfigurationStruct) GetRegistryInfo() bootstrapConfig.RegistryInfo {
 	return c.Registry
 }
 
-// GetDatabaseInfo returns a database information map.
+// GetDatabaseInfo returns a database information.
 func (c *ConfigurationStruct) GetDatabaseInfo() bootstrapConfig.Database {
-	return bootstrapConfig.Database{}
+	return c.Database
 }
 
 // GetInsecureSecrets returns the service's InsecureSecrets.
@@ -0,0 +1,23 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/cache"
+)
+
+// MetadataCacheName contains the name of the cache.MetadataCache implementation in the DIC.
+var MetadataCacheName = di.TypeInstanceToName(cache.MetadataCache{})
+
+// MetadataCacheFrom helper function queries the DIC and returns the cache.MetadataCache implementation, which is nil
+// when the metadata cache is disabled.
+func MetadataCacheFrom(get di.Get) *cache.MetadataCache {
+	metadataCache, ok := get(MetadataCacheName).(*cache.MetadataCache)
+	if !ok {
+		return nil
+	}
+	return metadataCache
+}
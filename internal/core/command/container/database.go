@@ -0,0 +1,23 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+// DBClientInterfaceName contains the name of the interfaces.DBClient implementation in the DIC.
+var DBClientInterfaceName = di.TypeInstanceToName((*interfaces.DBClient)(nil))
+
+// DBClientFrom helper function queries the DIC and returns the interfaces.DBClient implementation, or nil when the DIC
+// holds none.
+func DBClientFrom(get di.Get) interfaces.DBClient {
+	dbClient, ok := get(DBClientInterfaceName).(interfaces.DBClient)
+	if !ok {
+		return nil
+	}
+	return dbClient
+}
@@ -0,0 +1,23 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+// DeviceGroupClientName contains the name of the interfaces.DeviceGroupClient implementation in the DIC.
+var DeviceGroupClientName = di.TypeInstanceToName((*interfaces.DeviceGroupClient)(nil))
+
+// DeviceGroupClientFrom helper function queries the DIC and returns the interfaces.DeviceGroupClient implementation,
+// or nil when the DIC holds none.
+func DeviceGroupClientFrom(get di.Get) interfaces.DeviceGroupClient {
+	client, ok := get(DeviceGroupClientName).(interfaces.DeviceGroupClient)
+	if !ok {
+		return nil
+	}
+	return client
+}
@@ -0,0 +1,18 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+// CommandJobSchedulerName contains the name of the interfaces.CommandJobScheduler implementation in the DIC.
+var CommandJobSchedulerName = di.TypeInstanceToName((*interfaces.CommandJobScheduler)(nil))
+
+// CommandJobSchedulerFrom helper function queries the DIC and returns the interfaces.CommandJobScheduler implementation.
+func CommandJobSchedulerFrom(get di.Get) interfaces.CommandJobScheduler {
+	return get(CommandJobSchedulerName).(interfaces.CommandJobScheduler)
+}
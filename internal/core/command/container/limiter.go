@@ -0,0 +1,23 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+)
+
+// CommandLimiterName contains the name of the interfaces.CommandLimiter implementation in the DIC.
+var CommandLimiterName = di.TypeInstanceToName((*interfaces.CommandLimiter)(nil))
+
+// CommandLimiterFrom helper function queries the DIC and returns the interfaces.CommandLimiter implementation, or nil
+// when the DIC holds none.
+func CommandLimiterFrom(get di.Get) interfaces.CommandLimiter {
+	limiter, ok := get(CommandLimiterName).(interfaces.CommandLimiter)
+	if !ok {
+		return nil
+	}
+	return limiter
+}
@@ -0,0 +1,127 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"math"
+	"net/http"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+
+	"github.com/labstack/echo/v4"
+)
+
+// newCommandAuditRecord creates the audit record of a command received through the REST API
+func newCommandAuditRecord(r *http.Request, deviceName string, commandName string, method string) models.CommandAuditRecord {
+	var queryParams map[string]string
+	if query := r.URL.Query(); len(query) > 0 {
+		queryParams = make(map[string]string, len(query))
+		for k := range query {
+			queryParams[k] = query.Get(k)
+		}
+	}
+	return models.CommandAuditRecord{
+		DeviceName:    deviceName,
+		CommandName:   commandName,
+		Method:        method,
+		QueryParams:   queryParams,
+		CorrelationId: correlation.FromContext(r.Context()),
+		Caller:        callerIdentity(r),
+		Transport:     models.CommandAuditTransportREST,
+	}
+}
+
+// callerIdentity returns the identity of the caller carried by the JWT of the request, which is the name claim of the
+// token or its subject otherwise.  The token is only decoded here, relying on the authentication hook of the route to
+// reject the requests with an invalid token, so no identity is returned when the hook doesn't validate the tokens,
+// as the claims could then be forged.  No identity is returned either when the request carries no JWT.
+func callerIdentity(r *http.Request) string {
+	if !utils.IsJWTValidated() {
+		return ""
+	}
+	token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !found {
+		return ""
+	}
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return ""
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return ""
+	}
+	var claims struct {
+		Name    string `json:"name"`
+		Subject string `json:"sub"`
+	}
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		return ""
+	}
+	if claims.Name != "" {
+		return claims.Name
+	}
+	return claims.Subject
+}
+
+// AllCommandAuditRecords returns the command audit records of all devices, or of the device specified by name
+func (cc *CommandController) AllCommandAuditRecords(c echo.Context) error {
+	lc := container.LoggingClientFrom(cc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	config := commandContainer.ConfigurationFrom(cc.dic.Get)
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(c, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	records, totalCount, err := application.CommandAuditRecordsByTimeRange(c.Param(common.Name), 0, math.MaxInt, offset, limit, cc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responses.NewMultiCommandAuditRecordsResponse("", "", http.StatusOK, totalCount, records)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	// encode and send out the response
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// CommandAuditRecordsByTimeRange returns the command audit records of all devices, or of the device specified by
+// name, created within the time range
+func (cc *CommandController) CommandAuditRecordsByTimeRange(c echo.Context) error {
+	lc := container.LoggingClientFrom(cc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	config := commandContainer.ConfigurationFrom(cc.dic.Get)
+
+	// parse time range (start, end), offset, and limit from incoming request
+	start, end, offset, limit, err := utils.ParseTimeRangeOffsetLimit(c, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	records, totalCount, err := application.CommandAuditRecordsByTimeRange(c.Param(common.Name), start, end, offset, limit, cc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responses.NewMultiCommandAuditRecordsResponse("", "", http.StatusOK, totalCount, records)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	// encode and send out the response
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
@@ -0,0 +1,122 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/secret"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces/mocks"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+func buildTestJWT(claims string) string {
+	encode := base64.RawURLEncoding.EncodeToString
+	return encode([]byte(`{"alg":"HS256"}`)) + "." + encode([]byte(claims)) + ".signature"
+}
+
+func TestCallerIdentity(t *testing.T) {
+	token := "Bearer " + buildTestJWT(`{"name":"operator","sub":"subject-id"}`)
+	tests := []struct {
+		name            string
+		authorization   string
+		securityEnabled string
+		expected        string
+	}{
+		{"name claim", token, "true", "operator"},
+		{"subject claim", "Bearer " + buildTestJWT(`{"sub":"subject-id"}`), "true", "subject-id"},
+		{"no authorization", "", "true", ""},
+		{"not a bearer token", "Basic dXNlcjpwYXNz", "true", ""},
+		{"malformed token", "Bearer not-a-jwt", "true", ""},
+		{"security disabled", token, "false", ""},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Setenv(secret.EnvSecretStore, testCase.securityEnabled)
+			req := httptest.NewRequest(http.MethodGet, pkgCommon.ApiCommandAuditRoute, http.NoBody)
+			if testCase.authorization != "" {
+				req.Header.Set("Authorization", testCase.authorization)
+			}
+			assert.Equal(t, testCase.expected, callerIdentity(req))
+		})
+	}
+}
+
+func TestAllCommandAuditRecords(t *testing.T) {
+	records := []models.CommandAuditRecord{{Id: "id1", DeviceName: testDeviceName, CommandName: testCommandName, StatusCode: http.StatusOK}}
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("CommandAuditRecordsByTimeRange", "", 0, math.MaxInt, 0, 20).Return(records, uint32(1), nil)
+	dbClientMock.On("CommandAuditRecordsByTimeRange", testDeviceName, 0, math.MaxInt, 0, 1).Return(records, uint32(1), nil)
+
+	dic := NewMockDIC()
+	commandContainer.ConfigurationFrom(dic.Get).Audit = config.AuditInfo{Enabled: true}
+	dic.Update(di.ServiceConstructorMap{
+		commandContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	tests := []struct {
+		name               string
+		deviceName         string
+		limit              string
+		dic                *di.Container
+		errorExpected      bool
+		expectedStatusCode int
+	}{
+		{"Valid - all devices", "", "", dic, false, http.StatusOK},
+		{"Valid - by device name", testDeviceName, "1", dic, false, http.StatusOK},
+		{"Invalid - invalid limit", "", "invalid", dic, true, http.StatusBadRequest},
+		{"Unavailable - audit disabled", "", "", NewMockDIC(), true, http.StatusServiceUnavailable},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, pkgCommon.ApiCommandAuditRoute, http.NoBody)
+			query := req.URL.Query()
+			if testCase.limit != "" {
+				query.Add(common.Limit, testCase.limit)
+			}
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(testCase.deviceName)
+			err := NewCommandController(testCase.dic).AllCommandAuditRecords(c)
+			require.NoError(t, err)
+
+			// Assert
+			var res responses.MultiCommandAuditRecordsResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.errorExpected {
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				return
+			}
+			assert.Equal(t, uint32(1), res.TotalCount)
+			require.Len(t, res.Records, 1)
+			assert.Equal(t, "id1", res.Records[0].Id)
+		})
+	}
+}
@@ -6,9 +6,11 @@
 package http
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"net/http"
+	"time"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
@@ -19,6 +21,9 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
 	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 
 	"github.com/labstack/echo/v4"
@@ -96,18 +101,27 @@ func (cc *CommandController) IssueGetCommandByName(c echo.Context) error {
 	w := c.Response()
 	ctx := r.Context()
 
+	received := time.Now()
+
 	// URL parameters
 	deviceName := c.Param(common.Name)
 	commandName := c.Param(common.Command)
 
+	audit := newCommandAuditRecord(r, deviceName, commandName, pkgDtos.BatchCommandMethodGet)
+	defer func() {
+		application.AuditCommand(audit, received, cc.dic)
+	}()
+
 	// Query params
 	queryParams := r.URL.RawQuery
 	err := validateGetCommandParameters(r)
 	if err != nil {
+		application.SetCommandAuditResult(&audit, err)
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
 
 	response, err := application.IssueGetCommandByName(deviceName, commandName, queryParams, cc.dic)
+	application.SetCommandAuditResult(&audit, err)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
@@ -127,23 +141,72 @@ func (cc *CommandController) IssueSetCommandByName(c echo.Context) error {
 	w := c.Response()
 	ctx := r.Context()
 
+	received := time.Now()
+
 	// URL parameters
 	deviceName := c.Param(common.Name)
 	commandName := c.Param(common.Command)
 	// Query params
 	queryParams := r.URL.RawQuery
 
+	audit := newCommandAuditRecord(r, deviceName, commandName, pkgDtos.BatchCommandMethodSet)
+	defer func() {
+		application.AuditCommand(audit, received, cc.dic)
+	}()
+
 	// Request body
 	settings, err := utils.ParseBodyToMap(r)
 	if err != nil {
+		application.SetCommandAuditResult(&audit, err)
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
+	audit.Settings = settings
 	response, err := application.IssueSetCommandByName(deviceName, commandName, queryParams, settings, cc.dic)
+	application.SetCommandAuditResult(&audit, err)
+	if validationErr, ok := err.(application.SettingsValidationError); ok {
+		lc.Error(validationErr.Error(), common.CorrelationHeader, correlation.FromContext(ctx))
+		utils.WriteHttpHeader(w, ctx, http.StatusBadRequest)
+		return pkg.EncodeAndWriteResponse(responses.NewSettingsValidationResponse("", validationErr.Message(), validationErr.Fields), w, lc)
+	}
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
+	audit.StatusCode = response.StatusCode
 
 	utils.WriteHttpHeader(w, ctx, response.StatusCode)
 	// encode and send out the response
 	return pkg.EncodeAndWriteResponse(response, w, lc)
 }
+
+func (cc *CommandController) IssueBatchCommand(c echo.Context) error {
+	lc := container.LoggingClientFrom(cc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	var request pkgDtos.BatchCommandRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		edgexErr := errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the batch command request", err)
+		return utils.WriteErrorResponse(w, ctx, lc, edgexErr, "")
+	}
+
+	issuer := application.LimitedDeviceCommandIssuer(application.HttpDeviceCommandIssuer(cc.dic), cc.dic)
+	issuer = application.AuditedDeviceCommandIssuer(application.ValidatingDeviceCommandIssuer(issuer, cc.dic), newCommandAuditRecord(r, "", "", ""), cc.dic)
+	results, err := application.IssueBatchCommand(ctx, request, issuer, cc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	// the batch only succeeds as a whole when the command succeeded for every device
+	statusCode := http.StatusOK
+	for _, result := range results {
+		if result.StatusCode != http.StatusOK {
+			statusCode = http.StatusMultiStatus
+			break
+		}
+	}
+	response := responses.NewBatchCommandResponse("", "", statusCode, results)
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	// encode and send out the response
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
@@ -16,6 +16,8 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
 	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
@@ -32,6 +34,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -148,6 +151,30 @@ func buildDeviceProfileResponse() responseDTO.DeviceProfileResponse {
 	return deviceResponse
 }
 
+func buildSetCommandProfileResponse() responseDTO.DeviceProfileResponse {
+	minimum, maximum := float64(10), float64(40)
+	profile := dtos.DeviceProfile{
+		DeviceProfileBasicInfo: dtos.DeviceProfileBasicInfo{Name: testProfileName},
+		DeviceResources: []dtos.DeviceResource{
+			{Name: "AHU-TargetTemperature", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_RW, Minimum: &minimum, Maximum: &maximum}},
+			{Name: "AHU-TargetBand", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeFloat32, ReadWrite: common.ReadWrite_RW}},
+			{Name: "AHU-TargetHumidity", Properties: dtos.ResourceProperties{ValueType: common.ValueTypeObject, ReadWrite: common.ReadWrite_RW}},
+		},
+		DeviceCommands: []dtos.DeviceCommand{
+			{
+				Name:      testCommandName,
+				ReadWrite: common.ReadWrite_RW,
+				ResourceOperations: []dtos.ResourceOperation{
+					{DeviceResource: "AHU-TargetTemperature"},
+					{DeviceResource: "AHU-TargetBand"},
+					{DeviceResource: "AHU-TargetHumidity"},
+				},
+			},
+		},
+	}
+	return responseDTO.NewDeviceProfileResponse("", "", http.StatusOK, profile)
+}
+
 func buildDeviceServiceResponse() responseDTO.DeviceServiceResponse {
 	service := dtos.DeviceService{
 		Name:        testDeviceServiceName,
@@ -437,11 +464,20 @@ func TestIssueSetCommand(t *testing.T) {
 	dcMock.On("DeviceByName", context.Background(), testDeviceName).Return(expectedDeviceResponse, nil)
 	dcMock.On("DeviceByName", context.Background(), nonExistName).Return(responseDTO.DeviceResponse{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "fail to query device by name", nil))
 
+	dpcMock := &mocks.DeviceProfileClient{}
+	dpcMock.On("DeviceProfileByName", context.Background(), testProfileName).Return(buildSetCommandProfileResponse(), nil)
+
 	dscMock := &mocks.DeviceServiceClient{}
 	dscMock.On("DeviceServiceByName", context.Background(), testDeviceServiceName).Return(expectedDeviceServiceResponse, nil)
 
 	testSettings := buildTestSettings()
 	testSettingsJsonStr, _ := json.Marshal(testSettings)
+	outOfRangeSettings := buildTestSettings()
+	outOfRangeSettings["AHU-TargetTemperature"] = "45"
+	outOfRangeSettingsJsonStr, _ := json.Marshal(outOfRangeSettings)
+	invalidTypeSettings := buildTestSettings()
+	invalidTypeSettings["AHU-TargetBand"] = "high"
+	invalidTypeSettingsJsonStr, _ := json.Marshal(invalidTypeSettings)
 	dsccMock := &mocks.DeviceServiceCommandClient{}
 	dsccMock.On("SetCommandWithObject", context.Background(), testBaseAddress, testDeviceName, testCommandName, testQueryStrings, testSettings).Return(expectedBaseResponse, nil)
 	dsccMock.On("SetCommandWithObject", context.Background(), testBaseAddress, testDeviceName, testCommandName, "", testSettings).Return(expectedBaseResponse, nil)
@@ -453,6 +489,9 @@ func TestIssueSetCommand(t *testing.T) {
 		bootstrapContainer.DeviceClientName: func(get di.Get) interface{} {
 			return dcMock
 		},
+		bootstrapContainer.DeviceProfileClientName: func(get di.Get) interface{} {
+			return dpcMock
+		},
 		bootstrapContainer.DeviceServiceClientName: func(get di.Get) interface{} {
 			return dscMock
 		},
@@ -464,21 +503,24 @@ func TestIssueSetCommand(t *testing.T) {
 	assert.NotNil(t, cc)
 
 	tests := []struct {
-		name               string
-		deviceName         string
-		commandName        string
-		queryStrings       string
-		settings           []byte
-		errorExpected      bool
-		expectedStatusCode int
+		name                string
+		deviceName          string
+		commandName         string
+		queryStrings        string
+		settings            []byte
+		errorExpected       bool
+		expectedStatusCode  int
+		expectedFieldErrors []string
 	}{
-		{"Valid - execute set command with valid deviceName, commandName, query strings, and settings", testDeviceName, testCommandName, testQueryStrings, testSettingsJsonStr, false, http.StatusOK},
-		{"Valid - empty query strings", testDeviceName, testCommandName, "", testSettingsJsonStr, false, http.StatusOK},
-		{"Invalid - execute set command with invalid deviceName", nonExistName, testCommandName, testQueryStrings, testSettingsJsonStr, true, http.StatusNotFound},
-		{"Invalid - execute set command with invalid commandName", testDeviceName, nonExistName, testQueryStrings, testSettingsJsonStr, true, http.StatusBadRequest},
-		{"Invalid - empty device name", "", testCommandName, testQueryStrings, testSettingsJsonStr, true, http.StatusBadRequest},
-		{"Invalid - empty command name", testDeviceName, "", testQueryStrings, testSettingsJsonStr, true, http.StatusBadRequest},
-		{"Invalid - empty settings", testDeviceName, testCommandName, testQueryStrings, []byte{}, true, http.StatusBadRequest},
+		{"Valid - execute set command with valid deviceName, commandName, query strings, and settings", testDeviceName, testCommandName, testQueryStrings, testSettingsJsonStr, false, http.StatusOK, nil},
+		{"Valid - empty query strings", testDeviceName, testCommandName, "", testSettingsJsonStr, false, http.StatusOK, nil},
+		{"Invalid - execute set command with invalid deviceName", nonExistName, testCommandName, testQueryStrings, testSettingsJsonStr, true, http.StatusNotFound, nil},
+		{"Invalid - execute set command with invalid commandName", testDeviceName, nonExistName, testQueryStrings, testSettingsJsonStr, true, http.StatusNotFound, nil},
+		{"Invalid - empty device name", "", testCommandName, testQueryStrings, testSettingsJsonStr, true, http.StatusBadRequest, nil},
+		{"Invalid - empty command name", testDeviceName, "", testQueryStrings, testSettingsJsonStr, true, http.StatusBadRequest, nil},
+		{"Invalid - empty settings", testDeviceName, testCommandName, testQueryStrings, []byte{}, true, http.StatusBadRequest, nil},
+		{"Invalid - value out of range", testDeviceName, testCommandName, testQueryStrings, outOfRangeSettingsJsonStr, true, http.StatusBadRequest, []string{"AHU-TargetTemperature"}},
+		{"Invalid - value of invalid type", testDeviceName, testCommandName, testQueryStrings, invalidTypeSettingsJsonStr, true, http.StatusBadRequest, []string{"AHU-TargetBand"}},
 	}
 	for _, testCase := range tests {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -499,7 +541,7 @@ func TestIssueSetCommand(t *testing.T) {
 			assert.NoError(t, err)
 
 			// Assert
-			var res commonDTO.BaseResponse
+			var res responses.SettingsValidationResponse
 			err = json.Unmarshal(recorder.Body.Bytes(), &res)
 			require.NoError(t, err)
 			assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
@@ -510,6 +552,91 @@ func TestIssueSetCommand(t *testing.T) {
 			} else {
 				assert.Empty(t, res.Message, "Message should be empty when it is successful")
 			}
+			require.Len(t, res.Errors, len(testCase.expectedFieldErrors), "Field errors not as expected")
+			for i, field := range testCase.expectedFieldErrors {
+				assert.Equal(t, field, res.Errors[i].Field, "Field error not as expected")
+			}
+		})
+	}
+}
+
+func TestIssueBatchCommand(t *testing.T) {
+	var nonExistName = "nonExist"
+
+	expectedDeviceResponse := buildDeviceResponse()
+	expectedDeviceServiceResponse := buildDeviceServiceResponse()
+	expectedEventResponse := responseDTO.EventResponse{
+		BaseResponse: commonDTO.NewBaseResponse("", "", http.StatusOK),
+		Event:        dtos.Event{DeviceName: testDeviceName, SourceName: testCommandName},
+	}
+
+	dcMock := &mocks.DeviceClient{}
+	dcMock.On("DeviceByName", mock.Anything, testDeviceName).Return(expectedDeviceResponse, nil)
+	dcMock.On("DeviceByName", mock.Anything, nonExistName).Return(responseDTO.DeviceResponse{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "fail to query device by name", nil))
+
+	dscMock := &mocks.DeviceServiceClient{}
+	dscMock.On("DeviceServiceByName", mock.Anything, testDeviceServiceName).Return(expectedDeviceServiceResponse, nil)
+
+	dsccMock := &mocks.DeviceServiceCommandClient{}
+	dsccMock.On("GetCommand", mock.Anything, testBaseAddress, testDeviceName, testCommandName, "ds-pushevent=false").Return(&expectedEventResponse, nil)
+
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		bootstrapContainer.DeviceClientName: func(get di.Get) interface{} {
+			return dcMock
+		},
+		bootstrapContainer.DeviceServiceClientName: func(get di.Get) interface{} {
+			return dscMock
+		},
+		bootstrapContainer.DeviceServiceCommandClientName: func(get di.Get) interface{} {
+			return dsccMock
+		},
+	})
+	cc := NewCommandController(dic)
+	assert.NotNil(t, cc)
+
+	tests := []struct {
+		name                string
+		request             string
+		errorExpected       bool
+		expectedStatusCode  int
+		expectedResultCodes []int
+	}{
+		{"Valid - all devices succeed", `{"deviceNames":["testDevice"],"commandName":"testCommand","method":"get","queryParams":{"ds-pushevent":"false"}}`, false, http.StatusOK, []int{http.StatusOK}},
+		{"Valid - some devices fail", `{"deviceNames":["testDevice","nonExist"],"commandName":"testCommand","method":"get","queryParams":{"ds-pushevent":"false"}}`, false, http.StatusMultiStatus, []int{http.StatusOK, http.StatusNotFound}},
+		{"Invalid - no device selected", `{"commandName":"testCommand","method":"get"}`, true, http.StatusBadRequest, nil},
+		{"Invalid - invalid query parameter", `{"deviceNames":["testDevice"],"commandName":"testCommand","method":"get","queryParams":{"ds-pushevent":"no"}}`, true, http.StatusBadRequest, nil},
+		{"Invalid - malformed request", `{"deviceNames":`, true, http.StatusBadRequest, nil},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, pkgCommon.ApiDeviceBatchCommandRoute, bytes.NewBufferString(testCase.request))
+
+			// Act
+			recorder := httptest.NewRecorder()
+			handler := echo.HandlerFunc(cc.IssueBatchCommand)
+			c := e.NewContext(req, recorder)
+			err := handler(c)
+			assert.NoError(t, err)
+
+			// Assert
+			var res responses.BatchCommandResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
+			if testCase.errorExpected {
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				return
+			}
+			require.Len(t, res.Results, len(testCase.expectedResultCodes))
+			for i, result := range res.Results {
+				assert.Equal(t, testCase.expectedResultCodes[i], result.StatusCode, "Result status code not as expected")
+			}
+			require.NotNil(t, res.Results[0].Event)
+			assert.Equal(t, testDeviceName, res.Results[0].Event.DeviceName)
 		})
 	}
 }
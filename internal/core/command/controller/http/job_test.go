@@ -0,0 +1,175 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	contractResponses "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces/mocks"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos/requests"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+const testJobName = "testJob"
+
+func buildAddCommandJobRequest() requests.AddCommandJobRequest {
+	return requests.AddCommandJobRequest{
+		BaseRequest: commonDTO.NewBaseRequest(),
+		Job: dtos.CommandJob{
+			Name:        testJobName,
+			DeviceName:  testDeviceName,
+			CommandName: testCommandName,
+			Method:      "get",
+			Interval:    "10m",
+		},
+	}
+}
+
+func TestAddCommandJob(t *testing.T) {
+	dcMock := &mocks.DeviceClient{}
+	dcMock.On("DeviceByName", context.Background(), testDeviceName).Return(contractResponses.DeviceResponse{}, nil)
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddCommandJob", mock.Anything).Return(models.CommandJob{Id: "id1", Name: testJobName, Status: models.CommandJobStatusScheduled}, nil)
+	schedulerMock := &dbMock.CommandJobScheduler{}
+	schedulerMock.On("Schedule", mock.Anything).Return(nil)
+
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		container.DeviceClientName: func(get di.Get) interface{} {
+			return dcMock
+		},
+		commandContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		commandContainer.CommandJobSchedulerName: func(get di.Get) interface{} {
+			return schedulerMock
+		},
+	})
+
+	valid := buildAddCommandJobRequest()
+	noName := buildAddCommandJobRequest()
+	noName.Job.Name = ""
+	invalidMethod := buildAddCommandJobRequest()
+	invalidMethod.Job.Method = "post"
+	invalidInterval := buildAddCommandJobRequest()
+	invalidInterval.Job.Interval = "-1s"
+	setWithoutSettings := buildAddCommandJobRequest()
+	setWithoutSettings.Job.Method = "set"
+
+	tests := []struct {
+		name               string
+		request            []requests.AddCommandJobRequest
+		expectedStatusCode int
+	}{
+		{"Valid", []requests.AddCommandJobRequest{valid}, http.StatusCreated},
+		{"Invalid - no name", []requests.AddCommandJobRequest{noName}, http.StatusBadRequest},
+		{"Invalid - invalid method", []requests.AddCommandJobRequest{invalidMethod}, http.StatusBadRequest},
+		{"Invalid - negative interval", []requests.AddCommandJobRequest{invalidInterval}, http.StatusBadRequest},
+		{"Invalid - set without settings", []requests.AddCommandJobRequest{setWithoutSettings}, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			jsonData, err := json.Marshal(testCase.request)
+			require.NoError(t, err)
+			req := httptest.NewRequest(http.MethodPost, pkgCommon.ApiCommandJobRoute, bytes.NewReader(jsonData))
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = NewCommandJobController(dic).AddCommandJob(c)
+			require.NoError(t, err)
+
+			// Assert
+			if testCase.expectedStatusCode != http.StatusCreated {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				return
+			}
+			var res []commonDTO.BaseWithIdResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusMultiStatus, recorder.Result().StatusCode, "HTTP status code not as expected")
+			require.Len(t, res, 1)
+			assert.Equal(t, testCase.expectedStatusCode, res[0].StatusCode)
+			assert.Equal(t, "id1", res[0].Id)
+		})
+	}
+}
+
+func TestCommandJobByName(t *testing.T) {
+	notFoundName := "notFoundJob"
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("CommandJobByName", testJobName).Return(models.CommandJob{Id: "id1", Name: testJobName}, nil)
+	dbClientMock.On("CommandJobByName", notFoundName).Return(models.CommandJob{},
+		errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "command job doesn't exist", nil))
+
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		commandContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	tests := []struct {
+		name               string
+		jobName            string
+		errorExpected      bool
+		expectedStatusCode int
+	}{
+		{"Valid", testJobName, false, http.StatusOK},
+		{"Invalid - empty name", "", true, http.StatusBadRequest},
+		{"Invalid - not found", notFoundName, true, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, pkgCommon.ApiCommandJobByNameRoute, http.NoBody)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(testCase.jobName)
+			err := NewCommandJobController(dic).CommandJobByName(c)
+			require.NoError(t, err)
+
+			// Assert
+			var res responses.CommandJobResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.errorExpected {
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				return
+			}
+			assert.Equal(t, testJobName, res.Job.Name)
+		})
+	}
+}
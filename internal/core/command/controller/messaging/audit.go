@@ -0,0 +1,52 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// newCommandAuditRecord creates the audit record of a command received through a message bus.  The settings of a set
+// command are recorded when they can be decoded from the request payload.
+func newCommandAuditRecord(requestEnvelope types.MessageEnvelope, deviceName string, commandName string, method string, transport string) models.CommandAuditRecord {
+	record := models.CommandAuditRecord{
+		DeviceName:    deviceName,
+		CommandName:   commandName,
+		Method:        strings.ToLower(method),
+		QueryParams:   requestEnvelope.QueryParams,
+		CorrelationId: requestEnvelope.CorrelationID,
+		Transport:     transport,
+	}
+	if record.Method == pkgDtos.BatchCommandMethodSet {
+		_ = json.Unmarshal(requestEnvelope.Payload, &record.Settings)
+	}
+	return record
+}
+
+// setCommandAuditError sets the result of an audit record from the error the command failed with before reaching the
+// device service
+func setCommandAuditError(record *models.CommandAuditRecord, kind errors.ErrKind, err error) {
+	application.SetCommandAuditResult(record, errors.NewCommonEdgeX(kind, err.Error(), nil))
+}
+
+// setCommandAuditResponse sets the result of an audit record from the response of the device service, err being the
+// error the request to the device service failed with
+func setCommandAuditResponse(record *models.CommandAuditRecord, response *types.MessageEnvelope, err error) {
+	switch {
+	case err != nil:
+		application.SetCommandAuditResult(record, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "request to the device service failed", err))
+	case response.ErrorCode == 1:
+		application.SetCommandAuditResult(record, errors.NewCommonEdgeX(errors.KindServerError, string(response.Payload), nil))
+	default:
+		application.SetCommandAuditResult(record, nil)
+	}
+}
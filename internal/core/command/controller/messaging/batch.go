@@ -0,0 +1,218 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-messaging/v3/messaging"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// SubscribeBatchCommandRequests subscribes batch command requests from EdgeX service (e.g., Application Service)
+// and issues them to the Device Services of the selected devices via internal MessageBus
+func SubscribeBatchCommandRequests(ctx context.Context, requestTimeout time.Duration, dic *di.Container) errors.EdgeX {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	baseTopic := container.ConfigurationFrom(dic.Get).MessageBus.GetBaseTopicPrefix()
+	batchRequestTopic := common.BuildTopic(baseTopic, pkgCommon.CoreCommandBatchRequestTopic)
+
+	messages := make(chan types.MessageEnvelope)
+	messageErrors := make(chan error)
+	topics := []types.TopicChannel{
+		{
+			Topic:    batchRequestTopic,
+			Messages: messages,
+		},
+	}
+
+	messageBus := bootstrapContainer.MessagingClientFrom(dic.Get)
+
+	lc.Infof("Subscribing to internal batch command requests on topic: %s", batchRequestTopic)
+
+	err := messageBus.Subscribe(topics, messageErrors)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				lc.Infof("Exiting waiting for MessageBus '%s' topic messages", batchRequestTopic)
+				return
+			case err = <-messageErrors:
+				lc.Error(err.Error())
+			case requestEnvelope := <-messages:
+				// each request is processed in its own goroutine so that the commands held by the command limits of a
+				// device don't hold up the requests for the other devices
+				go processBatchCommandRequest(messageBus, requestEnvelope, baseTopic, requestTimeout, lc, dic)
+			}
+		}
+	}()
+
+	return nil
+}
+
+func processBatchCommandRequest(
+	messageBus messaging.MessageClient,
+	requestEnvelope types.MessageEnvelope,
+	baseTopic string,
+	requestTimeout time.Duration,
+	lc logger.LoggingClient,
+	dic *di.Container) {
+	lc.Debugf("Batch command request received on internal MessageBus. Topic: %s, Request-id: %s, Correlation-id: %s", requestEnvelope.ReceivedTopic, requestEnvelope.RequestID, requestEnvelope.CorrelationID)
+
+	if len(strings.TrimSpace(requestEnvelope.RequestID)) == 0 {
+		lc.Errorf("RequestId not set in batch command request received on internal MessageBus")
+		lc.Warn("Not publishing error message back due to insufficient information to publish on response topic")
+		return
+	}
+
+	responseEnvelope, err := getBatchCommandResponseEnvelope(requestEnvelope, messageBus, requestTimeout, models.CommandAuditTransportInternalMessageBus, dic)
+	if err != nil {
+		lc.Error(err.Error())
+		responseEnvelope = types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, err.Error())
+	}
+
+	// internal response topic scheme: <ResponseTopicPrefix>/<service-name>/<request-id>
+	internalResponseTopic := common.BuildTopic(baseTopic, common.ResponseTopic, common.CoreCommandServiceKey, requestEnvelope.RequestID)
+	err = messageBus.Publish(responseEnvelope, internalResponseTopic)
+	if err != nil {
+		lc.Errorf("Could not publish to topic '%s': %s", internalResponseTopic, err.Error())
+		return
+	}
+
+	lc.Debugf("Batch command response sent to internal MessageBus. Topic: %s, Correlation-id: %s", internalResponseTopic, requestEnvelope.CorrelationID)
+}
+
+func batchCommandRequestHandler(requestTimeout time.Duration, dic *di.Container) mqtt.MessageHandler {
+	return func(client mqtt.Client, message mqtt.Message) {
+		lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+		lc.Debugf("Received batch command request from external message broker on topic '%s' with %d bytes", message.Topic(), len(message.Payload()))
+
+		requestEnvelope, err := types.NewMessageEnvelopeFromJSON(message.Payload())
+		if err != nil {
+			lc.Errorf("Failed to decode request MessageEnvelope: %s", err.Error())
+			lc.Warn("Not publishing error message back due to insufficient information on response topic")
+			return
+		}
+
+		externalMQTTInfo := container.ConfigurationFrom(dic.Get).ExternalMQTT
+		responseTopic := externalMQTTInfo.Topics[pkgCommon.CommandBatchResponseTopicKey]
+		if responseTopic == "" {
+			lc.Errorf("%s not provided in External.Topics", pkgCommon.CommandBatchResponseTopicKey)
+			lc.Warn("Not publishing error message back due to insufficient information on response topic")
+			return
+		}
+
+		internalMessageBus := bootstrapContainer.MessagingClientFrom(dic.Get)
+		responseEnvelope, err := getBatchCommandResponseEnvelope(requestEnvelope, internalMessageBus, requestTimeout, models.CommandAuditTransportExternalMQTT, dic)
+		if err != nil {
+			responseEnvelope = types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, err.Error())
+		}
+
+		responseEnvelope.ReceivedTopic = responseTopic
+		publishMessage(client, responseTopic, externalMQTTInfo.QoS, externalMQTTInfo.Retain, responseEnvelope, lc)
+	}
+}
+
+// getBatchCommandResponseEnvelope issues the batch command carried by the request envelope and returns the
+// MessageEnvelope containing the BatchCommandResponse payload bytes.  The commands are recorded in the audit trail as
+// received through the specified transport.
+func getBatchCommandResponseEnvelope(requestEnvelope types.MessageEnvelope, messageBus messaging.MessageClient, requestTimeout time.Duration, transport string, dic *di.Container) (types.MessageEnvelope, error) {
+	var request pkgDtos.BatchCommandRequest
+	if err := json.Unmarshal(requestEnvelope.Payload, &request); err != nil {
+		return types.MessageEnvelope{}, fmt.Errorf("failed to decode batch command request payload: %s", err.Error())
+	}
+
+	audit := models.CommandAuditRecord{CorrelationId: requestEnvelope.CorrelationID, Transport: transport}
+	issuer := application.LimitedDeviceCommandIssuer(messageBusDeviceCommandIssuer(messageBus, requestTimeout, dic), dic)
+	issuer = application.AuditedDeviceCommandIssuer(application.ValidatingDeviceCommandIssuer(issuer, dic), audit, dic)
+	results, edgexError := application.IssueBatchCommand(context.Background(), request, issuer, dic)
+	if edgexError != nil {
+		return types.MessageEnvelope{}, fmt.Errorf("failed to issue batch command: %s", edgexError.Error())
+	}
+
+	statusCode := http.StatusOK
+	for _, result := range results {
+		if result.StatusCode != http.StatusOK {
+			statusCode = http.StatusMultiStatus
+			break
+		}
+	}
+	responseBytes, err := json.Marshal(pkgResponses.NewBatchCommandResponse(requestEnvelope.RequestID, "", statusCode, results))
+	if err != nil {
+		return types.MessageEnvelope{}, fmt.Errorf("failed to json encoding batch command payload: %s", err.Error())
+	}
+
+	responseEnvelope, err := types.NewMessageEnvelopeForResponse(responseBytes, requestEnvelope.RequestID, requestEnvelope.CorrelationID, common.ContentTypeJSON)
+	if err != nil {
+		return types.MessageEnvelope{}, fmt.Errorf("failed to create response MessageEnvelope: %s", err.Error())
+	}
+
+	return responseEnvelope, nil
+}
+
+// messageBusDeviceCommandIssuer returns the DeviceCommandIssuer issuing the commands to the device services via
+// internal MessageBus, each command being a request of its own
+func messageBusDeviceCommandIssuer(messageBus messaging.MessageClient, requestTimeout time.Duration, dic *di.Container) application.DeviceCommandIssuer {
+	return func(_ context.Context, device dtos.Device, service dtos.DeviceService, request pkgDtos.BatchCommandRequest) (*dtos.Event, errors.EdgeX) {
+		config := container.ConfigurationFrom(dic.Get)
+		baseTopic := config.MessageBus.GetBaseTopicPrefix()
+
+		var payload []byte
+		if request.Method == pkgDtos.BatchCommandMethodSet {
+			var err error
+			payload, err = json.Marshal(request.Settings)
+			if err != nil {
+				return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to encode the settings", err)
+			}
+		}
+		requestEnvelope := types.NewMessageEnvelopeForRequest(payload, request.QueryParams)
+
+		// internal command request topic scheme: <DeviceRequestTopicPrefix>/<device-service>/<device>/<command-name>/<method>
+		topicPrefix := common.BuildTopic(baseTopic, common.CoreCommandDeviceRequestPublishTopic)
+		deviceRequestTopic := common.NewPathBuilder().EnableNameFieldEscape(config.Service.EnableNameFieldEscape).
+			SetPath(topicPrefix).SetNameFieldPath(service.Name).SetNameFieldPath(device.Name).SetNameFieldPath(request.CommandName).SetPath(request.Method).BuildPath()
+		deviceResponseTopicPrefix := common.NewPathBuilder().EnableNameFieldEscape(config.Service.EnableNameFieldEscape).
+			SetPath(baseTopic).SetPath(common.ResponseTopic).SetNameFieldPath(service.Name).BuildPath()
+
+		response, err := messageBus.Request(requestEnvelope, deviceRequestTopic, deviceResponseTopicPrefix, requestTimeout)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindServiceUnavailable, fmt.Sprintf("request to topic '%s' failed", deviceRequestTopic), err)
+		}
+		if response.ErrorCode == 1 {
+			return nil, errors.NewCommonEdgeX(errors.KindServerError, string(response.Payload), nil)
+		}
+		if request.Method == pkgDtos.BatchCommandMethodSet || len(response.Payload) == 0 {
+			return nil, nil
+		}
+
+		var eventResponse responses.EventResponse
+		if err = json.Unmarshal(response.Payload, &eventResponse); err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to decode the event response", err)
+		}
+		return &eventResponse.Event, nil
+	}
+}
@@ -7,6 +7,7 @@
 package messaging
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -18,10 +19,14 @@ import (
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 
 	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
 )
 
 func OnConnectHandler(requestTimeout time.Duration, dic *di.Container) mqtt.OnConnectHandler {
@@ -39,11 +44,19 @@ func OnConnectHandler(requestTimeout time.Duration, dic *di.Container) mqtt.OnCo
 		}
 
 		requestCommandTopic := externalTopics[common.CommandRequestTopicKey]
-		if token := client.Subscribe(requestCommandTopic, qos, commandRequestHandler(requestTimeout, dic)); token.Wait() && token.Error() != nil {
+		if token := client.Subscribe(requestCommandTopic, qos, concurrentMessageHandler(commandRequestHandler(requestTimeout, dic))); token.Wait() && token.Error() != nil {
 			lc.Errorf("could not subscribe to topic '%s': %s", requestCommandTopic, token.Error().Error())
 		} else {
 			lc.Debugf("Subscribed to topic '%s' on external MQTT broker", requestCommandTopic)
 		}
+
+		if requestBatchTopic := externalTopics[pkgCommon.CommandBatchRequestTopicKey]; requestBatchTopic != "" {
+			if token := client.Subscribe(requestBatchTopic, qos, concurrentMessageHandler(batchCommandRequestHandler(requestTimeout, dic))); token.Wait() && token.Error() != nil {
+				lc.Errorf("could not subscribe to topic '%s': %s", requestBatchTopic, token.Error().Error())
+			} else {
+				lc.Debugf("Subscribed to topic '%s' on external MQTT broker", requestBatchTopic)
+			}
+		}
 	}
 }
 
@@ -94,6 +107,7 @@ func commandQueryHandler(dic *di.Container) mqtt.MessageHandler {
 
 func commandRequestHandler(requestTimeout time.Duration, dic *di.Container) mqtt.MessageHandler {
 	return func(client mqtt.Client, message mqtt.Message) {
+		received := time.Now()
 		lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 		config := container.ConfigurationFrom(dic.Get)
 		lc.Debugf("Received command request from external message broker on topic '%s' with %d bytes", message.Topic(), len(message.Payload()))
@@ -131,7 +145,14 @@ func commandRequestHandler(requestTimeout time.Duration, dic *di.Container) mqtt
 			return
 		}
 		method := topicLevels[length-1]
+
+		audit := newCommandAuditRecord(requestEnvelope, deviceName, commandName, method, models.CommandAuditTransportExternalMQTT)
+		defer func() {
+			application.AuditCommand(audit, received, dic)
+		}()
+
 		if !strings.EqualFold(method, "get") && !strings.EqualFold(method, "set") {
+			setCommandAuditError(&audit, errors.KindContractInvalid, fmt.Errorf("unknown request method: %s", method))
 			lc.Errorf("Unknown request method: %s, only 'get' or 'set' is allowed", method)
 			lc.Warn("Not publishing error message back due to insufficient information on response topic")
 			return
@@ -144,6 +165,7 @@ func commandRequestHandler(requestTimeout time.Duration, dic *di.Container) mqtt
 
 		deviceServiceName, err := retrieveServiceNameByDevice(deviceName, dic)
 		if err != nil {
+			setCommandAuditError(&audit, errors.KindEntityDoesNotExist, err)
 			responseEnvelope := types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, err.Error())
 			publishMessage(client, externalResponseTopic, qos, retain, responseEnvelope, lc)
 			return
@@ -151,11 +173,22 @@ func commandRequestHandler(requestTimeout time.Duration, dic *di.Container) mqtt
 
 		err = validateGetCommandQueryParameters(requestEnvelope.QueryParams)
 		if err != nil {
+			setCommandAuditError(&audit, errors.KindContractInvalid, err)
 			responseEnvelope := types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, err.Error())
 			publishMessage(client, externalResponseTopic, qos, retain, responseEnvelope, lc)
 			return
 		}
 
+		if strings.EqualFold(method, "set") {
+			if edgexErr := validateSetCommandRequest(requestEnvelope, deviceName, commandName, dic); edgexErr != nil {
+				application.SetCommandAuditResult(&audit, edgexErr)
+				lc.Error(edgexErr.Error())
+				responseEnvelope := newSetCommandErrorEnvelope(requestEnvelope.RequestID, edgexErr)
+				publishMessage(client, externalResponseTopic, qos, retain, responseEnvelope, lc)
+				return
+			}
+		}
+
 		deviceRequestTopic := common.NewPathBuilder().EnableNameFieldEscape(config.Service.EnableNameFieldEscape).
 			SetPath(topicPrefix).SetNameFieldPath(deviceServiceName).SetNameFieldPath(deviceName).SetNameFieldPath(commandName).SetPath(method).BuildPath()
 		deviceResponseTopicPrefix := common.NewPathBuilder().EnableNameFieldEscape(config.Service.EnableNameFieldEscape).
@@ -166,8 +199,17 @@ func commandRequestHandler(requestTimeout time.Duration, dic *di.Container) mqtt
 
 		internalMessageBus := bootstrapContainer.MessagingClientFrom(dic.Get)
 
+		release, edgexErr := application.AcquireDeviceCommandByName(context.Background(), deviceName, dic)
+		if edgexErr != nil {
+			application.SetCommandAuditResult(&audit, edgexErr)
+			responseEnvelope := types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, edgexErr.Error())
+			publishMessage(client, externalResponseTopic, qos, retain, responseEnvelope, lc)
+			return
+		}
 		// Request waits for the response and returns it.
 		response, err := internalMessageBus.Request(requestEnvelope, deviceRequestTopic, deviceResponseTopicPrefix, requestTimeout)
+		release()
+		setCommandAuditResponse(&audit, response, err)
 		if err != nil {
 			errorMessage := fmt.Sprintf("Failed to send DeviceCommand request with internal MessageBus: %v", err)
 			responseEnvelope := types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, errorMessage)
@@ -182,6 +224,15 @@ func commandRequestHandler(requestTimeout time.Duration, dic *di.Container) mqtt
 	}
 }
 
+// concurrentMessageHandler handles each message in its own goroutine, as the MQTT client otherwise handles the messages
+// one at a time, so that the commands held by the command limits of a device don't hold up the requests for the other
+// devices
+func concurrentMessageHandler(handler mqtt.MessageHandler) mqtt.MessageHandler {
+	return func(client mqtt.Client, message mqtt.Message) {
+		go handler(client, message)
+	}
+}
+
 func publishMessage(client mqtt.Client, responseTopic string, qos byte, retain bool, message types.MessageEnvelope, lc logger.LoggingClient) {
 	if message.ErrorCode == 1 {
 		lc.Error(string(message.Payload))
@@ -14,6 +14,7 @@ import (
 	"testing"
 	"time"
 
+	mqtt "github.com/eclipse/paho.mqtt.golang"
 	clientMocks "github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces/mocks"
 	lcMocks "github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger/mocks"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
@@ -374,6 +375,27 @@ func Test_commandRequestHandler(t *testing.T) {
 	}
 }
 
+func Test_concurrentMessageHandler(t *testing.T) {
+	unblock := make(chan struct{})
+	handled := make(chan struct{}, 2)
+	handler := concurrentMessageHandler(func(client mqtt.Client, message mqtt.Message) {
+		<-unblock
+		handled <- struct{}{}
+	})
+
+	// a message waiting to be handled doesn't hold up the next one
+	handler(nil, nil)
+	handler(nil, nil)
+	close(unblock)
+	for i := 0; i < 2; i++ {
+		select {
+		case <-handled:
+		case <-time.After(time.Second):
+			require.Fail(t, "the messages should be handled")
+		}
+	}
+}
+
 func testCommandQueryPayload() types.MessageEnvelope {
 	payload := types.NewMessageEnvelopeForRequest(nil, nil)
 
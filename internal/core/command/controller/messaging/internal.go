@@ -23,7 +23,9 @@ import (
 
 	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
 )
 
 // SubscribeCommandRequests subscribes command requests from EdgeX service (e.g., Application Service)
@@ -57,7 +59,9 @@ func SubscribeCommandRequests(ctx context.Context, requestTimeout time.Duration,
 			case err = <-messageErrors:
 				lc.Error(err.Error())
 			case requestEnvelope := <-messages:
-				processDeviceCommandRequest(messageBus, requestEnvelope, baseTopic, requestTimeout, lc, dic)
+				// each request is processed in its own goroutine so that the commands held by the command limits of a
+				// device don't hold up the requests for the other devices
+				go processDeviceCommandRequest(messageBus, requestEnvelope, baseTopic, requestTimeout, lc, dic)
 			}
 		}
 	}()
@@ -73,6 +77,7 @@ func processDeviceCommandRequest(
 	lc logger.LoggingClient,
 	dic *di.Container) {
 	var err error
+	received := time.Now()
 	config := container.ConfigurationFrom(dic.Get)
 
 	lc.Debugf("Command device request received on internal MessageBus. Topic: %s, Request-id: %s, Correlation-id: %s", requestEnvelope.ReceivedTopic, requestEnvelope.RequestID, requestEnvelope.CorrelationID)
@@ -117,8 +122,15 @@ func processDeviceCommandRequest(
 		return
 	}
 	method := topicLevels[length-1]
+
+	audit := newCommandAuditRecord(requestEnvelope, deviceName, commandName, method, models.CommandAuditTransportInternalMessageBus)
+	defer func() {
+		application.AuditCommand(audit, received, dic)
+	}()
+
 	if !strings.EqualFold(method, "get") && !strings.EqualFold(method, "set") {
 		err = fmt.Errorf("unknown request method: %s, only 'get' or 'set' is allowed", method)
+		setCommandAuditError(&audit, errors.KindContractInvalid, err)
 		lc.Error(err.Error())
 		responseEnvelope := types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, err.Error())
 		err = messageBus.Publish(responseEnvelope, internalResponseTopic)
@@ -133,6 +145,7 @@ func processDeviceCommandRequest(
 	deviceServiceName, err := retrieveServiceNameByDevice(deviceName, dic)
 	if err != nil {
 		err = fmt.Errorf("invalid request topic: %s", err.Error())
+		setCommandAuditError(&audit, errors.KindEntityDoesNotExist, err)
 		lc.Error(err.Error())
 		responseEnvelope := types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, err.Error())
 		err = messageBus.Publish(responseEnvelope, internalResponseTopic)
@@ -144,6 +157,7 @@ func processDeviceCommandRequest(
 
 	err = validateGetCommandQueryParameters(requestEnvelope.QueryParams)
 	if err != nil {
+		setCommandAuditError(&audit, errors.KindContractInvalid, err)
 		lc.Errorf(err.Error())
 		responseEnvelope := types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, err.Error())
 		err = messageBus.Publish(responseEnvelope, internalResponseTopic)
@@ -153,6 +167,19 @@ func processDeviceCommandRequest(
 		return
 	}
 
+	if strings.EqualFold(method, "set") {
+		if edgexErr := validateSetCommandRequest(requestEnvelope, deviceName, commandName, dic); edgexErr != nil {
+			application.SetCommandAuditResult(&audit, edgexErr)
+			lc.Error(edgexErr.Error())
+			responseEnvelope := newSetCommandErrorEnvelope(requestEnvelope.RequestID, edgexErr)
+			err = messageBus.Publish(responseEnvelope, internalResponseTopic)
+			if err != nil {
+				lc.Errorf("Could not publish to topic '%s': %s", internalResponseTopic, err.Error())
+			}
+			return
+		}
+	}
+
 	deviceRequestTopic := common.NewPathBuilder().EnableNameFieldEscape(config.Service.EnableNameFieldEscape).
 		SetPath(topicPrefix).SetNameFieldPath(deviceServiceName).SetNameFieldPath(deviceName).SetNameFieldPath(commandName).SetPath(method).BuildPath()
 	deviceResponseTopicPrefix := common.NewPathBuilder().EnableNameFieldEscape(config.Service.EnableNameFieldEscape).
@@ -161,7 +188,20 @@ func processDeviceCommandRequest(
 	lc.Debugf("Sending Command Device Request to internal MessageBus. Topic: %s, Correlation-id: %s", deviceRequestTopic, requestEnvelope.CorrelationID)
 	lc.Debugf("Expecting response on topic: %s/%s", deviceResponseTopicPrefix, requestEnvelope.RequestID)
 
+	release, edgexErr := application.AcquireDeviceCommandByName(context.Background(), deviceName, dic)
+	if edgexErr != nil {
+		application.SetCommandAuditResult(&audit, edgexErr)
+		lc.Error(edgexErr.Error())
+		responseEnvelope := types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, edgexErr.Error())
+		err = messageBus.Publish(responseEnvelope, internalResponseTopic)
+		if err != nil {
+			lc.Errorf("Could not publish to topic '%s': %s", internalResponseTopic, err.Error())
+		}
+		return
+	}
 	response, err := messageBus.Request(requestEnvelope, deviceRequestTopic, deviceResponseTopicPrefix, requestTimeout)
+	release()
+	setCommandAuditResponse(&audit, response, err)
 	if err != nil {
 		lc.Errorf("Request to topic '%s' failed: %s", deviceRequestTopic, err.Error())
 		return
@@ -0,0 +1,77 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+)
+
+// SubscribeMetadataSystemEvents subscribes the system events published by core-metadata and evicts the entities they
+// notify the change of from the metadata cache
+func SubscribeMetadataSystemEvents(ctx context.Context, dic *di.Container) errors.EdgeX {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	metadataCache := container.MetadataCacheFrom(dic.Get)
+	if metadataCache == nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "nil MetadataCache returned", nil)
+	}
+	baseTopic := container.ConfigurationFrom(dic.Get).MessageBus.GetBaseTopicPrefix()
+	systemEventTopic := common.BuildTopic(baseTopic, common.SystemEventPublishTopic, common.CoreMetaDataServiceKey, "#")
+
+	messages := make(chan types.MessageEnvelope)
+	messageErrors := make(chan error)
+	topics := []types.TopicChannel{
+		{
+			Topic:    systemEventTopic,
+			Messages: messages,
+		},
+	}
+
+	messageBus := bootstrapContainer.MessagingClientFrom(dic.Get)
+
+	lc.Infof("Subscribing to metadata system events on topic: %s", systemEventTopic)
+
+	err := messageBus.Subscribe(topics, messageErrors)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				lc.Infof("Exiting waiting for MessageBus '%s' topic messages", systemEventTopic)
+				return
+			case err = <-messageErrors:
+				// system events may have been missed, so the cached entries can no longer be trusted
+				lc.Error(err.Error())
+				metadataCache.Clear()
+			case envelope := <-messages:
+				var systemEvent dtos.SystemEvent
+				if err = json.Unmarshal(envelope.Payload, &systemEvent); err != nil {
+					lc.Errorf("Failed to decode system event received on topic '%s', clearing the metadata cache: %s", envelope.ReceivedTopic, err.Error())
+					metadataCache.Clear()
+					continue
+				}
+				if err = metadataCache.Invalidate(systemEvent); err != nil {
+					lc.Errorf("Failed to decode %s system event details, clearing the metadata cache: %s", systemEvent.Type, err.Error())
+					metadataCache.Clear()
+					continue
+				}
+				lc.Debugf("Evicted the %s of the %s system event from the metadata cache", systemEvent.Type, systemEvent.Action)
+			}
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,45 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+)
+
+// validateSetCommandRequest validates the settings carried by the payload of a set command request against the profile
+// of the device
+func validateSetCommandRequest(requestEnvelope types.MessageEnvelope, deviceName string, commandName string, dic *di.Container) errors.EdgeX {
+	var settings map[string]any
+	if err := json.Unmarshal(requestEnvelope.Payload, &settings); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the settings of the set command request", err)
+	}
+	return application.ValidateSetCommandSettings(context.Background(), deviceName, commandName, settings, dic)
+}
+
+// newSetCommandErrorEnvelope creates the response MessageEnvelope of a set command request rejected with err.  The
+// payload is the JSON encoded SettingsValidationResponse reporting the invalid settings when err is a
+// SettingsValidationError, and the error message otherwise.
+func newSetCommandErrorEnvelope(requestId string, err errors.EdgeX) types.MessageEnvelope {
+	responseEnvelope := types.NewMessageEnvelopeWithError(requestId, err.Error())
+	validationErr, ok := err.(application.SettingsValidationError)
+	if !ok {
+		return responseEnvelope
+	}
+	payload, encodeErr := json.Marshal(responses.NewSettingsValidationResponse(requestId, validationErr.Message(), validationErr.Fields))
+	if encodeErr != nil {
+		return responseEnvelope
+	}
+	responseEnvelope.Payload = payload
+	responseEnvelope.ContentType = common.ContentTypeJSON
+	return responseEnvelope
+}
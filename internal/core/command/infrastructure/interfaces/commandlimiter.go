@@ -0,0 +1,17 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interfaces
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// CommandLimiter limits the commands issued concurrently and per second to the devices
+type CommandLimiter interface {
+	// Acquire waits until the limits of the device and of its profile allow a command to be issued to the device, and
+	// returns the function releasing the command once it completes
+	Acquire(ctx context.Context, deviceName string, profileName string) (release func(), err errors.EdgeX)
+}
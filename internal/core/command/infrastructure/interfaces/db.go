@@ -0,0 +1,25 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interfaces
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+type DBClient interface {
+	CloseSession()
+
+	AddCommandAuditRecord(r models.CommandAuditRecord) (models.CommandAuditRecord, errors.EdgeX)
+	CommandAuditRecordsByTimeRange(deviceName string, start int, end int, offset int, limit int) ([]models.CommandAuditRecord, uint32, errors.EdgeX)
+	TrimCommandAuditRecords(maxCount int) errors.EdgeX
+
+	AddCommandJob(job models.CommandJob) (models.CommandJob, errors.EdgeX)
+	CommandJobByName(name string) (models.CommandJob, errors.EdgeX)
+	AllCommandJobs(offset int, limit int) ([]models.CommandJob, errors.EdgeX)
+	UpdateCommandJob(job models.CommandJob) errors.EdgeX
+	DeleteCommandJobByName(name string) errors.EdgeX
+	CommandJobTotalCount() (uint32, errors.EdgeX)
+}
@@ -0,0 +1,17 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interfaces
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// DeviceGroupClient queries the device groups of core-metadata
+type DeviceGroupClient interface {
+	// DevicesByDeviceGroupName returns the devices of the device group, sorted by name, with offset and limit
+	DevicesByDeviceGroupName(ctx context.Context, name string, offset int, limit int) (responses.MultiDevicesResponse, errors.EdgeX)
+}
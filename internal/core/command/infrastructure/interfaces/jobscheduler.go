@@ -0,0 +1,17 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interfaces
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// CommandJobScheduler runs the command jobs at their scheduled time
+type CommandJobScheduler interface {
+	Schedule(job models.CommandJob) errors.EdgeX
+	Unschedule(name string)
+	Stop()
+}
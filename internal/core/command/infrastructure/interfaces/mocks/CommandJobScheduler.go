@@ -0,0 +1,57 @@
+// Code generated by mockery v2.22.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	errors "github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// CommandJobScheduler is an autogenerated mock type for the CommandJobScheduler type
+type CommandJobScheduler struct {
+	mock.Mock
+}
+
+// Schedule provides a mock function with given fields: job
+func (_m *CommandJobScheduler) Schedule(job models.CommandJob) errors.EdgeX {
+	ret := _m.Called(job)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(models.CommandJob) errors.EdgeX); ok {
+		r0 = rf(job)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// Stop provides a mock function with given fields:
+func (_m *CommandJobScheduler) Stop() {
+	_m.Called()
+}
+
+// Unschedule provides a mock function with given fields: name
+func (_m *CommandJobScheduler) Unschedule(name string) {
+	_m.Called(name)
+}
+
+type mockConstructorTestingTNewCommandJobScheduler interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewCommandJobScheduler creates a new instance of CommandJobScheduler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewCommandJobScheduler(t mockConstructorTestingTNewCommandJobScheduler) *CommandJobScheduler {
+	mock := &CommandJobScheduler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,59 @@
+// Code generated by mockery v2.22.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	errors "github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CommandLimiter is an autogenerated mock type for the CommandLimiter type
+type CommandLimiter struct {
+	mock.Mock
+}
+
+// Acquire provides a mock function with given fields: ctx, deviceName, profileName
+func (_m *CommandLimiter) Acquire(ctx context.Context, deviceName string, profileName string) (func(), errors.EdgeX) {
+	ret := _m.Called(ctx, deviceName, profileName)
+
+	var r0 func()
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (func(), errors.EdgeX)); ok {
+		return rf(ctx, deviceName, profileName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) func()); ok {
+		r0 = rf(ctx, deviceName, profileName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(func())
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) errors.EdgeX); ok {
+		r1 = rf(ctx, deviceName, profileName)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewCommandLimiter interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewCommandLimiter creates a new instance of CommandLimiter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewCommandLimiter(t mockConstructorTestingTNewCommandLimiter) *CommandLimiter {
+	mock := &CommandLimiter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,251 @@
+// Code generated by mockery v2.22.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	errors "github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// DBClient is an autogenerated mock type for the DBClient type
+type DBClient struct {
+	mock.Mock
+}
+
+// AddCommandAuditRecord provides a mock function with given fields: r
+func (_m *DBClient) AddCommandAuditRecord(r models.CommandAuditRecord) (models.CommandAuditRecord, errors.EdgeX) {
+	ret := _m.Called(r)
+
+	var r0 models.CommandAuditRecord
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(models.CommandAuditRecord) (models.CommandAuditRecord, errors.EdgeX)); ok {
+		return rf(r)
+	}
+	if rf, ok := ret.Get(0).(func(models.CommandAuditRecord) models.CommandAuditRecord); ok {
+		r0 = rf(r)
+	} else {
+		r0 = ret.Get(0).(models.CommandAuditRecord)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.CommandAuditRecord) errors.EdgeX); ok {
+		r1 = rf(r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddCommandJob provides a mock function with given fields: job
+func (_m *DBClient) AddCommandJob(job models.CommandJob) (models.CommandJob, errors.EdgeX) {
+	ret := _m.Called(job)
+
+	var r0 models.CommandJob
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(models.CommandJob) (models.CommandJob, errors.EdgeX)); ok {
+		return rf(job)
+	}
+	if rf, ok := ret.Get(0).(func(models.CommandJob) models.CommandJob); ok {
+		r0 = rf(job)
+	} else {
+		r0 = ret.Get(0).(models.CommandJob)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.CommandJob) errors.EdgeX); ok {
+		r1 = rf(job)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AllCommandJobs provides a mock function with given fields: offset, limit
+func (_m *DBClient) AllCommandJobs(offset int, limit int) ([]models.CommandJob, errors.EdgeX) {
+	ret := _m.Called(offset, limit)
+
+	var r0 []models.CommandJob
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int, int) ([]models.CommandJob, errors.EdgeX)); ok {
+		return rf(offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []models.CommandJob); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CommandJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// CloseSession provides a mock function with given fields:
+func (_m *DBClient) CloseSession() {
+	_m.Called()
+}
+
+// CommandAuditRecordsByTimeRange provides a mock function with given fields: deviceName, start, end, offset, limit
+func (_m *DBClient) CommandAuditRecordsByTimeRange(deviceName string, start int, end int, offset int, limit int) ([]models.CommandAuditRecord, uint32, errors.EdgeX) {
+	ret := _m.Called(deviceName, start, end, offset, limit)
+
+	var r0 []models.CommandAuditRecord
+	var r1 uint32
+	var r2 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, int, int, int, int) ([]models.CommandAuditRecord, uint32, errors.EdgeX)); ok {
+		return rf(deviceName, start, end, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int, int, int) []models.CommandAuditRecord); ok {
+		r0 = rf(deviceName, start, end, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CommandAuditRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int, int, int) uint32); ok {
+		r1 = rf(deviceName, start, end, offset, limit)
+	} else {
+		r1 = ret.Get(1).(uint32)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, int, int, int, int) errors.EdgeX); ok {
+		r2 = rf(deviceName, start, end, offset, limit)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1, r2
+}
+
+// CommandJobByName provides a mock function with given fields: name
+func (_m *DBClient) CommandJobByName(name string) (models.CommandJob, errors.EdgeX) {
+	ret := _m.Called(name)
+
+	var r0 models.CommandJob
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) (models.CommandJob, errors.EdgeX)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) models.CommandJob); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(models.CommandJob)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// CommandJobTotalCount provides a mock function with given fields:
+func (_m *DBClient) CommandJobTotalCount() (uint32, errors.EdgeX) {
+	ret := _m.Called()
+
+	var r0 uint32
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func() (uint32, errors.EdgeX)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteCommandJobByName provides a mock function with given fields: name
+func (_m *DBClient) DeleteCommandJobByName(name string) errors.EdgeX {
+	ret := _m.Called(name)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// TrimCommandAuditRecords provides a mock function with given fields: maxCount
+func (_m *DBClient) TrimCommandAuditRecords(maxCount int) errors.EdgeX {
+	ret := _m.Called(maxCount)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int) errors.EdgeX); ok {
+		r0 = rf(maxCount)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// UpdateCommandJob provides a mock function with given fields: job
+func (_m *DBClient) UpdateCommandJob(job models.CommandJob) errors.EdgeX {
+	ret := _m.Called(job)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(models.CommandJob) errors.EdgeX); ok {
+		r0 = rf(job)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewDBClient interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewDBClient creates a new instance of DBClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewDBClient(t mockConstructorTestingTNewDBClient) *DBClient {
+	mock := &DBClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
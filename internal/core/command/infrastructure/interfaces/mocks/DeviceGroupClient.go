@@ -0,0 +1,59 @@
+// Code generated by mockery v2.22.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	errors "github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	mock "github.com/stretchr/testify/mock"
+
+	responses "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+)
+
+// DeviceGroupClient is an autogenerated mock type for the DeviceGroupClient type
+type DeviceGroupClient struct {
+	mock.Mock
+}
+
+// DevicesByDeviceGroupName provides a mock function with given fields: ctx, name, offset, limit
+func (_m *DeviceGroupClient) DevicesByDeviceGroupName(ctx context.Context, name string, offset int, limit int) (responses.MultiDevicesResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, name, offset, limit)
+
+	var r0 responses.MultiDevicesResponse
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) (responses.MultiDevicesResponse, errors.EdgeX)); ok {
+		return rf(ctx, name, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) responses.MultiDevicesResponse); ok {
+		r0 = rf(ctx, name, offset, limit)
+	} else {
+		r0 = ret.Get(0).(responses.MultiDevicesResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) errors.EdgeX); ok {
+		r1 = rf(ctx, name, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewDeviceGroupClient interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewDeviceGroupClient creates a new instance of DeviceGroupClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewDeviceGroupClient(t mockConstructorTestingTNewDeviceGroupClient) *DeviceGroupClient {
+	mock := &DeviceGroupClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
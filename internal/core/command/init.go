@@ -20,12 +20,18 @@ import (
 	"context"
 	"sync"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application/job"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application/limiter"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/secret"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 	clients "github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+
+	pkgClients "github.com/edgexfoundry/edgex-go/internal/pkg/clients/http"
 
 	"github.com/labstack/echo/v4"
 )
@@ -55,7 +61,42 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _
 			jwtSecretProvider := secret.NewJWTSecretProvider(bootstrapContainer.SecretProviderExtFrom(get))
 			return clients.NewDeviceServiceCommandClient(jwtSecretProvider, config.Service.EnableNameFieldEscape)
 		},
+		// the device groups of core-metadata are queried to issue batch commands to the devices of a group
+		container.DeviceGroupClientName: func(get di.Get) interface{} {
+			jwtSecretProvider := secret.NewJWTSecretProvider(bootstrapContainer.SecretProviderExtFrom(get))
+			return pkgClients.NewDeviceGroupClient(config.Clients[common.CoreMetaDataServiceKey].Url(), jwtSecretProvider, config.Service.EnableNameFieldEscape)
+		},
 	})
 
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	commandLimiter, err := limiter.NewCommandLimiter(config.CommandLimits)
+	if err != nil {
+		lc.Errorf("Failed to create the command limiter, %v", err)
+		return false
+	}
+	scheduler := job.NewScheduler(dic)
+	dic.Update(di.ServiceConstructorMap{
+		container.CommandLimiterName: func(get di.Get) interface{} {
+			return commandLimiter
+		},
+		container.CommandJobSchedulerName: func(get di.Get) interface{} {
+			return scheduler
+		},
+	})
+
+	err = application.LoadCommandJobs(dic)
+	if err != nil {
+		lc.Errorf("Failed to load command jobs to scheduler, %v", err)
+		return false
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		<-ctx.Done()
+		scheduler.Stop()
+	}()
+
 	return true
 }
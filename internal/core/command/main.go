@@ -32,9 +32,11 @@ import (
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 
 	"github.com/edgexfoundry/edgex-go"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/cache"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/controller/messaging"
+	pkgHandlers "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 
@@ -76,6 +78,7 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *echo.Echo) {
 		bootstrapConfig.ServiceTypeOther,
 		[]interfaces.BootstrapHandler{
 			handlers.NewClientsBootstrap().BootstrapHandler,
+			pkgHandlers.NewDatabase(httpServer, configuration, container.DBClientInterfaceName).BootstrapHandler,
 			MessagingBootstrapHandler,
 			handlers.NewServiceMetrics(common.CoreCommandServiceKey).BootstrapHandler, // Must be after Messaging
 			NewBootstrap(router, common.CoreCommandServiceKey).BootstrapHandler,
@@ -111,6 +114,11 @@ func MessagingBootstrapHandler(ctx context.Context, wg *sync.WaitGroup, startupT
 	if !handlers.MessagingBootstrapHandler(ctx, wg, startupTimer, dic) {
 		return false
 	}
+
+	if configuration.MetadataCache.Enabled && !initMetadataCache(ctx, dic) {
+		return false
+	}
+
 	if err := messaging.SubscribeCommandRequests(ctx, requestTimeout, dic); err != nil {
 		lc.Errorf("Failed to subscribe commands request from internal message bus, %v", err)
 		return false
@@ -121,5 +129,52 @@ func MessagingBootstrapHandler(ctx context.Context, wg *sync.WaitGroup, startupT
 		return false
 	}
 
+	if err := messaging.SubscribeBatchCommandRequests(ctx, requestTimeout, dic); err != nil {
+		lc.Errorf("Failed to subscribe batch command request from internal message bus, %v", err)
+		return false
+	}
+
+	return true
+}
+
+// initMetadataCache caches the devices, device profiles and device services retrieved through the metadata clients,
+// evicting them on the metadata system events
+func initMetadataCache(ctx context.Context, dic *di.Container) bool {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	configuration := container.ConfigurationFrom(dic.Get)
+
+	var ttl time.Duration
+	if len(configuration.MetadataCache.TTL) > 0 {
+		var err error
+		ttl, err = time.ParseDuration(configuration.MetadataCache.TTL)
+		if err != nil {
+			lc.Errorf("Failed to parse MetadataCache.TTL configuration value: %v", err)
+			return false
+		}
+	}
+
+	metadataCache := cache.NewMetadataCache(ttl)
+	dc := metadataCache.DeviceClient(bootstrapContainer.DeviceClientFrom(dic.Get))
+	dpc := metadataCache.DeviceProfileClient(bootstrapContainer.DeviceProfileClientFrom(dic.Get))
+	dsc := metadataCache.DeviceServiceClient(bootstrapContainer.DeviceServiceClientFrom(dic.Get))
+	dic.Update(di.ServiceConstructorMap{
+		container.MetadataCacheName: func(get di.Get) interface{} {
+			return metadataCache
+		},
+		bootstrapContainer.DeviceClientName: func(get di.Get) interface{} {
+			return dc
+		},
+		bootstrapContainer.DeviceProfileClientName: func(get di.Get) interface{} {
+			return dpc
+		},
+		bootstrapContainer.DeviceServiceClientName: func(get di.Get) interface{} {
+			return dsc
+		},
+	})
+
+	if err := messaging.SubscribeMetadataSystemEvents(ctx, dic); err != nil {
+		lc.Errorf("Failed to subscribe metadata system events from internal message bus, %v", err)
+		return false
+	}
 	return true
 }
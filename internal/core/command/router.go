@@ -9,6 +9,7 @@ package command
 import (
 	"github.com/edgexfoundry/edgex-go"
 	commandController "github.com/edgexfoundry/edgex-go/internal/core/command/controller/http"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/controller"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/handlers"
@@ -32,4 +33,18 @@ func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 	r.GET(common.ApiDeviceByNameEchoRoute, cmd.CommandsByDeviceName, authenticationHook)
 	r.GET(common.ApiDeviceNameCommandNameEchoRoute, cmd.IssueGetCommandByName, authenticationHook)
 	r.PUT(common.ApiDeviceNameCommandNameEchoRoute, cmd.IssueSetCommandByName, authenticationHook)
+	r.POST(pkgCommon.ApiDeviceBatchCommandRoute, cmd.IssueBatchCommand, authenticationHook)
+
+	// Command Audit
+	r.GET(pkgCommon.ApiAllCommandAuditRoute, cmd.AllCommandAuditRecords, authenticationHook)
+	r.GET(pkgCommon.ApiCommandAuditByTimeRangeEchoRoute, cmd.CommandAuditRecordsByTimeRange, authenticationHook)
+	r.GET(pkgCommon.ApiCommandAuditByDeviceNameEchoRoute, cmd.AllCommandAuditRecords, authenticationHook)
+	r.GET(pkgCommon.ApiCommandAuditByDeviceNameAndTimeRangeEchoRoute, cmd.CommandAuditRecordsByTimeRange, authenticationHook)
+
+	// Command Job
+	job := commandController.NewCommandJobController(dic)
+	r.POST(pkgCommon.ApiCommandJobRoute, job.AddCommandJob, authenticationHook)
+	r.GET(pkgCommon.ApiAllCommandJobRoute, job.AllCommandJobs, authenticationHook)
+	r.GET(pkgCommon.ApiCommandJobByNameEchoRoute, job.CommandJobByName, authenticationHook)
+	r.DELETE(pkgCommon.ApiCommandJobByNameEchoRoute, job.DeleteCommandJobByName, authenticationHook)
 }
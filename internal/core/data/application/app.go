@@ -20,6 +20,8 @@ import (
 
 	gometrics "github.com/rcrowley/go-metrics"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
@@ -29,8 +31,16 @@ import (
 const (
 	eventsPersistedMetricName   = "EventsPersisted"
 	readingsPersistedMetricName = "ReadingsPersisted"
+	// timeSeriesWriteErrorsMetricName is only registered when the events and readings are written asynchronously to
+	// a time series store, i.e. with the hybrid database
+	timeSeriesWriteErrorsMetricName = "TimeSeriesWriteErrors"
 )
 
+// timeSeriesWriter is implemented by the database clients writing the events and readings asynchronously
+type timeSeriesWriter interface {
+	TimeSeriesWriteErrors() gometrics.Counter
+}
+
 // CoreDataApp encapsulates the Core Data Application functionality
 // TODO: Extend this App usage beyond Events.
 type CoreDataApp struct {
@@ -63,6 +73,13 @@ func NewCoreDataApp(dic *di.Container) *CoreDataApp {
 	}
 	app.lc.Infof("Registered metrics counter %s", readingsPersistedMetricName)
 
+	if writer, ok := dic.Get(container.DBClientInterfaceName).(timeSeriesWriter); ok {
+		if err := metricsManager.Register(timeSeriesWriteErrorsMetricName, writer.TimeSeriesWriteErrors(), nil); err != nil {
+			app.lc.Errorf("%s metrics will not be collected: %s", timeSeriesWriteErrorsMetricName, err.Error())
+		}
+		app.lc.Infof("Registered metrics counter %s", timeSeriesWriteErrorsMetricName)
+	}
+
 	return app
 }
 
@@ -21,6 +21,7 @@ import (
 
 	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 
 	"github.com/google/uuid"
 )
@@ -74,6 +75,72 @@ func (a *CoreDataApp) AddEvent(e models.Event, ctx context.Context, dic *di.Cont
 	return nil
 }
 
+// ImportedEvent is an event decoded from an import stream along with its encoded AddEventRequest, which is published
+// as is like the AddEventRequest of AddEvent
+type ImportedEvent struct {
+	Event       models.Event
+	Payload     []byte
+	ContentType string
+}
+
+// ImportEvents validates the batch of imported events and adds the valid ones to the database at once, then publishes
+// them to the message bus when requested.  The failures are returned per event, at the index of the event.
+func (a *CoreDataApp) ImportEvents(events []ImportedEvent, serviceName string, publish bool, ctx context.Context, dic *di.Container) []errors.EdgeX {
+	configuration := container.ConfigurationFrom(dic.Get)
+	failures := make([]errors.EdgeX, len(events))
+
+	var valid []int
+	for i, e := range events {
+		if err := a.ValidateEvent(e.Event, e.Event.ProfileName, e.Event.DeviceName, e.Event.SourceName, ctx, dic); err != nil {
+			failures[i] = err
+			continue
+		}
+		valid = append(valid, i)
+	}
+
+	if configuration.Writable.PersistData && len(valid) > 0 {
+		validEvents := make([]models.Event, len(valid))
+		for i, index := range valid {
+			validEvents[i] = events[index].Event
+		}
+		dbFailures, err := container.DBClientFrom(dic.Get).AddEvents(validEvents)
+		if err != nil {
+			for _, index := range valid {
+				failures[index] = errors.NewCommonEdgeXWrapper(err)
+			}
+			return failures
+		}
+		for i, index := range valid {
+			if dbFailures[i] != nil {
+				failures[index] = errors.NewCommonEdgeXWrapper(dbFailures[i])
+				continue
+			}
+			a.eventsPersistedCounter.Inc(1)
+			a.readingsPersistedCounter.Inc(int64(len(events[index].Event.Readings)))
+		}
+		a.lc.Debugf("%d imported events created on DB successfully. Correlation-id: %s", len(valid), correlation.FromContext(ctx))
+	}
+
+	if publish {
+		var published []ImportedEvent
+		for i, e := range events {
+			if failures[i] == nil {
+				published = append(published, e)
+			}
+		}
+		// The request context is done once the response is written, so the events are published with a context of
+		// their own, carrying the correlation id of the request
+		publishCtx := context.WithValue(context.Background(), common.CorrelationHeader, correlation.FromContext(ctx)) // nolint:staticcheck
+		go func() {
+			for _, e := range published {
+				a.PublishEvent(e.Payload, serviceName, e.Event.ProfileName, e.Event.DeviceName, e.Event.SourceName,
+					context.WithValue(publishCtx, common.ContentType, e.ContentType), dic) // nolint:staticcheck
+			}
+		}()
+	}
+	return failures
+}
+
 // PublishEvent publishes incoming AddEventRequest in the format of []byte through MessageClient
 func (a *CoreDataApp) PublishEvent(data []byte, serviceName string, profileName string, deviceName string, sourceName string, ctx context.Context, dic *di.Container) {
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
@@ -219,6 +286,28 @@ func (a *CoreDataApp) EventsByDeviceName(offset int, limit int, name string, dic
 	return events, totalCount, nil
 }
 
+// EventsByCursor query, from the latest to the oldest, at most limit events positioned after the cursor.  The events of
+// every device are queried when name is empty.  The returned cursor is the zero cursor once the last page is queried.
+func (a *CoreDataApp) EventsByCursor(name string, cursor pkgModels.Cursor, limit int, dic *di.Container) (events []dtos.Event, totalCount uint32, next pkgModels.Cursor, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	eventModels, next, err := dbClient.EventsBeforeCursor(name, cursor, limit)
+	if err == nil {
+		if name == "" {
+			totalCount, err = dbClient.EventTotalCount()
+		} else {
+			totalCount, err = dbClient.EventCountByDeviceName(name)
+		}
+	}
+	if err != nil {
+		return events, totalCount, next, errors.NewCommonEdgeXWrapper(err)
+	}
+	events = make([]dtos.Event, len(eventModels))
+	for i, e := range eventModels {
+		events[i] = dtos.FromEventModelToDTO(e)
+	}
+	return events, totalCount, next, nil
+}
+
 // EventsByTimeRange query events with offset, limit and time range
 func (a *CoreDataApp) EventsByTimeRange(startTime int, endTime int, offset int, limit int, dic *di.Container) (events []dtos.Event, totalCount uint32, err errors.EdgeX) {
 	dbClient := container.DBClientFrom(dic.Get)
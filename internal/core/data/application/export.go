@@ -0,0 +1,239 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/parquet-go/parquet-go"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// defaultExportBatchSize is the amount of readings queried at once when MaxResultCount doesn't limit it
+const defaultExportBatchSize = 1024
+
+// ReadingExportCursor walks through the readings matching a filter in ascending order of origin, one batch at a time.
+// The batches are queried through a DBClient cursor, so readings added or deleted during the export don't shift the
+// exported ones as offset paging would.
+type ReadingExportCursor struct {
+	dbClient  interfaces.DBClient
+	filter    pkgModels.ReadingFilter
+	position  pkgModels.Cursor
+	batchSize int
+	done      bool
+}
+
+// NewReadingExportCursor validates the filter and creates a cursor positioned before the first matching reading
+func NewReadingExportCursor(filter pkgModels.ReadingFilter, dic *di.Container) (*ReadingExportCursor, errors.EdgeX) {
+	if filter.End < filter.Start {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("end's value %v is not allowed to be less than start's value %v", filter.End, filter.Start), nil)
+	}
+	batchSize := container.ConfigurationFrom(dic.Get).Service.MaxResultCount
+	if batchSize <= 0 {
+		batchSize = defaultExportBatchSize
+	}
+	return &ReadingExportCursor{
+		dbClient:  container.DBClientFrom(dic.Get),
+		filter:    filter,
+		batchSize: batchSize,
+	}, nil
+}
+
+// Next returns the next batch of readings, an empty batch meaning every reading has been visited
+func (c *ReadingExportCursor) Next() ([]models.Reading, errors.EdgeX) {
+	for !c.done {
+		readings, next, err := c.dbClient.ReadingsByCursor(c.filter, c.position, c.batchSize)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		c.done = next == c.position
+		c.position = next
+		// a batch may be empty while the cursor moved when its readings were deleted in the meantime
+		if len(readings) > 0 {
+			return readings, nil
+		}
+	}
+	return nil, nil
+}
+
+// ReadingEncoder writes readings to a stream in a given export format
+type ReadingEncoder interface {
+	// ContentType returns the media type of the stream
+	ContentType() string
+	// Encode writes the batch of readings to the stream
+	Encode(readings []models.Reading) error
+	// Close terminates the stream without closing the underlying writer
+	Close() error
+}
+
+// NewReadingEncoder creates the encoder of the export format, i.e. csv, ndjson or parquet
+func NewReadingEncoder(format string, w io.Writer) (ReadingEncoder, errors.EdgeX) {
+	switch format {
+	case pkgCommon.ExportFormatCSV:
+		return &csvReadingEncoder{writer: csv.NewWriter(w)}, nil
+	case pkgCommon.ExportFormatNDJSON:
+		return &ndjsonReadingEncoder{encoder: json.NewEncoder(w)}, nil
+	case pkgCommon.ExportFormatParquet:
+		return &parquetReadingEncoder{writer: parquet.NewGenericWriter[exportedReading](w, parquet.Compression(&parquet.Snappy))}, nil
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported export format %s", format), nil)
+	}
+}
+
+// exportedReading is the flat representation of a reading used by the tabular export formats.  Value holds the value
+// of a simple reading, the base64 encoded value of a binary reading or the JSON encoded value of an object reading.
+type exportedReading struct {
+	Id           string `parquet:"id"`
+	Origin       int64  `parquet:"origin,timestamp(nanosecond)"`
+	DeviceName   string `parquet:"deviceName"`
+	ProfileName  string `parquet:"profileName"`
+	ResourceName string `parquet:"resourceName"`
+	ValueType    string `parquet:"valueType"`
+	Units        string `parquet:"units"`
+	MediaType    string `parquet:"mediaType"`
+	Value        string `parquet:"value"`
+	Tags         string `parquet:"tags"`
+}
+
+var exportedReadingColumns = []string{"id", "origin", "deviceName", "profileName", "resourceName", "valueType", "units", "mediaType", "value", "tags"}
+
+func toExportedReading(reading models.Reading) (exportedReading, error) {
+	base := reading.GetBaseReading()
+	r := exportedReading{
+		Id:           base.Id,
+		Origin:       base.Origin,
+		DeviceName:   base.DeviceName,
+		ProfileName:  base.ProfileName,
+		ResourceName: base.ResourceName,
+		ValueType:    base.ValueType,
+		Units:        base.Units,
+	}
+	switch v := reading.(type) {
+	case models.SimpleReading:
+		r.Value = v.Value
+	case models.BinaryReading:
+		r.MediaType = v.MediaType
+		r.Value = base64.StdEncoding.EncodeToString(v.BinaryValue)
+	case models.ObjectReading:
+		value, err := json.Marshal(v.ObjectValue)
+		if err != nil {
+			return r, err
+		}
+		r.Value = string(value)
+	}
+	if len(base.Tags) > 0 {
+		tags, err := json.Marshal(base.Tags)
+		if err != nil {
+			return r, err
+		}
+		r.Tags = string(tags)
+	}
+	return r, nil
+}
+
+type csvReadingEncoder struct {
+	writer        *csv.Writer
+	headerWritten bool
+}
+
+func (e *csvReadingEncoder) ContentType() string {
+	return pkgCommon.ContentTypeCSV
+}
+
+func (e *csvReadingEncoder) writeHeader() error {
+	if e.headerWritten {
+		return nil
+	}
+	e.headerWritten = true
+	return e.writer.Write(exportedReadingColumns)
+}
+
+func (e *csvReadingEncoder) Encode(readings []models.Reading) error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+	for _, reading := range readings {
+		r, err := toExportedReading(reading)
+		if err != nil {
+			return err
+		}
+		err = e.writer.Write([]string{r.Id, strconv.FormatInt(r.Origin, 10), r.DeviceName, r.ProfileName, r.ResourceName, r.ValueType, r.Units, r.MediaType, r.Value, r.Tags})
+		if err != nil {
+			return err
+		}
+	}
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+func (e *csvReadingEncoder) Close() error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+type ndjsonReadingEncoder struct {
+	encoder *json.Encoder
+}
+
+func (e *ndjsonReadingEncoder) ContentType() string {
+	return pkgCommon.ContentTypeNDJSON
+}
+
+func (e *ndjsonReadingEncoder) Encode(readings []models.Reading) error {
+	for _, reading := range readings {
+		if err := e.encoder.Encode(dtos.FromReadingModelToDTO(reading)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *ndjsonReadingEncoder) Close() error {
+	return nil
+}
+
+// parquetReadingEncoder writes every batch as a row group, so the rows are streamed rather than buffered until Close
+type parquetReadingEncoder struct {
+	writer *parquet.GenericWriter[exportedReading]
+}
+
+func (e *parquetReadingEncoder) ContentType() string {
+	return pkgCommon.ContentTypeParquet
+}
+
+func (e *parquetReadingEncoder) Encode(readings []models.Reading) error {
+	rows := make([]exportedReading, len(readings))
+	for i, reading := range readings {
+		r, err := toExportedReading(reading)
+		if err != nil {
+			return err
+		}
+		rows[i] = r
+	}
+	if _, err := e.writer.Write(rows); err != nil {
+		return err
+	}
+	return e.writer.Flush()
+}
+
+func (e *parquetReadingEncoder) Close() error {
+	return e.writer.Close()
+}
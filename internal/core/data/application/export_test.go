@@ -0,0 +1,107 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+func exportReadings() []models.Reading {
+	return []models.Reading{
+		models.SimpleReading{
+			BaseReading: models.BaseReading{Id: "1", Origin: 10, DeviceName: "d", ProfileName: "p", ResourceName: "r", ValueType: common.ValueTypeString, Tags: map[string]any{"a": "b"}},
+			Value:       "x,y",
+		},
+		models.BinaryReading{
+			BaseReading: models.BaseReading{Id: "2", Origin: 20, DeviceName: "d", ProfileName: "p", ResourceName: "r", ValueType: common.ValueTypeBinary},
+			BinaryValue: []byte{1, 2},
+			MediaType:   "application/octet-stream",
+		},
+	}
+}
+
+func TestExportReadingCursor(t *testing.T) {
+	filter := pkgModels.ReadingFilter{DeviceName: "d", End: 100}
+	first := pkgModels.Cursor{Score: 10, Id: "1"}
+	second := pkgModels.Cursor{Score: 20, Id: "2"}
+	dic := mocks.NewMockDIC()
+	dbClientMock := &dbMock.DBClient{}
+	readings := exportReadings()
+	dbClientMock.On("ReadingsByCursor", filter, pkgModels.Cursor{}, mock.Anything).Return(readings[:1], first, nil)
+	// the readings of the second batch were deleted in the meantime
+	dbClientMock.On("ReadingsByCursor", filter, first, mock.Anything).Return(nil, second, nil)
+	dbClientMock.On("ReadingsByCursor", filter, second, mock.Anything).Return(nil, second, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	cursor, err := NewReadingExportCursor(filter, dic)
+	require.NoError(t, err)
+	batch, err := cursor.Next()
+	require.NoError(t, err)
+	assert.Equal(t, readings[:1], batch)
+	batch, err = cursor.Next()
+	require.NoError(t, err)
+	assert.Empty(t, batch)
+	batch, err = cursor.Next()
+	require.NoError(t, err)
+	assert.Empty(t, batch)
+	dbClientMock.AssertNumberOfCalls(t, "ReadingsByCursor", 3)
+
+	_, err = NewReadingExportCursor(pkgModels.ReadingFilter{Start: 10, End: 0}, dic)
+	require.Error(t, err)
+}
+
+func TestCSVReadingEncoder(t *testing.T) {
+	var buffer bytes.Buffer
+	encoder, err := NewReadingEncoder(pkgCommon.ExportFormatCSV, &buffer)
+	require.NoError(t, err)
+	require.NoError(t, encoder.Encode(exportReadings()))
+	require.NoError(t, encoder.Close())
+
+	expected := "id,origin,deviceName,profileName,resourceName,valueType,units,mediaType,value,tags\n" +
+		"1,10,d,p,r,String,,,\"x,y\",\"{\"\"a\"\":\"\"b\"\"}\"\n" +
+		"2,20,d,p,r,Binary,,application/octet-stream,AQI=,\n"
+	assert.Equal(t, expected, buffer.String())
+}
+
+func TestParquetReadingEncoder(t *testing.T) {
+	var buffer bytes.Buffer
+	encoder, err := NewReadingEncoder(pkgCommon.ExportFormatParquet, &buffer)
+	require.NoError(t, err)
+	readings := exportReadings()
+	require.NoError(t, encoder.Encode(readings[:1]))
+	require.NoError(t, encoder.Encode(readings[1:]))
+	require.NoError(t, encoder.Close())
+
+	rows, readErr := parquet.Read[exportedReading](bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+	require.NoError(t, readErr)
+	require.Len(t, rows, 2)
+	assert.Equal(t, "x,y", rows[0].Value)
+	assert.Equal(t, `{"a":"b"}`, rows[0].Tags)
+	assert.Equal(t, int64(20), rows[1].Origin)
+	assert.Equal(t, "AQI=", rows[1].Value)
+}
+
+func TestUnsupportedReadingEncoder(t *testing.T) {
+	_, err := NewReadingEncoder("xml", &bytes.Buffer{})
+	require.Error(t, err)
+}
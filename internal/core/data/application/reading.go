@@ -8,16 +8,21 @@ package application
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/data/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 )
 
 var asyncPurgeReadingOnce sync.Once
@@ -91,6 +96,29 @@ func ReadingsByDeviceName(offset int, limit int, name string, dic *di.Container)
 	return readings, totalCount, nil
 }
 
+// ReadingsByCursor query, from the latest to the oldest, at most limit readings positioned after the cursor.  The
+// readings of every device are queried when name is empty.  The returned cursor is the zero cursor once the last page
+// is queried.
+func ReadingsByCursor(name string, cursor pkgModels.Cursor, limit int, dic *di.Container) (readings []dtos.BaseReading, totalCount uint32, next pkgModels.Cursor, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	readingModels, next, err := dbClient.ReadingsBeforeCursor(name, cursor, limit)
+	if err == nil {
+		readings, err = convertReadingModelsToDTOs(readingModels)
+		if err == nil {
+			if name == "" {
+				totalCount, err = dbClient.ReadingTotalCount()
+			} else {
+				totalCount, err = dbClient.ReadingCountByDeviceName(name)
+			}
+		}
+	}
+
+	if err != nil {
+		return readings, totalCount, next, errors.NewCommonEdgeXWrapper(err)
+	}
+	return readings, totalCount, next, nil
+}
+
 // ReadingsByTimeRange query readings with offset, limit and time range
 func ReadingsByTimeRange(start int, end int, offset int, limit int, dic *di.Container) (readings []dtos.BaseReading, totalCount uint32, err errors.EdgeX) {
 	dbClient := container.DBClientFrom(dic.Get)
@@ -224,6 +252,49 @@ func ReadingsByDeviceNameAndResourceNamesAndTimeRange(deviceName string, resourc
 	return readings, totalCount, nil
 }
 
+// AggregateReadingsByDeviceNameAndResourceNameAndTimeRange downsamples the readings of a device resource within the
+// specified time range into windows of the given duration, applying the requested aggregation functions.  All the
+// supported aggregation functions are applied when none is specified.
+func AggregateReadingsByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, start int, end int, window string, functions []string, dic *di.Container) (aggregates []pkgDtos.ReadingAggregate, err errors.EdgeX) {
+	if deviceName == "" {
+		return aggregates, errors.NewCommonEdgeX(errors.KindContractInvalid, "device name is empty", nil)
+	}
+	if resourceName == "" {
+		return aggregates, errors.NewCommonEdgeX(errors.KindContractInvalid, "resource name is empty", nil)
+	}
+	windowDuration, parseErr := time.ParseDuration(window)
+	if parseErr != nil {
+		return aggregates, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse window %s", window), parseErr)
+	}
+	if windowDuration <= 0 {
+		return aggregates, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("window %s must be positive", window), nil)
+	}
+	config := container.ConfigurationFrom(dic.Get)
+	if windows := int64(end-start) / windowDuration.Nanoseconds(); windows > int64(config.Service.MaxResultCount) {
+		return aggregates, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("time range with window %s results in %d windows, which exceeds the MaxResultCount %d", window, windows, config.Service.MaxResultCount), nil)
+	}
+	if len(functions) == 0 {
+		functions = pkgModels.AggregateFunctions
+	}
+	for _, f := range functions {
+		if !pkgModels.IsValidAggregateFunction(f) {
+			return aggregates, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported aggregation function %s", f), nil)
+		}
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	aggregateModels, err := dbClient.AggregateReadingsByDeviceNameAndResourceNameAndTimeRange(deviceName, resourceName, start, end, windowDuration.Nanoseconds(), functions)
+	if err != nil {
+		return aggregates, errors.NewCommonEdgeXWrapper(err)
+	}
+	aggregates = make([]pkgDtos.ReadingAggregate, len(aggregateModels))
+	for i, a := range aggregateModels {
+		aggregates[i] = pkgDtos.FromReadingAggregateModelToDTO(a)
+	}
+	return aggregates, nil
+}
+
 // AsyncPurgeReading purge readings and related events according to the retention capability.
 func AsyncPurgeReading(interval time.Duration, ctx context.Context, dic *di.Container) {
 	asyncPurgeReadingOnce.Do(func() {
@@ -248,27 +319,139 @@ func AsyncPurgeReading(interval time.Duration, ctx context.Context, dic *di.Cont
 	})
 }
 
-func purgeReading(dic *di.Container) errors.EdgeX {
-	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
-	dbClient := container.DBClientFrom(dic.Get)
-	config := container.ConfigurationFrom(dic.Get)
-	total, err := dbClient.ReadingTotalCount()
-	if err != nil {
-		return errors.NewCommonEdgeX(errors.Kind(err), "failed to query reading total count, %v", err)
+// ValidateReadingRetention checks the durations of the reading retention configuration
+func ValidateReadingRetention(retention config.ReadingRetention) errors.EdgeX {
+	if _, err := parseMaxAge(retention.MaxAge); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
 	}
-	if total >= config.Retention.MaxCap {
-		lc.Debugf("Purging the reading amount %d to the minimum capacity %d", total, config.Retention.MinCap)
-		// Using reading origin instead event origin to remove events and readings by age.
-		// If we remove readings to MinCap and remove related events, some readings might lose the related event.
-		reading, err := dbClient.LatestReadingByOffset(config.Retention.MinCap)
-		if err != nil {
-			return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query reading with offset '%d'", config.Retention.MinCap), err)
+	for name, policy := range retention.DeviceProfiles {
+		if _, err := parseMaxAge(policy.MaxAge); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid retention policy of device profile %s", name), err)
+		}
+	}
+	for name, policy := range retention.Devices {
+		if _, err := parseMaxAge(policy.MaxAge); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid retention policy of device %s", name), err)
 		}
-		age := time.Now().UnixNano() - reading.GetBaseReading().Origin
-		err = dbClient.DeleteEventsByAge(age)
+	}
+	return nil
+}
+
+// parseMaxAge parses the maximum age of a retention policy, 0 meaning the age based retention is disabled
+func parseMaxAge(maxAge string) (time.Duration, errors.EdgeX) {
+	if len(maxAge) == 0 {
+		return 0, nil
+	}
+	age, err := time.ParseDuration(maxAge)
+	if err != nil || age <= 0 {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("MaxAge '%s' must be a positive duration", maxAge), err)
+	}
+	return age, nil
+}
+
+// readingPurger enforces a retention policy on the readings of a scope: all the readings, the readings of a device or
+// the readings of a device profile
+type readingPurger struct {
+	scope          string
+	count          func() (uint32, errors.EdgeX)
+	latestByOffset func(offset uint32) (models.Reading, errors.EdgeX)
+	deleteByAge    func(age int64) errors.EdgeX
+}
+
+func (p readingPurger) purge(policy config.RetentionPolicy, lc logger.LoggingClient) errors.EdgeX {
+	maxAge, err := parseMaxAge(policy.MaxAge)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if maxAge > 0 {
+		lc.Debugf("Purging %s older than %s", p.scope, maxAge)
+		err = p.deleteByAge(maxAge.Nanoseconds())
 		if err != nil {
-			return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to delete events and readings by age '%d'", age), err)
+			return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to delete %s by age '%s'", p.scope, maxAge), err)
 		}
 	}
+
+	if policy.MaxCap == 0 {
+		return nil
+	}
+	total, err := p.count()
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query the count of %s", p.scope), err)
+	}
+	if total < policy.MaxCap {
+		return nil
+	}
+	lc.Debugf("Purging the %s amount %d to the minimum capacity %d", p.scope, total, policy.MinCap)
+	// Using reading origin instead event origin to remove events and readings by age.
+	// If we remove readings to MinCap and remove related events, some readings might lose the related event.
+	reading, err := p.latestByOffset(policy.MinCap)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query %s with offset '%d'", p.scope, policy.MinCap), err)
+	}
+	age := time.Now().UnixNano() - reading.GetBaseReading().Origin
+	err = p.deleteByAge(age)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to delete %s by age '%d'", p.scope, age), err)
+	}
 	return nil
 }
+
+// purgeReading enforces the global retention and then the retention policies of the device profiles and devices.  A
+// failing policy doesn't prevent the remaining ones from being enforced, the first error being returned.
+func purgeReading(dic *di.Container) errors.EdgeX {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	dbClient := container.DBClientFrom(dic.Get)
+	retention := container.ConfigurationFrom(dic.Get).Retention
+
+	var firstErr errors.EdgeX
+	enforce := func(purger readingPurger, policy config.RetentionPolicy) {
+		if err := purger.purge(policy, lc); err != nil {
+			lc.Errorf("Failed to purge %s, %v", purger.scope, err)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+
+	enforce(readingPurger{
+		scope:          "readings",
+		count:          dbClient.ReadingTotalCount,
+		latestByOffset: dbClient.LatestReadingByOffset,
+		deleteByAge:    dbClient.DeleteEventsByAge,
+	}, config.RetentionPolicy{MaxCap: retention.MaxCap, MinCap: retention.MinCap, MaxAge: retention.MaxAge})
+
+	for _, profileName := range sortedKeys(retention.DeviceProfiles) {
+		name := profileName
+		enforce(readingPurger{
+			scope: fmt.Sprintf("readings of device profile %s", name),
+			count: func() (uint32, errors.EdgeX) { return dbClient.ReadingCountByProfileName(name) },
+			latestByOffset: func(offset uint32) (models.Reading, errors.EdgeX) {
+				return dbClient.LatestReadingByOffsetAndProfileName(offset, name)
+			},
+			deleteByAge: func(age int64) errors.EdgeX { return dbClient.DeleteEventsByAgeAndProfileName(age, name) },
+		}, retention.DeviceProfiles[name])
+	}
+
+	for _, deviceName := range sortedKeys(retention.Devices) {
+		name := deviceName
+		enforce(readingPurger{
+			scope: fmt.Sprintf("readings of device %s", name),
+			count: func() (uint32, errors.EdgeX) { return dbClient.ReadingCountByDeviceName(name) },
+			latestByOffset: func(offset uint32) (models.Reading, errors.EdgeX) {
+				return dbClient.LatestReadingByOffsetAndDeviceName(offset, name)
+			},
+			deleteByAge: func(age int64) errors.EdgeX { return dbClient.DeleteEventsByAgeAndDeviceName(age, name) },
+		}, retention.Devices[name])
+	}
+
+	return firstErr
+}
+
+func sortedKeys(policies map[string]config.RetentionPolicy) []string {
+	keys := make([]string, 0, len(policies))
+	for k := range policies {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
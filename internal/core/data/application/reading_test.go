@@ -8,6 +8,7 @@ package application
 import (
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -295,3 +296,101 @@ func TestPurgeReading(t *testing.T) {
 		})
 	}
 }
+
+func TestPurgeReadingByAge(t *testing.T) {
+	dic := mocks.NewMockDIC()
+	coreDataConfig := container.ConfigurationFrom(dic.Get)
+	coreDataConfig.Retention = config.ReadingRetention{
+		Enabled:  true,
+		Interval: "1s",
+		MaxAge:   "1h",
+	}
+	dic.Update(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return coreDataConfig
+		},
+	})
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeleteEventsByAge", time.Hour.Nanoseconds()).Return(nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	err := purgeReading(dic)
+	require.NoError(t, err)
+	dbClientMock.AssertCalled(t, "DeleteEventsByAge", time.Hour.Nanoseconds())
+	dbClientMock.AssertNotCalled(t, "ReadingTotalCount")
+}
+
+func TestPurgeReadingByPolicies(t *testing.T) {
+	profileName := "vibration"
+	deviceName := "environment-sensor"
+	dic := mocks.NewMockDIC()
+	coreDataConfig := container.ConfigurationFrom(dic.Get)
+	coreDataConfig.Retention = config.ReadingRetention{
+		Enabled:  true,
+		Interval: "1s",
+		MaxCap:   100,
+		MinCap:   50,
+		DeviceProfiles: map[string]config.RetentionPolicy{
+			profileName: {MaxCap: 5, MinCap: 3, MaxAge: "10m"},
+		},
+		Devices: map[string]config.RetentionPolicy{
+			deviceName: {MaxAge: "168h"},
+		},
+	}
+	dic.Update(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return coreDataConfig
+		},
+	})
+
+	dbClientMock := &dbMock.DBClient{}
+	var reading models.Reading = models.SimpleReading{}
+	dbClientMock.On("ReadingTotalCount").Return(uint32(10), nil)
+	dbClientMock.On("ReadingCountByProfileName", profileName).Return(uint32(5), nil)
+	dbClientMock.On("LatestReadingByOffsetAndProfileName", uint32(3), profileName).Return(reading, nil)
+	dbClientMock.On("DeleteEventsByAgeAndProfileName", mock.Anything, profileName).Return(nil)
+	dbClientMock.On("DeleteEventsByAgeAndDeviceName", (168 * time.Hour).Nanoseconds(), deviceName).Return(nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	err := purgeReading(dic)
+	require.NoError(t, err)
+	dbClientMock.AssertNotCalled(t, "DeleteEventsByAge", mock.Anything)
+	dbClientMock.AssertCalled(t, "DeleteEventsByAgeAndProfileName", (10 * time.Minute).Nanoseconds(), profileName)
+	dbClientMock.AssertNumberOfCalls(t, "DeleteEventsByAgeAndProfileName", 2)
+	dbClientMock.AssertCalled(t, "DeleteEventsByAgeAndDeviceName", (168 * time.Hour).Nanoseconds(), deviceName)
+	dbClientMock.AssertNotCalled(t, "ReadingCountByDeviceName", deviceName)
+}
+
+func TestValidateReadingRetention(t *testing.T) {
+	tests := []struct {
+		name          string
+		retention     config.ReadingRetention
+		expectedError bool
+	}{
+		{"valid", config.ReadingRetention{MaxAge: "168h", Devices: map[string]config.RetentionPolicy{"d": {MaxAge: "1h"}}}, false},
+		{"valid without age", config.ReadingRetention{MaxCap: 10}, false},
+		{"invalid global age", config.ReadingRetention{MaxAge: "7 days"}, true},
+		{"negative age", config.ReadingRetention{MaxAge: "-1h"}, true},
+		{"invalid profile age", config.ReadingRetention{DeviceProfiles: map[string]config.RetentionPolicy{"p": {MaxAge: "x"}}}, true},
+		{"invalid device age", config.ReadingRetention{Devices: map[string]config.RetentionPolicy{"d": {MaxAge: "0s"}}}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := ValidateReadingRetention(testCase.retention)
+			if testCase.expectedError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
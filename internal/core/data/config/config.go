@@ -16,6 +16,8 @@
 package config
 
 import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v3/config"
 )
 
@@ -27,6 +29,7 @@ type ConfigurationStruct struct {
 	Service      bootstrapConfig.ServiceInfo
 	MaxEventSize int64
 	Retention    ReadingRetention
+	TimeSeries   db.TimeSeriesInfo
 }
 
 type WritableInfo struct {
@@ -39,8 +42,24 @@ type WritableInfo struct {
 type ReadingRetention struct {
 	Enabled  bool
 	Interval string
-	MaxCap   uint32
-	MinCap   uint32
+	// MaxCap is the count of readings from which they are purged down to MinCap, 0 disables the count based retention
+	MaxCap uint32
+	MinCap uint32
+	// MaxAge is how long the readings are kept, e.g. 168h, empty disables the age based retention
+	MaxAge string
+	// DeviceProfiles holds the retention policies of the readings of specific device profiles, keyed by profile name
+	DeviceProfiles map[string]RetentionPolicy
+	// Devices holds the retention policies of the readings of specific devices, keyed by device name
+	Devices map[string]RetentionPolicy
+}
+
+// RetentionPolicy limits the readings of a device or device profile.  It is enforced in addition to the global
+// retention, so that the readings of a high-rate source can be purged sooner without affecting the other sources.
+// A zero MaxCap disables the count based retention and an empty MaxAge the age based one.
+type RetentionPolicy struct {
+	MaxCap uint32
+	MinCap uint32
+	MaxAge string
 }
 
 // UpdateFromRaw converts configuration received from the registry to a service-specific configuration struct which is
@@ -103,6 +122,11 @@ func (c *ConfigurationStruct) GetDatabaseInfo() bootstrapConfig.Database {
 	return c.Database
 }
 
+// GetTimeSeriesInfo returns the time series store information used when the database type is hybrid.
+func (c *ConfigurationStruct) GetTimeSeriesInfo() db.TimeSeriesInfo {
+	return c.TimeSeries
+}
+
 // GetInsecureSecrets returns the service's InsecureSecrets.
 func (c *ConfigurationStruct) GetInsecureSecrets() bootstrapConfig.InsecureSecrets {
 	return c.Writable.InsecureSecrets
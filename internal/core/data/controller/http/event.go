@@ -6,6 +6,7 @@ import (
 	"io"
 	"math"
 	"net/http"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -14,6 +15,10 @@ import (
 	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
 	edgexIO "github.com/edgexfoundry/edgex-go/internal/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
@@ -26,6 +31,9 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
+// eventImportBatchSize is the amount of imported events added to the database at once
+const eventImportBatchSize = 100
+
 type EventController struct {
 	readers map[string]edgexIO.DtoReader
 	mux     sync.RWMutex
@@ -125,6 +133,107 @@ func (ec *EventController) AddEvent(c echo.Context) error {
 	return pkg.EncodeAndWriteResponse(response, w, lc)
 }
 
+// ImportEvents adds the events of a newline-delimited JSON or CBOR sequence stream of AddEventRequest, e.g. to backfill
+// the events buffered by a gateway during an outage.  The stream is decoded one event at a time and the events are
+// added to the database in batches.  The events which fail to be decoded, validated or added are reported in the
+// response with the 207 status code, while the other events are still imported.
+func (ec *EventController) ImportEvents(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	// retrieve all the service injections from bootstrap
+	lc := container.LoggingClientFrom(ec.dic.Get)
+
+	ctx := r.Context()
+	config := dataContainer.ConfigurationFrom(ec.dic.Get)
+
+	serviceName := c.Param(common.ServiceName)
+	if len(strings.TrimSpace(serviceName)) == 0 {
+		err := errors.NewCommonEdgeX(errors.KindContractInvalid, "service name sending events can not be empty", nil)
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	publish, parseErr := strconv.ParseBool(utils.ParseQueryStringToString(r, pkgCommon.Publish, "false"))
+	if parseErr != nil {
+		err := errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse querystring %s", pkgCommon.Publish), parseErr)
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	maxEventSize := math.MaxInt32
+	if config.MaxEventSize > 0 {
+		maxEventSize = int(config.MaxEventSize * 1024)
+	}
+	sequence, err := edgexIO.NewDtoSequenceReader(r.Header.Get(common.ContentType), r.Body, maxEventSize)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	reader := edgexIO.NewDtoReader(sequence.ContentType())
+
+	var failures []pkgDtos.EventImportFailure
+	addFailure := func(index int, eventId string, err errors.EdgeX) {
+		failures = append(failures, pkgDtos.EventImportFailure{Index: index, EventId: eventId, StatusCode: err.Code(), Message: err.Message()})
+	}
+	var batch []application.ImportedEvent
+	var batchIndexes []int
+	total, imported := 0, 0
+	importBatch := func() {
+		for i, err := range ec.app.ImportEvents(batch, serviceName, publish, ctx, ec.dic) {
+			if err != nil {
+				addFailure(batchIndexes[i], batch[i].Event.Id, err)
+			} else {
+				imported++
+			}
+		}
+		batch, batchIndexes = nil, nil
+	}
+
+	for {
+		item, err := sequence.Next()
+		if err != nil {
+			// the stream can't be decoded any further
+			addFailure(total, "", err)
+			total++
+			break
+		}
+		if item == nil {
+			break
+		}
+		index := total
+		total++
+
+		var addEventReqDTO requestDTO.AddEventRequest
+		if err = reader.Read(bytes.NewReader(item), &addEventReqDTO); err != nil {
+			addFailure(index, "", err)
+			continue
+		}
+		batch = append(batch, application.ImportedEvent{
+			Event:       requestDTO.AddEventReqToEventModel(addEventReqDTO),
+			Payload:     item,
+			ContentType: sequence.ContentType(),
+		})
+		batchIndexes = append(batchIndexes, index)
+		if len(batch) == eventImportBatchSize {
+			importBatch()
+		}
+	}
+	if len(batch) > 0 {
+		importBatch()
+	}
+	sort.Slice(failures, func(i, j int) bool { return failures[i].Index < failures[j].Index })
+
+	statusCode := http.StatusCreated
+	if len(failures) > 0 {
+		statusCode = http.StatusMultiStatus
+		lc.Errorf("%d of %d imported events failed", len(failures), total)
+	}
+	response := pkgResponses.NewEventImportResponse("", "", statusCode, uint32(total), uint32(imported), failures)
+	utils.WriteHttpHeader(w, ctx, statusCode)
+	// encode and send out the response
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
 func (ec *EventController) EventById(c echo.Context) error {
 	// retrieve all the service injections from bootstrap
 	lc := container.LoggingClientFrom(ec.dic.Get)
@@ -222,6 +331,13 @@ func (ec *EventController) AllEvents(c echo.Context) error {
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
+	cursor, pagedByCursor, err := utils.ParseCursorQueryString(c, offset)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if pagedByCursor {
+		return ec.eventsByCursor(c, "", cursor, limit)
+	}
 	events, totalCount, err := ec.app.AllEvents(offset, limit, ec.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
@@ -245,6 +361,13 @@ func (ec *EventController) EventsByDeviceName(c echo.Context) error {
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
+	cursor, pagedByCursor, err := utils.ParseCursorQueryString(c, offset)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if pagedByCursor {
+		return ec.eventsByCursor(c, name, cursor, limit)
+	}
 	events, totalCount, err := ec.app.EventsByDeviceName(offset, limit, name, ec.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
@@ -320,3 +443,21 @@ func (ec *EventController) DeleteEventsByAge(c echo.Context) error {
 	// encode and send out the response
 	return pkg.EncodeAndWriteResponse(response, w, lc)
 }
+
+// eventsByCursor responds with the events of a device positioned after the cursor, or the events of every device when
+// name is empty
+func (ec *EventController) eventsByCursor(c echo.Context, name string, cursor pkgModels.Cursor, limit int) error {
+	lc := container.LoggingClientFrom(ec.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	events, totalCount, next, err := ec.app.EventsByCursor(name, cursor, limit, ec.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := pkgResponses.NewMultiEventsWithCursorResponse("", "", http.StatusOK, totalCount, events, next.Token())
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
@@ -6,6 +6,7 @@
 package http
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -16,6 +17,7 @@ import (
 	"github.com/fxamacker/cbor/v2"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
@@ -32,6 +34,9 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 
 	"github.com/labstack/echo/v4"
 )
@@ -363,6 +368,96 @@ func TestAddEventSize(t *testing.T) {
 	}
 }
 
+func TestImportEvents(t *testing.T) {
+	first := testAddEvent
+	second := testAddEvent
+	second.Event.Id = uuid.New().String()
+	duplicateErr := errors.NewCommonEdgeX(errors.KindDuplicateName, "Event Id exists", nil)
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddEvents", mock.MatchedBy(func(events []models.Event) bool { return len(events) == 2 && events[1].Id == second.Event.Id })).
+		Return([]errors.EdgeX{nil, duplicateErr}, nil)
+	dbClientMock.On("AddEvents", mock.Anything).Return([]errors.EdgeX{nil}, nil)
+
+	dic := mocks.NewMockDIC()
+	app := application.NewCoreDataApp(dic)
+	dic.Update(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				MaxEventSize: 25,
+				Writable: config.WritableInfo{
+					PersistData: true,
+				},
+			}
+		},
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		application.CoreDataAppName: func(get di.Get) interface{} {
+			return app
+		},
+	})
+	ec := NewEventController(dic)
+
+	firstJSON, err := json.Marshal(first)
+	require.NoError(t, err)
+	secondJSON, err := json.Marshal(second)
+	require.NoError(t, err)
+	ndjson := string(firstJSON) + "\n{\"apiVersion\":\"v3\"}\n" + string(secondJSON) + "\n"
+	firstCBOR, err := cbor.Marshal(first)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name                  string
+		contentType           string
+		serviceName           string
+		body                  []byte
+		expectedStatusCode    int
+		expectedTotalCount    uint32
+		expectedImportedCount uint32
+		expectedFailures      []int
+	}{
+		{"Valid - NDJSON with failures", pkgCommon.ContentTypeNDJSON, TestServiceName, []byte(ndjson), http.StatusMultiStatus, 3, 1, []int{1, 2}},
+		{"Valid - CBOR sequence", common.ContentTypeCBOR, TestServiceName, firstCBOR, http.StatusCreated, 1, 1, nil},
+		{"Valid - truncated CBOR sequence", common.ContentTypeCBOR, TestServiceName, append(firstCBOR, firstCBOR[:10]...), http.StatusMultiStatus, 2, 1, []int{1}},
+		{"Invalid - unsupported content type", common.ContentTypeJSON, TestServiceName, firstJSON, http.StatusBadRequest, 0, 0, nil},
+		{"Invalid - empty service name", pkgCommon.ContentTypeNDJSON, "", []byte(ndjson), http.StatusBadRequest, 0, 0, nil},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodPost, pkgCommon.ApiEventImportByServiceNameEchoRoute, bytes.NewReader(testCase.body))
+			require.NoError(t, err)
+			req.Header.Set(common.ContentType, testCase.contentType)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.ServiceName)
+			c.SetParamValues(testCase.serviceName)
+			err = ec.ImportEvents(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode == http.StatusBadRequest {
+				return
+			}
+			var res pkgResponses.EventImportResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			assert.Equal(t, testCase.expectedTotalCount, res.TotalCount, "Total count not as expected")
+			assert.Equal(t, testCase.expectedImportedCount, res.ImportedCount, "Imported count not as expected")
+			require.Len(t, res.Failures, len(testCase.expectedFailures))
+			for i, index := range testCase.expectedFailures {
+				assert.Equal(t, index, res.Failures[i].Index)
+				assert.NotEmpty(t, res.Failures[i].Message)
+			}
+		})
+	}
+}
+
 func TestEventById(t *testing.T) {
 	validEventId := expectedEventId
 	emptyEventId := ""
@@ -688,6 +783,75 @@ func TestAllEvents(t *testing.T) {
 	}
 }
 
+func TestAllEventsByCursor(t *testing.T) {
+	events := []models.Event{persistedEvent, persistedEvent}
+	totalCount := uint32(3)
+	first := pkgModels.Cursor{Score: TestOriginTime, Id: ExampleUUID}
+
+	dic := mocks.NewMockDIC()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("EventTotalCount").Return(totalCount, nil)
+	dbClientMock.On("EventsBeforeCursor", "", pkgModels.Cursor{}, 2).Return(events, first, nil)
+	dbClientMock.On("EventsBeforeCursor", "", first, 2).Return(events[:1], pkgModels.Cursor{}, nil)
+	app := application.NewCoreDataApp(dic)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		application.CoreDataAppName: func(get di.Get) interface{} {
+			return app
+		},
+	})
+	controller := NewEventController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		offset             string
+		cursor             string
+		expectedCount      int
+		expectedNextCursor string
+		expectedStatusCode int
+	}{
+		{"Valid - get the first page", "", "", 2, first.Token(), http.StatusOK},
+		{"Valid - get the last page", "", first.Token(), 1, "", http.StatusOK},
+		{"Invalid - cursor along with offset", "1", first.Token(), 0, "", http.StatusBadRequest},
+		{"Invalid - malformed cursor", "", "???", 0, "", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, common.ApiAllEventRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			if testCase.offset != "" {
+				query.Add(common.Offset, testCase.offset)
+			}
+			query.Add(common.Limit, "2")
+			query.Add(pkgCommon.Cursor, testCase.cursor)
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = controller.AllEvents(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				return
+			}
+			var res pkgResponses.MultiEventsWithCursorResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedCount, len(res.Events), "Event count not as expected")
+			assert.Equal(t, totalCount, res.TotalCount, "Total count not as expected")
+			assert.Equal(t, testCase.expectedNextCursor, res.NextCursor, "Next cursor not as expected")
+		})
+	}
+}
+
 func TestAllEventsByDeviceName(t *testing.T) {
 	testDeviceA := "testDeviceA"
 	testDeviceB := "testDeviceB"
@@ -9,11 +9,16 @@ import (
 	"fmt"
 	"math"
 	"net/http"
+	"time"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/data/application"
 	dataContainer "github.com/edgexfoundry/edgex-go/internal/core/data/container"
 	"github.com/edgexfoundry/edgex-go/internal/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
@@ -69,6 +74,13 @@ func (rc *ReadingController) AllReadings(c echo.Context) error {
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
+	cursor, pagedByCursor, err := utils.ParseCursorQueryString(c, offset)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if pagedByCursor {
+		return rc.readingsByCursor(c, "", cursor, limit)
+	}
 	readings, totalCount, err := application.AllReadings(offset, limit, rc.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
@@ -139,6 +151,13 @@ func (rc *ReadingController) ReadingsByDeviceName(c echo.Context) error {
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
+	cursor, pagedByCursor, err := utils.ParseCursorQueryString(c, offset)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if pagedByCursor {
+		return rc.readingsByCursor(c, name, cursor, limit)
+	}
 	readings, totalCount, err := application.ReadingsByDeviceName(offset, limit, name, rc.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
@@ -293,3 +312,117 @@ func (rc *ReadingController) ReadingsByDeviceNameAndResourceNamesAndTimeRange(c
 	utils.WriteHttpHeader(w, ctx, http.StatusOK)
 	return pkg.EncodeAndWriteResponse(response, w, lc)
 }
+
+func (rc *ReadingController) AggregateReadingsByDeviceNameAndResourceNameAndTimeRange(c echo.Context) error {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	deviceName := c.Param(common.Name)
+	resourceName := c.Param(common.ResourceName)
+
+	// parse time range (start, end), window and aggregation functions from incoming request
+	start, err := utils.ParsePathParamToInt(c, common.Start)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	end, err := utils.ParsePathParamToInt(c, common.End)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if end < start {
+		err = errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("end's value %v is not allowed to be greater than start's value %v", end, start), nil)
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	window := utils.ParseQueryStringToString(r, pkgCommon.Window, "")
+	functions := utils.ParseQueryStringToStrings(c, pkgCommon.Functions, common.CommaSeparator)
+
+	aggregates, err := application.AggregateReadingsByDeviceNameAndResourceNameAndTimeRange(deviceName, resourceName, start, end, window, functions, rc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := pkgResponses.NewMultiReadingAggregatesResponse("", "", http.StatusOK, uint32(len(aggregates)), aggregates)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// ExportReadings streams the readings matching the device, resource and time range filters in the requested format.
+// Errors occurring once the stream has started can't be reported through the status code anymore, so they are logged
+// and the stream is terminated.
+func (rc *ReadingController) ExportReadings(c echo.Context) error {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// parse the filters and format from incoming request
+	start, err := utils.ParseQueryStringToInt(c, common.Start, 0, 0, math.MaxInt64)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	end, err := utils.ParseQueryStringToInt(c, common.End, int(time.Now().UnixNano()), 0, math.MaxInt64)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	filter := pkgModels.ReadingFilter{
+		DeviceName:   utils.ParseQueryStringToString(r, common.DeviceName, ""),
+		ResourceName: utils.ParseQueryStringToString(r, common.ResourceName, ""),
+		Start:        int64(start),
+		End:          int64(end),
+	}
+	format := utils.ParseQueryStringToString(r, pkgCommon.Format, pkgCommon.ExportFormatNDJSON)
+
+	cursor, err := application.NewReadingExportCursor(filter, rc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	encoder, err := application.NewReadingEncoder(format, w)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	readings, err := cursor.Next()
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	w.Header().Set(common.CorrelationHeader, correlation.FromContext(ctx))
+	w.Header().Set(common.ContentType, encoder.ContentType())
+	w.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=readings.%s", format))
+	w.WriteHeader(http.StatusOK)
+	for len(readings) > 0 {
+		if encodeErr := encoder.Encode(readings); encodeErr != nil {
+			lc.Errorf("Failed to encode the exported readings, the export is terminated: %v", encodeErr)
+			return nil
+		}
+		w.Flush()
+		readings, err = cursor.Next()
+		if err != nil {
+			lc.Errorf("Failed to query the exported readings, the export is terminated: %v", err)
+			return nil
+		}
+	}
+	if closeErr := encoder.Close(); closeErr != nil {
+		lc.Errorf("Failed to terminate the exported readings: %v", closeErr)
+	}
+	return nil
+}
+
+// readingsByCursor responds with the readings of a device positioned after the cursor, or the readings of every device
+// when name is empty
+func (rc *ReadingController) readingsByCursor(c echo.Context, name string, cursor pkgModels.Cursor, limit int) error {
+	lc := container.LoggingClientFrom(rc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	readings, totalCount, next, err := application.ReadingsByCursor(name, cursor, limit, rc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := pkgResponses.NewMultiReadingsWithCursorResponse("", "", http.StatusOK, totalCount, readings, next.Token())
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
@@ -10,12 +10,17 @@ import (
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/data/container"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces/mocks"
 	"github.com/edgexfoundry/edgex-go/internal/core/data/mocks"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
 	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
 	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
@@ -126,6 +131,62 @@ func TestAllReadings(t *testing.T) {
 	}
 }
 
+func TestReadingsByDeviceNameByCursor(t *testing.T) {
+	totalCount := uint32(2)
+	next := pkgModels.Cursor{Score: TestOriginTime, Id: ExampleUUID}
+
+	dic := mocks.NewMockDIC()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ReadingCountByDeviceName", TestDeviceName).Return(totalCount, nil)
+	dbClientMock.On("ReadingsBeforeCursor", TestDeviceName, pkgModels.Cursor{}, 1).Return([]models.Reading{persistedReading}, next, nil)
+	dbClientMock.On("ReadingsBeforeCursor", TestDeviceName, next, 1).Return([]models.Reading{}, pkgModels.Cursor{}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewReadingController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		cursor             string
+		expectedCount      int
+		expectedNextCursor string
+	}{
+		{"Valid - get the first page", "", 1, next.Token()},
+		{"Valid - get the page after the last reading", next.Token(), 0, ""},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, common.ApiReadingByDeviceNameEchoRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(common.Limit, "1")
+			query.Add(pkgCommon.Cursor, testCase.cursor)
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(TestDeviceName)
+			err = controller.ReadingsByDeviceName(c)
+			require.NoError(t, err)
+
+			// Assert
+			var res pkgResponses.MultiReadingsWithCursorResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedCount, len(res.Readings), "Reading count not as expected")
+			assert.Equal(t, totalCount, res.TotalCount, "Total count not as expected")
+			assert.Equal(t, testCase.expectedNextCursor, res.NextCursor, "Next cursor not as expected")
+		})
+	}
+}
+
 func TestReadingsByTimeRange(t *testing.T) {
 	totalCount := uint32(0)
 	dic := mocks.NewMockDIC()
@@ -711,3 +772,166 @@ func TestReadingsByDeviceNameAndResourceNamesAndTimeRange(t *testing.T) {
 		})
 	}
 }
+
+func TestAggregateReadingsByDeviceNameAndResourceNameAndTimeRange(t *testing.T) {
+	aggregates := []pkgModels.ReadingAggregate{
+		{
+			DeviceName:   TestDeviceName,
+			ResourceName: TestDeviceResourceName,
+			Start:        0,
+			End:          60000000000,
+			Values:       map[string]float64{pkgModels.AggregateMean: 21.5},
+		},
+	}
+	allFunctions := pkgModels.AggregateFunctions
+	dic := mocks.NewMockDIC()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AggregateReadingsByDeviceNameAndResourceNameAndTimeRange", TestDeviceName, TestDeviceResourceName, 0, 600000000000, int64(60000000000), []string{pkgModels.AggregateMean}).Return(aggregates, nil)
+	dbClientMock.On("AggregateReadingsByDeviceNameAndResourceNameAndTimeRange", TestDeviceName, TestDeviceResourceName, 0, 600000000000, int64(60000000000), allFunctions).Return(aggregates, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	rc := NewReadingController(dic)
+	assert.NotNil(t, rc)
+
+	tests := []struct {
+		name               string
+		deviceName         string
+		resourceName       string
+		start              string
+		end                string
+		window             string
+		functions          string
+		errorExpected      bool
+		expectedStatusCode int
+	}{
+		{"Valid - mean", TestDeviceName, TestDeviceResourceName, "0", "600000000000", "1m", pkgModels.AggregateMean, false, http.StatusOK},
+		{"Valid - all functions", TestDeviceName, TestDeviceResourceName, "0", "600000000000", "1m", "", false, http.StatusOK},
+		{"Invalid - empty deviceName", "", TestDeviceResourceName, "0", "600000000000", "1m", "", true, http.StatusBadRequest},
+		{"Invalid - empty resourceName", TestDeviceName, "", "0", "600000000000", "1m", "", true, http.StatusBadRequest},
+		{"Invalid - invalid start format", TestDeviceName, TestDeviceResourceName, "aaa", "600000000000", "1m", "", true, http.StatusBadRequest},
+		{"Invalid - end before start", TestDeviceName, TestDeviceResourceName, "10", "0", "1m", "", true, http.StatusBadRequest},
+		{"Invalid - empty window", TestDeviceName, TestDeviceResourceName, "0", "600000000000", "", "", true, http.StatusBadRequest},
+		{"Invalid - negative window", TestDeviceName, TestDeviceResourceName, "0", "600000000000", "-1m", "", true, http.StatusBadRequest},
+		{"Invalid - too many windows", TestDeviceName, TestDeviceResourceName, "0", "600000000000", "1s", "", true, http.StatusBadRequest},
+		{"Invalid - unsupported function", TestDeviceName, TestDeviceResourceName, "0", "600000000000", "1m", "median", true, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiReadingAggregateByDeviceNameAndResourceNameAndTimeRangeEchoRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(pkgCommon.Window, testCase.window)
+			if testCase.functions != "" {
+				query.Add(pkgCommon.Functions, testCase.functions)
+			}
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name, common.ResourceName, common.Start, common.End)
+			c.SetParamValues(testCase.deviceName, testCase.resourceName, testCase.start, testCase.end)
+			err = rc.AggregateReadingsByDeviceNameAndResourceNameAndTimeRange(c)
+			require.NoError(t, err)
+
+			// Assert
+			if testCase.errorExpected {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			} else {
+				var res pkgResponses.MultiReadingAggregatesResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+				assert.Empty(t, res.Message, "Message should be empty when it is successful")
+				assert.Equal(t, uint32(len(aggregates)), res.TotalCount, "Total count not as expected")
+				require.Len(t, res.Aggregates, 1)
+				assert.Equal(t, 21.5, res.Aggregates[0].Values[pkgModels.AggregateMean])
+			}
+		})
+	}
+}
+
+func TestExportReadings(t *testing.T) {
+	filter := pkgModels.ReadingFilter{DeviceName: TestDeviceName, Start: 0, End: 600000000000}
+	next := pkgModels.Cursor{Score: TestOriginTime, Id: ExampleUUID}
+	dic := mocks.NewMockDIC()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("ReadingsByCursor", filter, pkgModels.Cursor{}, mock.Anything).Return([]models.Reading{persistedReading}, next, nil)
+	dbClientMock.On("ReadingsByCursor", filter, next, mock.Anything).Return(nil, next, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	rc := NewReadingController(dic)
+	assert.NotNil(t, rc)
+
+	tests := []struct {
+		name                string
+		format              string
+		start               string
+		end                 string
+		errorExpected       bool
+		expectedStatusCode  int
+		expectedContentType string
+	}{
+		{"Valid - default format", "", "0", "600000000000", false, http.StatusOK, pkgCommon.ContentTypeNDJSON},
+		{"Valid - csv", pkgCommon.ExportFormatCSV, "0", "600000000000", false, http.StatusOK, pkgCommon.ContentTypeCSV},
+		{"Valid - parquet", pkgCommon.ExportFormatParquet, "0", "600000000000", false, http.StatusOK, pkgCommon.ContentTypeParquet},
+		{"Invalid - unsupported format", "xml", "0", "600000000000", true, http.StatusBadRequest, ""},
+		{"Invalid - invalid start format", "", "aaa", "600000000000", true, http.StatusBadRequest, ""},
+		{"Invalid - end before start", "", "10", "0", true, http.StatusBadRequest, ""},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiReadingExportRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(common.DeviceName, TestDeviceName)
+			query.Add(common.Start, testCase.start)
+			query.Add(common.End, testCase.end)
+			if testCase.format != "" {
+				query.Add(pkgCommon.Format, testCase.format)
+			}
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = rc.ExportReadings(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.errorExpected {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				return
+			}
+			assert.Equal(t, testCase.expectedContentType, recorder.Header().Get(common.ContentType))
+			assert.NotEmpty(t, recorder.Body.Bytes())
+			if testCase.expectedContentType == pkgCommon.ContentTypeNDJSON {
+				var reading dtos.BaseReading
+				err = json.Unmarshal(recorder.Body.Bytes(), &reading)
+				require.NoError(t, err)
+				assert.Equal(t, ExampleUUID, reading.Id)
+			}
+		})
+	}
+}
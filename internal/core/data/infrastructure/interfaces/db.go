@@ -8,12 +8,15 @@ package interfaces
 import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 	model "github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 )
 
 type DBClient interface {
 	CloseSession()
 
 	AddEvent(e model.Event) (model.Event, errors.EdgeX)
+	AddEvents(events []model.Event) ([]errors.EdgeX, errors.EdgeX)
 	EventById(id string) (model.Event, errors.EdgeX)
 	DeleteEventById(id string) errors.EdgeX
 	EventTotalCount() (uint32, errors.EdgeX)
@@ -21,17 +24,22 @@ type DBClient interface {
 	EventCountByTimeRange(start int, end int) (uint32, errors.EdgeX)
 	AllEvents(offset int, limit int) ([]model.Event, errors.EdgeX)
 	EventsByDeviceName(offset int, limit int, name string) ([]model.Event, errors.EdgeX)
+	EventsBeforeCursor(name string, cursor pkgModels.Cursor, limit int) ([]model.Event, pkgModels.Cursor, errors.EdgeX)
 	DeleteEventsByDeviceName(deviceName string) errors.EdgeX
 	EventsByTimeRange(start int, end int, offset int, limit int) ([]model.Event, errors.EdgeX)
 	DeleteEventsByAge(age int64) errors.EdgeX
+	DeleteEventsByAgeAndDeviceName(age int64, deviceName string) errors.EdgeX
+	DeleteEventsByAgeAndProfileName(age int64, profileName string) errors.EdgeX
 	ReadingTotalCount() (uint32, errors.EdgeX)
 	AllReadings(offset int, limit int) ([]model.Reading, errors.EdgeX)
 	ReadingsByTimeRange(start int, end int, offset int, limit int) ([]model.Reading, errors.EdgeX)
 	ReadingsByResourceName(offset int, limit int, resourceName string) ([]model.Reading, errors.EdgeX)
 	ReadingsByDeviceName(offset int, limit int, name string) ([]model.Reading, errors.EdgeX)
+	ReadingsBeforeCursor(name string, cursor pkgModels.Cursor, limit int) ([]model.Reading, pkgModels.Cursor, errors.EdgeX)
 	ReadingsByDeviceNameAndResourceName(deviceName string, resourceName string, offset int, limit int) ([]model.Reading, errors.EdgeX)
 	ReadingsByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, start int, end int, offset int, limit int) ([]model.Reading, errors.EdgeX)
 	ReadingCountByDeviceName(deviceName string) (uint32, errors.EdgeX)
+	ReadingCountByProfileName(profileName string) (uint32, errors.EdgeX)
 	ReadingCountByResourceName(resourceName string) (uint32, errors.EdgeX)
 	ReadingCountByResourceNameAndTimeRange(resourceName string, start int, end int) (uint32, errors.EdgeX)
 	ReadingCountByDeviceNameAndResourceName(deviceName string, resourceName string) (uint32, errors.EdgeX)
@@ -42,4 +50,8 @@ type DBClient interface {
 	ReadingsByDeviceNameAndTimeRange(deviceName string, start int, end int, offset int, limit int) ([]model.Reading, errors.EdgeX)
 	ReadingCountByDeviceNameAndTimeRange(deviceName string, start int, end int) (uint32, errors.EdgeX)
 	LatestReadingByOffset(offset uint32) (model.Reading, errors.EdgeX)
+	LatestReadingByOffsetAndDeviceName(offset uint32, deviceName string) (model.Reading, errors.EdgeX)
+	LatestReadingByOffsetAndProfileName(offset uint32, profileName string) (model.Reading, errors.EdgeX)
+	ReadingsByCursor(filter pkgModels.ReadingFilter, cursor pkgModels.Cursor, limit int) ([]model.Reading, pkgModels.Cursor, errors.EdgeX)
+	AggregateReadingsByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, start int, end int, window int64, functions []string) ([]pkgModels.ReadingAggregate, errors.EdgeX)
 }
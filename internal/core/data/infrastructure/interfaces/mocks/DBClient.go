@@ -8,6 +8,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	models "github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	pkgmodels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 )
 
 // DBClient is an autogenerated mock type for the DBClient type
@@ -41,6 +43,62 @@ func (_m *DBClient) AddEvent(e models.Event) (models.Event, errors.EdgeX) {
 	return r0, r1
 }
 
+// AddEvents provides a mock function with given fields: events
+func (_m *DBClient) AddEvents(events []models.Event) ([]errors.EdgeX, errors.EdgeX) {
+	ret := _m.Called(events)
+
+	var r0 []errors.EdgeX
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func([]models.Event) ([]errors.EdgeX, errors.EdgeX)); ok {
+		return rf(events)
+	}
+	if rf, ok := ret.Get(0).(func([]models.Event) []errors.EdgeX); ok {
+		r0 = rf(events)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]errors.EdgeX)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]models.Event) errors.EdgeX); ok {
+		r1 = rf(events)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AggregateReadingsByDeviceNameAndResourceNameAndTimeRange provides a mock function with given fields: deviceName, resourceName, start, end, window, functions
+func (_m *DBClient) AggregateReadingsByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, start int, end int, window int64, functions []string) ([]pkgmodels.ReadingAggregate, errors.EdgeX) {
+	ret := _m.Called(deviceName, resourceName, start, end, window, functions)
+
+	var r0 []pkgmodels.ReadingAggregate
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, string, int, int, int64, []string) ([]pkgmodels.ReadingAggregate, errors.EdgeX)); ok {
+		return rf(deviceName, resourceName, start, end, window, functions)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int, int, int64, []string) []pkgmodels.ReadingAggregate); ok {
+		r0 = rf(deviceName, resourceName, start, end, window, functions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgmodels.ReadingAggregate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int, int, int64, []string) errors.EdgeX); ok {
+		r1 = rf(deviceName, resourceName, start, end, window, functions)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AllEvents provides a mock function with given fields: offset, limit
 func (_m *DBClient) AllEvents(offset int, limit int) ([]models.Event, errors.EdgeX) {
 	ret := _m.Called(offset, limit)
@@ -134,6 +192,38 @@ func (_m *DBClient) DeleteEventsByAge(age int64) errors.EdgeX {
 	return r0
 }
 
+// DeleteEventsByAgeAndDeviceName provides a mock function with given fields: age, deviceName
+func (_m *DBClient) DeleteEventsByAgeAndDeviceName(age int64, deviceName string) errors.EdgeX {
+	ret := _m.Called(age, deviceName)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int64, string) errors.EdgeX); ok {
+		r0 = rf(age, deviceName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteEventsByAgeAndProfileName provides a mock function with given fields: age, profileName
+func (_m *DBClient) DeleteEventsByAgeAndProfileName(age int64, profileName string) errors.EdgeX {
+	ret := _m.Called(age, profileName)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int64, string) errors.EdgeX); ok {
+		r0 = rf(age, profileName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // DeleteEventsByDeviceName provides a mock function with given fields: deviceName
 func (_m *DBClient) DeleteEventsByDeviceName(deviceName string) errors.EdgeX {
 	ret := _m.Called(deviceName)
@@ -254,6 +344,41 @@ func (_m *DBClient) EventTotalCount() (uint32, errors.EdgeX) {
 	return r0, r1
 }
 
+// EventsBeforeCursor provides a mock function with given fields: name, cursor, limit
+func (_m *DBClient) EventsBeforeCursor(name string, cursor pkgmodels.Cursor, limit int) ([]models.Event, pkgmodels.Cursor, errors.EdgeX) {
+	ret := _m.Called(name, cursor, limit)
+
+	var r0 []models.Event
+	var r1 pkgmodels.Cursor
+	var r2 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, pkgmodels.Cursor, int) ([]models.Event, pkgmodels.Cursor, errors.EdgeX)); ok {
+		return rf(name, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, pkgmodels.Cursor, int) []models.Event); ok {
+		r0 = rf(name, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, pkgmodels.Cursor, int) pkgmodels.Cursor); ok {
+		r1 = rf(name, cursor, limit)
+	} else {
+		r1 = ret.Get(1).(pkgmodels.Cursor)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, pkgmodels.Cursor, int) errors.EdgeX); ok {
+		r2 = rf(name, cursor, limit)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1, r2
+}
+
 // EventsByDeviceName provides a mock function with given fields: offset, limit, name
 func (_m *DBClient) EventsByDeviceName(offset int, limit int, name string) ([]models.Event, errors.EdgeX) {
 	ret := _m.Called(offset, limit, name)
@@ -338,6 +463,62 @@ func (_m *DBClient) LatestReadingByOffset(offset uint32) (models.Reading, errors
 	return r0, r1
 }
 
+// LatestReadingByOffsetAndDeviceName provides a mock function with given fields: offset, deviceName
+func (_m *DBClient) LatestReadingByOffsetAndDeviceName(offset uint32, deviceName string) (models.Reading, errors.EdgeX) {
+	ret := _m.Called(offset, deviceName)
+
+	var r0 models.Reading
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(uint32, string) (models.Reading, errors.EdgeX)); ok {
+		return rf(offset, deviceName)
+	}
+	if rf, ok := ret.Get(0).(func(uint32, string) models.Reading); ok {
+		r0 = rf(offset, deviceName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(models.Reading)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint32, string) errors.EdgeX); ok {
+		r1 = rf(offset, deviceName)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// LatestReadingByOffsetAndProfileName provides a mock function with given fields: offset, profileName
+func (_m *DBClient) LatestReadingByOffsetAndProfileName(offset uint32, profileName string) (models.Reading, errors.EdgeX) {
+	ret := _m.Called(offset, profileName)
+
+	var r0 models.Reading
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(uint32, string) (models.Reading, errors.EdgeX)); ok {
+		return rf(offset, profileName)
+	}
+	if rf, ok := ret.Get(0).(func(uint32, string) models.Reading); ok {
+		r0 = rf(offset, profileName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(models.Reading)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint32, string) errors.EdgeX); ok {
+		r1 = rf(offset, profileName)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // ReadingCountByDeviceName provides a mock function with given fields: deviceName
 func (_m *DBClient) ReadingCountByDeviceName(deviceName string) (uint32, errors.EdgeX) {
 	ret := _m.Called(deviceName)
@@ -442,6 +623,32 @@ func (_m *DBClient) ReadingCountByDeviceNameAndTimeRange(deviceName string, star
 	return r0, r1
 }
 
+// ReadingCountByProfileName provides a mock function with given fields: profileName
+func (_m *DBClient) ReadingCountByProfileName(profileName string) (uint32, errors.EdgeX) {
+	ret := _m.Called(profileName)
+
+	var r0 uint32
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) (uint32, errors.EdgeX)); ok {
+		return rf(profileName)
+	}
+	if rf, ok := ret.Get(0).(func(string) uint32); ok {
+		r0 = rf(profileName)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(profileName)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // ReadingCountByResourceName provides a mock function with given fields: resourceName
 func (_m *DBClient) ReadingCountByResourceName(resourceName string) (uint32, errors.EdgeX) {
 	ret := _m.Called(resourceName)
@@ -546,6 +753,76 @@ func (_m *DBClient) ReadingTotalCount() (uint32, errors.EdgeX) {
 	return r0, r1
 }
 
+// ReadingsBeforeCursor provides a mock function with given fields: name, cursor, limit
+func (_m *DBClient) ReadingsBeforeCursor(name string, cursor pkgmodels.Cursor, limit int) ([]models.Reading, pkgmodels.Cursor, errors.EdgeX) {
+	ret := _m.Called(name, cursor, limit)
+
+	var r0 []models.Reading
+	var r1 pkgmodels.Cursor
+	var r2 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, pkgmodels.Cursor, int) ([]models.Reading, pkgmodels.Cursor, errors.EdgeX)); ok {
+		return rf(name, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, pkgmodels.Cursor, int) []models.Reading); ok {
+		r0 = rf(name, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Reading)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, pkgmodels.Cursor, int) pkgmodels.Cursor); ok {
+		r1 = rf(name, cursor, limit)
+	} else {
+		r1 = ret.Get(1).(pkgmodels.Cursor)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, pkgmodels.Cursor, int) errors.EdgeX); ok {
+		r2 = rf(name, cursor, limit)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1, r2
+}
+
+// ReadingsByCursor provides a mock function with given fields: filter, cursor, limit
+func (_m *DBClient) ReadingsByCursor(filter pkgmodels.ReadingFilter, cursor pkgmodels.Cursor, limit int) ([]models.Reading, pkgmodels.Cursor, errors.EdgeX) {
+	ret := _m.Called(filter, cursor, limit)
+
+	var r0 []models.Reading
+	var r1 pkgmodels.Cursor
+	var r2 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(pkgmodels.ReadingFilter, pkgmodels.Cursor, int) ([]models.Reading, pkgmodels.Cursor, errors.EdgeX)); ok {
+		return rf(filter, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(pkgmodels.ReadingFilter, pkgmodels.Cursor, int) []models.Reading); ok {
+		r0 = rf(filter, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Reading)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(pkgmodels.ReadingFilter, pkgmodels.Cursor, int) pkgmodels.Cursor); ok {
+		r1 = rf(filter, cursor, limit)
+	} else {
+		r1 = ret.Get(1).(pkgmodels.Cursor)
+	}
+
+	if rf, ok := ret.Get(2).(func(pkgmodels.ReadingFilter, pkgmodels.Cursor, int) errors.EdgeX); ok {
+		r2 = rf(filter, cursor, limit)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1, r2
+}
+
 // ReadingsByDeviceName provides a mock function with given fields: offset, limit, name
 func (_m *DBClient) ReadingsByDeviceName(offset int, limit int, name string) ([]models.Reading, errors.EdgeX) {
 	ret := _m.Called(offset, limit, name)
@@ -63,6 +63,10 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, st
 			lc.Errorf("Failed to parse reading retention interval, %v", err)
 			return false
 		}
+		if err := application.ValidateReadingRetention(config.Retention); err != nil {
+			lc.Errorf("Failed to validate reading retention, %v", err)
+			return false
+		}
 		application.AsyncPurgeReading(retentionInterval, ctx, dic)
 	}
 
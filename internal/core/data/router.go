@@ -15,6 +15,7 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 
 	dataController "github.com/edgexfoundry/edgex-go/internal/core/data/controller/http"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 
 	"github.com/labstack/echo/v4"
 )
@@ -30,6 +31,7 @@ func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 	// Events
 	ec := dataController.NewEventController(dic)
 	r.POST(common.ApiEventServiceNameProfileNameDeviceNameSourceNameEchoRoute, ec.AddEvent, authenticationHook)
+	r.POST(pkgCommon.ApiEventImportByServiceNameEchoRoute, ec.ImportEvents, authenticationHook)
 	r.GET(common.ApiEventIdEchoRoute, ec.EventById, authenticationHook)
 	r.DELETE(common.ApiEventIdEchoRoute, ec.DeleteEventById, authenticationHook)
 	r.GET(common.ApiEventCountRoute, ec.EventTotalCount, authenticationHook)
@@ -52,4 +54,6 @@ func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 	r.GET(common.ApiReadingByDeviceNameAndResourceNameEchoRoute, rc.ReadingsByDeviceNameAndResourceName, authenticationHook)
 	r.GET(common.ApiReadingByDeviceNameAndResourceNameAndTimeRangeEchoRoute, rc.ReadingsByDeviceNameAndResourceNameAndTimeRange, authenticationHook)
 	r.GET(common.ApiReadingByDeviceNameAndTimeRangeEchoRoute, rc.ReadingsByDeviceNameAndResourceNamesAndTimeRange, authenticationHook)
+	r.GET(pkgCommon.ApiReadingExportRoute, rc.ExportReadings, authenticationHook)
+	r.GET(pkgCommon.ApiReadingAggregateByDeviceNameAndResourceNameAndTimeRangeEchoRoute, rc.AggregateReadingsByDeviceNameAndResourceNameAndTimeRange, authenticationHook)
 }
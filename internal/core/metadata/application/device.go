@@ -32,6 +32,8 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/labelselector"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 )
 
@@ -93,6 +95,11 @@ func DeleteDeviceByName(name string, ctx context.Context, dic *di.Container) err
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	err = DeleteDeviceTwinByDeviceName(name, ctx, dic)
+	if err != nil && errors.Kind(err) != errors.KindEntityDoesNotExist {
+		lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+		lc.Warnf("fail to delete the device twin of device %s, err: %v", name, err)
+	}
 
 	deviceDTO := dtos.FromDeviceModelToDTO(device)
 	go publishSystemEvent(common.DeviceSystemEventType, common.SystemEventActionDelete, device.ServiceName, deviceDTO, ctx, dic)
@@ -227,6 +234,41 @@ func AllDevices(offset int, limit int, labels []string, dic *di.Container) (devi
 	return devices, totalCount, nil
 }
 
+// DevicesByLabelSelector query the devices whose labels satisfy the label selector with offset and limit
+func DevicesByLabelSelector(offset int, limit int, selector labelselector.Selector, dic *di.Container) (devices []dtos.Device, totalCount uint32, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	deviceModels, totalCount, err := dbClient.DevicesByLabelSelector(offset, limit, selector)
+	if err != nil {
+		return devices, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	devices = make([]dtos.Device, len(deviceModels))
+	for i, m := range deviceModels {
+		devices[i] = dtos.FromDeviceModelToDTO(m)
+	}
+	return devices, totalCount, nil
+}
+
+// DevicesByCursor query, in the order of AllDevices, at most limit devices positioned after the cursor.  The returned
+// cursor is the zero cursor once the last page is queried.  Paging by cursor doesn't support filtering by labels.
+func DevicesByCursor(cursor pkgModels.Cursor, limit int, labels []string, dic *di.Container) (devices []dtos.Device, totalCount uint32, next pkgModels.Cursor, err errors.EdgeX) {
+	if len(labels) > 0 {
+		return devices, totalCount, next, errors.NewCommonEdgeX(errors.KindContractInvalid, "labels are not supported when paging by cursor", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	deviceModels, next, err := dbClient.DevicesBeforeCursor(cursor, limit)
+	if err == nil {
+		totalCount, err = dbClient.DeviceCountByLabels(nil)
+	}
+	if err != nil {
+		return devices, totalCount, next, errors.NewCommonEdgeXWrapper(err)
+	}
+	devices = make([]dtos.Device, len(deviceModels))
+	for i, d := range deviceModels {
+		devices[i] = dtos.FromDeviceModelToDTO(d)
+	}
+	return devices, totalCount, next, nil
+}
+
 // DeviceByName query the device by name
 func DeviceByName(name string, dic *di.Container) (device dtos.Device, err errors.EdgeX) {
 	if name == "" {
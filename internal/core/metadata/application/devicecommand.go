@@ -9,6 +9,7 @@ import (
 	"context"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
@@ -36,13 +37,13 @@ func AddDeviceProfileDeviceCommand(profileName string, deviceCommand models.Devi
 		return errors.NewCommonEdgeXWrapper(validateErr)
 	}
 
-	err = dbClient.UpdateDeviceProfile(profile)
+	revision, err := updateDeviceProfileWithRevision(profile, pkgModels.DeviceProfileRevisionActionUpdate, 0, ctx, dic)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
 	lc.Debugf("DeviceProfile deviceCommands added on DB successfully. Correlation-id: %s ", correlation.FromContext(ctx))
-	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
+	go publishUpdateDeviceProfileSystemEvent(profileDTO, revision, ctx, dic)
 
 	return nil
 }
@@ -70,14 +71,14 @@ func PatchDeviceProfileDeviceCommand(profileName string, dto dtos.UpdateDeviceCo
 
 	requests.ReplaceDeviceCommandModelFieldsWithDTO(&profile.DeviceCommands[index], dto)
 
-	err = dbClient.UpdateDeviceProfile(profile)
+	revision, err := updateDeviceProfileWithRevision(profile, pkgModels.DeviceProfileRevisionActionUpdate, 0, ctx, dic)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
 	lc.Debugf("DeviceProfile deviceCommands patched on DB successfully. Correlation-id: %s ", correlation.FromContext(ctx))
 	profileDTO := dtos.FromDeviceProfileModelToDTO(profile)
-	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
+	go publishUpdateDeviceProfileSystemEvent(profileDTO, revision, ctx, dic)
 
 	return nil
 }
@@ -127,11 +128,11 @@ func DeleteDeviceCommandByName(profileName string, commandName string, ctx conte
 		return errors.NewCommonEdgeXWrapper(e)
 	}
 
-	err = dbClient.UpdateDeviceProfile(profile)
+	revision, err := updateDeviceProfileWithRevision(profile, pkgModels.DeviceProfileRevisionActionUpdate, 0, ctx, dic)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
-	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
+	go publishUpdateDeviceProfileSystemEvent(profileDTO, revision, ctx, dic)
 	return nil
 }
@@ -0,0 +1,197 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/labelselector"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// AddDeviceGroup adds a new device group
+func AddDeviceGroup(g pkgModels.DeviceGroup, ctx context.Context, dic *di.Container) (id string, edgeXerr errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	edgeXerr = validateDeviceGroupDeviceNames(dbClient, g.DeviceNames)
+	if edgeXerr != nil {
+		return "", edgeXerr
+	}
+
+	addedGroup, edgeXerr := dbClient.AddDeviceGroup(g)
+	if edgeXerr != nil {
+		return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	lc.Debugf("DeviceGroup created on DB successfully. DeviceGroup ID: %s, Correlation-ID: %s ",
+		addedGroup.Id,
+		correlation.FromContext(ctx))
+
+	return addedGroup.Id, nil
+}
+
+// validateDeviceGroupDeviceNames validates that the devices listed by name in a device group exist
+func validateDeviceGroupDeviceNames(dbClient interfaces.DBClient, deviceNames []string) errors.EdgeX {
+	for _, name := range deviceNames {
+		exists, edgeXerr := dbClient.DeviceNameExists(name)
+		if edgeXerr != nil {
+			return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("device '%s' existence check failed", name), edgeXerr)
+		} else if !exists {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device '%s' does not exists", name), nil)
+		}
+	}
+	return nil
+}
+
+// DeviceGroupByName query the device group by name
+func DeviceGroupByName(name string, dic *di.Container) (group pkgDtos.DeviceGroup, edgeXerr errors.EdgeX) {
+	if name == "" {
+		return group, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	g, edgeXerr := dbClient.DeviceGroupByName(name)
+	if edgeXerr != nil {
+		return group, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return pkgDtos.FromDeviceGroupModelToDTO(g), nil
+}
+
+// AllDeviceGroups query the device groups with offset and limit
+func AllDeviceGroups(offset int, limit int, dic *di.Container) (groups []pkgDtos.DeviceGroup, totalCount uint32, edgeXerr errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	groupModels, edgeXerr := dbClient.AllDeviceGroups(offset, limit)
+	if edgeXerr == nil {
+		totalCount, edgeXerr = dbClient.DeviceGroupTotalCount()
+	}
+	if edgeXerr != nil {
+		return groups, totalCount, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	groups = make([]pkgDtos.DeviceGroup, len(groupModels))
+	for i, g := range groupModels {
+		groups[i] = pkgDtos.FromDeviceGroupModelToDTO(g)
+	}
+	return groups, totalCount, nil
+}
+
+// PatchDeviceGroup executes the PATCH operation with the device group DTO to replace the old data
+func PatchDeviceGroup(dto pkgDtos.UpdateDeviceGroup, ctx context.Context, dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	var g pkgModels.DeviceGroup
+	var edgeXerr errors.EdgeX
+	// The ID or Name is required by DTO and the DTO also accepts empty string ID if the Name is provided
+	if dto.Id != nil && *dto.Id != "" {
+		g, edgeXerr = dbClient.DeviceGroupById(*dto.Id)
+	} else {
+		g, edgeXerr = dbClient.DeviceGroupByName(*dto.Name)
+	}
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if dto.Name != nil && *dto.Name != g.Name {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device group name '%s' not match the exsting '%s' ", *dto.Name, g.Name), nil)
+	}
+
+	pkgDtos.ReplaceDeviceGroupModelFieldsWithDTO(&g, dto)
+	if edgeXerr = pkgDtos.ValidateDeviceGroupMembers(g); edgeXerr != nil {
+		return edgeXerr
+	}
+	if edgeXerr = validateDeviceGroupDeviceNames(dbClient, dto.DeviceNames); edgeXerr != nil {
+		return edgeXerr
+	}
+
+	edgeXerr = dbClient.UpdateDeviceGroup(g)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	lc.Debugf("DeviceGroup patched on DB successfully. Correlation-ID: %s ", correlation.FromContext(ctx))
+	return nil
+}
+
+// DeleteDeviceGroupByName deletes the device group by name
+func DeleteDeviceGroupByName(name string, ctx context.Context, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	edgeXerr := dbClient.DeleteDeviceGroupByName(name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	bootstrapContainer.LoggingClientFrom(dic.Get).Debugf("DeviceGroup %s deleted on DB successfully. Correlation-ID: %s", name, correlation.FromContext(ctx))
+	return nil
+}
+
+// DevicesByDeviceGroupName query, sorted by name, the devices of the device group with offset and limit.  The devices
+// of the group are the devices listed by name which still exist and the devices whose labels satisfy the label
+// selector of the group.
+func DevicesByDeviceGroupName(offset int, limit int, name string, dic *di.Container) (devices []dtos.Device, totalCount uint32, edgeXerr errors.EdgeX) {
+	if name == "" {
+		return devices, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	g, edgeXerr := dbClient.DeviceGroupByName(name)
+	if edgeXerr != nil {
+		return devices, totalCount, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	members := make(map[string]models.Device)
+	for _, deviceName := range g.DeviceNames {
+		d, edgeXerr := dbClient.DeviceByName(deviceName)
+		if edgeXerr != nil {
+			if errors.Kind(edgeXerr) == errors.KindEntityDoesNotExist {
+				continue
+			}
+			return devices, totalCount, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		members[d.Name] = d
+	}
+	if g.LabelSelector != "" {
+		selector, edgeXerr := labelselector.Parse(g.LabelSelector)
+		if edgeXerr != nil {
+			return devices, totalCount, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("invalid label selector of device group %s", g.Name), edgeXerr)
+		}
+		selected, _, edgeXerr := dbClient.DevicesByLabelSelector(0, -1, selector)
+		if edgeXerr != nil {
+			return devices, totalCount, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		for _, d := range selected {
+			members[d.Name] = d
+		}
+	}
+
+	names := make([]string, 0, len(members))
+	for deviceName := range members {
+		names = append(names, deviceName)
+	}
+	sort.Strings(names)
+	totalCount = uint32(len(names))
+	if offset > len(names) {
+		return devices, totalCount, errors.NewCommonEdgeX(errors.KindRangeNotSatisfiable, fmt.Sprintf("query objects bounds out of range. length:%v", len(names)), nil)
+	}
+	end := offset + limit
+	if limit < 0 || end > len(names) {
+		end = len(names)
+	}
+	devices = make([]dtos.Device, 0, end-offset)
+	for _, deviceName := range names[offset:end] {
+		devices = append(devices, dtos.FromDeviceModelToDTO(members[deviceName]))
+	}
+	return devices, totalCount, nil
+}
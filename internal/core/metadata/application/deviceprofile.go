@@ -12,6 +12,8 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/labelselector"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
@@ -35,7 +37,11 @@ func AddDeviceProfile(d models.DeviceProfile, ctx context.Context, dic *di.Conta
 	}
 
 	correlationId := correlation.FromContext(ctx)
-	addedDeviceProfile, err := dbClient.AddDeviceProfile(d)
+	revision, err := newDeviceProfileRevision(d, pkgModels.DeviceProfileRevisionActionAdd, 0, ctx, dic)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	addedDeviceProfile, err := dbClient.AddDeviceProfileWithRevision(d, revision)
 	if err != nil {
 		return "", errors.NewCommonEdgeXWrapper(err)
 	}
@@ -55,17 +61,23 @@ func AddDeviceProfile(d models.DeviceProfile, ctx context.Context, dic *di.Conta
 // The UpdateDeviceProfile function accepts the device profile model from the controller functions
 // and invokes updateDeviceProfile function in the infrastructure layer
 func UpdateDeviceProfile(d models.DeviceProfile, ctx context.Context, dic *di.Container) (err errors.EdgeX) {
-	dbClient := container.DBClientFrom(dic.Get)
+	_, err = updateDeviceProfile(d, pkgModels.DeviceProfileRevisionActionUpdate, 0, ctx, dic)
+	return err
+}
+
+// updateDeviceProfile updates the device profile along with the revision of the updated profile with the action,
+// rolledBackTo being the revision number restored by a rollback
+func updateDeviceProfile(d models.DeviceProfile, action string, rolledBackTo int64, ctx context.Context, dic *di.Container) (revision pkgModels.DeviceProfileRevision, err errors.EdgeX) {
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 
 	err = deviceProfileUoMValidation(d, dic)
 	if err != nil {
-		return errors.NewCommonEdgeXWrapper(err)
+		return revision, errors.NewCommonEdgeXWrapper(err)
 	}
 
-	err = dbClient.UpdateDeviceProfile(d)
+	revision, err = updateDeviceProfileWithRevision(d, action, rolledBackTo, ctx, dic)
 	if err != nil {
-		return errors.NewCommonEdgeXWrapper(err)
+		return revision, errors.NewCommonEdgeXWrapper(err)
 	}
 
 	lc.Debugf(
@@ -73,15 +85,11 @@ func UpdateDeviceProfile(d models.DeviceProfile, ctx context.Context, dic *di.Co
 		correlation.FromContext(ctx),
 	)
 
-	profile, err := dbClient.DeviceProfileByName(d.Name)
-	if err != nil {
-		return errors.NewCommonEdgeXWrapper(err)
-	}
-
-	profileDTO := dtos.FromDeviceProfileModelToDTO(profile)
-	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
+	// The revision holds the profile as stored by the update
+	profileDTO := dtos.FromDeviceProfileModelToDTO(revision.Profile)
+	go publishUpdateDeviceProfileSystemEvent(profileDTO, revision, ctx, dic)
 
-	return nil
+	return revision, nil
 }
 
 // DeviceProfileByName query the device profile by name
@@ -140,6 +148,41 @@ func AllDeviceProfiles(offset int, limit int, labels []string, dic *di.Container
 	return deviceProfiles, totalCount, nil
 }
 
+// DeviceProfilesByLabelSelector query the device profiles whose labels satisfy the label selector with offset and limit
+func DeviceProfilesByLabelSelector(offset int, limit int, selector labelselector.Selector, dic *di.Container) (deviceProfiles []dtos.DeviceProfile, totalCount uint32, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	profileModels, totalCount, err := dbClient.DeviceProfilesByLabelSelector(offset, limit, selector)
+	if err != nil {
+		return deviceProfiles, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	deviceProfiles = make([]dtos.DeviceProfile, len(profileModels))
+	for i, m := range profileModels {
+		deviceProfiles[i] = dtos.FromDeviceProfileModelToDTO(m)
+	}
+	return deviceProfiles, totalCount, nil
+}
+
+// DeviceProfilesByCursor query, in the order of AllDeviceProfiles, at most limit device profiles positioned after the cursor.  The returned
+// cursor is the zero cursor once the last page is queried.  Paging by cursor doesn't support filtering by labels.
+func DeviceProfilesByCursor(cursor pkgModels.Cursor, limit int, labels []string, dic *di.Container) (deviceProfiles []dtos.DeviceProfile, totalCount uint32, next pkgModels.Cursor, err errors.EdgeX) {
+	if len(labels) > 0 {
+		return deviceProfiles, totalCount, next, errors.NewCommonEdgeX(errors.KindContractInvalid, "labels are not supported when paging by cursor", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	dps, next, err := dbClient.DeviceProfilesBeforeCursor(cursor, limit)
+	if err == nil {
+		totalCount, err = dbClient.DeviceProfileCountByLabels(nil)
+	}
+	if err != nil {
+		return deviceProfiles, totalCount, next, errors.NewCommonEdgeXWrapper(err)
+	}
+	deviceProfiles = make([]dtos.DeviceProfile, len(dps))
+	for i, dp := range dps {
+		deviceProfiles[i] = dtos.FromDeviceProfileModelToDTO(dp)
+	}
+	return deviceProfiles, totalCount, next, nil
+}
+
 // DeviceProfilesByModel query the device profiles with offset, limit and model
 func DeviceProfilesByModel(offset int, limit int, model string, dic *di.Container) (deviceProfiles []dtos.DeviceProfile, totalCount uint32, err errors.EdgeX) {
 	if model == "" {
@@ -210,7 +253,7 @@ func PatchDeviceProfileBasicInfo(ctx context.Context, dto dtos.UpdateDeviceProfi
 	}
 
 	requests.ReplaceDeviceProfileModelBasicInfoFieldsWithDTO(&deviceProfile, dto)
-	err = dbClient.UpdateDeviceProfile(deviceProfile)
+	revision, err := updateDeviceProfileWithRevision(deviceProfile, pkgModels.DeviceProfileRevisionActionUpdate, 0, ctx, dic)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
@@ -221,7 +264,7 @@ func PatchDeviceProfileBasicInfo(ctx context.Context, dto dtos.UpdateDeviceProfi
 	)
 
 	profileDTO := dtos.FromDeviceProfileModelToDTO(deviceProfile)
-	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
+	go publishUpdateDeviceProfileSystemEvent(profileDTO, revision, ctx, dic)
 
 	return nil
 }
@@ -246,12 +289,9 @@ func deviceProfileByDTO(dbClient interfaces.DBClient, dto dtos.UpdateDeviceProfi
 }
 
 func deviceProfileUoMValidation(p models.DeviceProfile, dic *di.Container) errors.EdgeX {
-	if container.ConfigurationFrom(dic.Get).Writable.UoM.Validation {
-		uom := container.UnitsOfMeasureFrom(dic.Get)
-		for _, dr := range p.DeviceResources {
-			if ok := uom.Validate(dr.Properties.Units); !ok {
-				return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s units %s is invalid", dr.Name, dr.Properties.Units), nil)
-			}
+	for _, dr := range p.DeviceResources {
+		if err := deviceResourceUoMValidation(dr, dic); err != nil {
+			return err
 		}
 	}
 
@@ -0,0 +1,276 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+// newDeviceProfileRevision creates the revision of the device profile as it is after being added, updated or rolled
+// back, numbered after the latest revision of the profile along with the changes from it.  The revision is recorded
+// in the same transaction as the change of the profile, which fails if another revision was recorded meanwhile.
+func newDeviceProfileRevision(profile models.DeviceProfile, action string, rolledBackTo int64, ctx context.Context, dic *di.Container) (pkgModels.DeviceProfileRevision, errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+
+	revision := pkgModels.DeviceProfileRevision{
+		ProfileName:  profile.Name,
+		Revision:     1,
+		Author:       utils.CallerIdentityFromContext(ctx),
+		Action:       action,
+		RolledBackTo: rolledBackTo,
+		Profile:      profile,
+	}
+	latest, err := dbClient.DeviceProfileRevisionsByProfileName(0, 1, profile.Name)
+	if err != nil {
+		return revision, errors.NewCommonEdgeXWrapper(err)
+	}
+	if len(latest) > 0 {
+		revision.Revision = latest[0].Revision + 1
+		revision.Changes, err = diffDeviceProfiles(dtos.FromDeviceProfileModelToDTO(latest[0].Profile), dtos.FromDeviceProfileModelToDTO(profile))
+		if err != nil {
+			return revision, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	return revision, nil
+}
+
+// updateDeviceProfileWithRevision updates the device profile along with its revision with the action, see
+// newDeviceProfileRevision, after recording the baseline revision of the profile if needed
+func updateDeviceProfileWithRevision(profile models.DeviceProfile, action string, rolledBackTo int64, ctx context.Context, dic *di.Container) (pkgModels.DeviceProfileRevision, errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	recordDeviceProfileBaselineRevision(profile.Name, ctx, dic)
+	revision, err := newDeviceProfileRevision(profile, action, rolledBackTo, ctx, dic)
+	if err != nil {
+		return revision, errors.NewCommonEdgeXWrapper(err)
+	}
+	revision, err = dbClient.UpdateDeviceProfileWithRevision(profile, revision)
+	if err != nil {
+		return revision, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	lc.Debugf("DeviceProfile %s revision %d recorded on DB successfully. Correlation-ID: %s", profile.Name, revision.Revision, correlation.FromContext(ctx))
+	return revision, nil
+}
+
+// recordDeviceProfileBaselineRevision records the stored device profile as a baseline revision when the profile has no
+// revisions, i.e. it was added before the revisions were recorded, so that its first update is recorded along with
+// the changes and can be rolled back.  As the baseline doesn't record a change of the profile, a failure is only
+// logged.
+func recordDeviceProfileBaselineRevision(name string, ctx context.Context, dic *di.Container) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	correlationId := correlation.FromContext(ctx)
+
+	latest, err := dbClient.DeviceProfileRevisionsByProfileName(0, 1, name)
+	if err != nil {
+		lc.Errorf("fail to query the latest revision of device profile %s, Correlation-ID: %s, err: %v", name, correlationId, err)
+		return
+	}
+	if len(latest) > 0 {
+		return
+	}
+	profile, err := dbClient.DeviceProfileByName(name)
+	if err != nil {
+		lc.Errorf("fail to query device profile %s for its baseline revision, Correlation-ID: %s, err: %v", name, correlationId, err)
+		return
+	}
+	revision, err := dbClient.AddDeviceProfileRevision(pkgModels.DeviceProfileRevision{
+		ProfileName: name,
+		Revision:    1,
+		Author:      utils.CallerIdentityFromContext(ctx),
+		Action:      pkgModels.DeviceProfileRevisionActionBaseline,
+		Profile:     profile,
+	})
+	if err != nil {
+		lc.Errorf("fail to record the baseline revision of device profile %s, Correlation-ID: %s, err: %v", name, correlationId, err)
+		return
+	}
+	lc.Debugf("DeviceProfile %s baseline revision %d recorded on DB successfully. Correlation-ID: %s", name, revision.Revision, correlationId)
+}
+
+// diffDeviceProfiles returns the changes from the previous to the current device profile, comparing their JSON
+// representations.  The ids, creation and modification timestamps, which are set by the database, are not compared.
+func diffDeviceProfiles(previous dtos.DeviceProfile, current dtos.DeviceProfile) ([]pkgModels.DeviceProfileChange, errors.EdgeX) {
+	previous.Id, previous.Created, previous.Modified = "", 0, 0
+	current.Id, current.Created, current.Modified = "", 0, 0
+
+	var p, c any
+	if err := jsonRoundTrip(previous, &p); err != nil {
+		return nil, err
+	}
+	if err := jsonRoundTrip(current, &c); err != nil {
+		return nil, err
+	}
+	return diffValues("", p, c, nil), nil
+}
+
+func jsonRoundTrip(in any, out any) errors.EdgeX {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to encode device profile", err)
+	}
+	if err = json.Unmarshal(data, out); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode device profile", err)
+	}
+	return nil
+}
+
+// diffValues appends the changes from the previous to the current JSON value at the path to changes.  The elements of
+// the arrays of named objects, e.g. the device resources, are matched by name rather than by index.
+func diffValues(path string, previous any, current any, changes []pkgModels.DeviceProfileChange) []pkgModels.DeviceProfileChange {
+	switch p := previous.(type) {
+	case map[string]any:
+		if c, ok := current.(map[string]any); ok {
+			keys := make([]string, 0, len(p)+len(c))
+			for k := range p {
+				keys = append(keys, k)
+			}
+			for k := range c {
+				if _, ok := p[k]; !ok {
+					keys = append(keys, k)
+				}
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				keyPath := k
+				if path != "" {
+					keyPath = path + "." + k
+				}
+				changes = diffValues(keyPath, p[k], c[k], changes)
+			}
+			return changes
+		}
+	case []any:
+		if c, ok := current.([]any); ok {
+			previousByName, previousNames, previousNamed := namedElements(p)
+			currentByName, currentNames, currentNamed := namedElements(c)
+			if previousNamed && currentNamed {
+				names := previousNames
+				for _, name := range currentNames {
+					if _, ok := previousByName[name]; !ok {
+						names = append(names, name)
+					}
+				}
+				for _, name := range names {
+					changes = diffValues(fmt.Sprintf("%s[%s]", path, name), previousByName[name], currentByName[name], changes)
+				}
+				return changes
+			}
+			for i := 0; i < max(len(p), len(c)); i++ {
+				var previousElement, currentElement any
+				if i < len(p) {
+					previousElement = p[i]
+				}
+				if i < len(c) {
+					currentElement = c[i]
+				}
+				changes = diffValues(fmt.Sprintf("%s[%d]", path, i), previousElement, currentElement, changes)
+			}
+			return changes
+		}
+	}
+	if !reflect.DeepEqual(previous, current) {
+		changes = append(changes, pkgModels.DeviceProfileChange{Path: path, Previous: previous, Current: current})
+	}
+	return changes
+}
+
+// namedElements indexes the elements of the array by name, in the order of the array, when all the elements are
+// objects with a unique name
+func namedElements(elements []any) (byName map[string]any, names []string, named bool) {
+	byName = make(map[string]any, len(elements))
+	for _, e := range elements {
+		object, ok := e.(map[string]any)
+		if !ok {
+			return nil, nil, false
+		}
+		name, ok := object["name"].(string)
+		if !ok || name == "" {
+			return nil, nil, false
+		}
+		if _, exists := byName[name]; exists {
+			return nil, nil, false
+		}
+		byName[name] = e
+		names = append(names, name)
+	}
+	return byName, names, true
+}
+
+// DeviceProfileRevisionsByName query the revisions of the device profile by profile name with offset and limit, the
+// latest revision first
+func DeviceProfileRevisionsByName(offset int, limit int, profileName string, dic *di.Container) (revisions []pkgDtos.DeviceProfileRevision, totalCount uint32, err errors.EdgeX) {
+	if profileName == "" {
+		return revisions, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, "profile name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	revisionModels, err := dbClient.DeviceProfileRevisionsByProfileName(offset, limit, profileName)
+	if err == nil {
+		totalCount, err = dbClient.DeviceProfileRevisionCountByProfileName(profileName)
+	}
+	if err != nil {
+		return revisions, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	revisions = make([]pkgDtos.DeviceProfileRevision, len(revisionModels))
+	for i, r := range revisionModels {
+		revisions[i] = pkgDtos.FromDeviceProfileRevisionModelToDTO(r)
+	}
+	return revisions, totalCount, nil
+}
+
+// DeviceProfileRevisionByNumber query the revision of the device profile by profile name and revision number
+func DeviceProfileRevisionByNumber(profileName string, revision int64, dic *di.Container) (r pkgDtos.DeviceProfileRevision, err errors.EdgeX) {
+	if profileName == "" {
+		return r, errors.NewCommonEdgeX(errors.KindContractInvalid, "profile name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	revisionModel, err := dbClient.DeviceProfileRevisionByNumber(profileName, revision)
+	if err != nil {
+		return r, errors.NewCommonEdgeXWrapper(err)
+	}
+	return pkgDtos.FromDeviceProfileRevisionModelToDTO(revisionModel), nil
+}
+
+// RollbackDeviceProfile updates the device profile to the profile recorded by one of its revisions, which records a
+// new rollback revision returned here.  Only existing profiles can be rolled back.
+func RollbackDeviceProfile(profileName string, revision int64, ctx context.Context, dic *di.Container) (r pkgDtos.DeviceProfileRevision, err errors.EdgeX) {
+	if profileName == "" {
+		return r, errors.NewCommonEdgeX(errors.KindContractInvalid, "profile name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	target, err := dbClient.DeviceProfileRevisionByNumber(profileName, revision)
+	if err != nil {
+		return r, errors.NewCommonEdgeXWrapper(err)
+	}
+	profile, err := dbClient.DeviceProfileByName(profileName)
+	if err != nil {
+		return r, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	rolledBack := target.Profile
+	rolledBack.Id = profile.Id
+	rollbackRevision, err := updateDeviceProfile(rolledBack, pkgModels.DeviceProfileRevisionActionRollback, revision, ctx, dic)
+	if err != nil {
+		return r, errors.NewCommonEdgeXWrapper(err)
+	}
+	return pkgDtos.FromDeviceProfileRevisionModelToDTO(rollbackRevision), nil
+}
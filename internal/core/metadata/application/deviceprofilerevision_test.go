@@ -0,0 +1,67 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+const testRevisionProfileName = "revision-profile"
+
+func TestRecordDeviceProfileBaselineRevision(t *testing.T) {
+	profile := models.DeviceProfile{Name: testRevisionProfileName, Description: "before the update"}
+
+	tests := []struct {
+		name             string
+		revisions        []pkgModels.DeviceProfileRevision
+		expectedBaseline bool
+	}{
+		{"no revisions", []pkgModels.DeviceProfileRevision{}, true},
+		{"revisions recorded", []pkgModels.DeviceProfileRevision{{Revision: 1}}, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("DeviceProfileRevisionsByProfileName", 0, 1, testRevisionProfileName).Return(testCase.revisions, nil)
+			dbClientMock.On("DeviceProfileByName", testRevisionProfileName).Return(profile, nil)
+			var recorded pkgModels.DeviceProfileRevision
+			dbClientMock.On("AddDeviceProfileRevision", mock.Anything).Run(func(args mock.Arguments) {
+				recorded = args.Get(0).(pkgModels.DeviceProfileRevision)
+			}).Return(func(r pkgModels.DeviceProfileRevision) pkgModels.DeviceProfileRevision {
+				r.Revision = 1
+				return r
+			}, nil)
+			dic := di.NewContainer(di.ServiceConstructorMap{
+				container.DBClientInterfaceName: func(get di.Get) interface{} {
+					return dbClientMock
+				},
+				bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+					return logger.NewMockClient()
+				},
+			})
+
+			recordDeviceProfileBaselineRevision(testRevisionProfileName, context.Background(), dic)
+
+			if !testCase.expectedBaseline {
+				dbClientMock.AssertNotCalled(t, "AddDeviceProfileRevision", mock.Anything)
+				return
+			}
+			assert.Equal(t, pkgModels.DeviceProfileRevisionActionBaseline, recorded.Action)
+			assert.Equal(t, profile.Description, recorded.Profile.Description)
+			assert.Empty(t, recorded.Changes)
+		})
+	}
+}
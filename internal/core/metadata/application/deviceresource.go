@@ -10,7 +10,9 @@ import (
 	"fmt"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
@@ -73,13 +75,13 @@ func AddDeviceProfileResource(profileName string, resource models.DeviceResource
 		return errors.NewCommonEdgeXWrapper(validateErr)
 	}
 
-	err = dbClient.UpdateDeviceProfile(profile)
+	revision, err := updateDeviceProfileWithRevision(profile, pkgModels.DeviceProfileRevisionActionUpdate, 0, ctx, dic)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
 	lc.Debugf("DeviceProfile deviceResources added on DB successfully. Correlation-id: %s ", correlation.FromContext(ctx))
-	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
+	go publishUpdateDeviceProfileSystemEvent(profileDTO, revision, ctx, dic)
 
 	return nil
 }
@@ -107,14 +109,14 @@ func PatchDeviceProfileResource(profileName string, dto dtos.UpdateDeviceResourc
 
 	requests.ReplaceDeviceResourceModelFieldsWithDTO(&profile.DeviceResources[index], dto)
 
-	err = dbClient.UpdateDeviceProfile(profile)
+	revision, err := updateDeviceProfileWithRevision(profile, pkgModels.DeviceProfileRevisionActionUpdate, 0, ctx, dic)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
 	lc.Debugf("DeviceProfile deviceResources patched on DB successfully. Correlation-id: %s ", correlation.FromContext(ctx))
 	profileDTO := dtos.FromDeviceProfileModelToDTO(profile)
-	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
+	go publishUpdateDeviceProfileSystemEvent(profileDTO, revision, ctx, dic)
 
 	return nil
 }
@@ -164,12 +166,12 @@ func DeleteDeviceResourceByName(profileName string, resourceName string, ctx con
 		return errors.NewCommonEdgeXWrapper(e)
 	}
 
-	err = dbClient.UpdateDeviceProfile(profile)
+	revision, err := updateDeviceProfileWithRevision(profile, pkgModels.DeviceProfileRevisionActionUpdate, 0, ctx, dic)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
-	go publishUpdateDeviceProfileSystemEvent(profileDTO, ctx, dic)
+	go publishUpdateDeviceProfileSystemEvent(profileDTO, revision, ctx, dic)
 	return nil
 }
 
@@ -181,5 +183,27 @@ func deviceResourceUoMValidation(r models.DeviceResource, dic *di.Container) err
 		}
 	}
 
+	return deviceResourceCanonicalUnitValidation(r, dic)
+}
+
+// deviceResourceCanonicalUnitValidation validates that the canonical unit declared by the device resource, if any, is
+// a known unit to which the units of the device resource can be converted
+func deviceResourceCanonicalUnitValidation(r models.DeviceResource, dic *di.Container) errors.EdgeX {
+	value, declared := r.Properties.Optional[pkgCommon.CanonicalUnit]
+	if !declared {
+		return nil
+	}
+	canonicalUnit, ok := value.(string)
+	if !ok || canonicalUnit == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s canonical unit must be a non-empty string", r.Name), nil)
+	}
+	uom := container.UnitsOfMeasureFrom(dic.Get)
+	canonicalDimension, ok := uom.Dimension(canonicalUnit)
+	if !ok {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s canonical unit %s is unknown", r.Name, canonicalUnit), nil)
+	}
+	if dimension, ok := uom.Dimension(r.Properties.Units); !ok || dimension != canonicalDimension {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("DeviceResource %s units %s can't be converted to canonical unit %s", r.Name, r.Properties.Units, canonicalUnit), nil)
+	}
 	return nil
 }
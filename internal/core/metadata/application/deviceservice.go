@@ -13,6 +13,8 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/labelselector"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
@@ -137,3 +139,38 @@ func AllDeviceServices(offset int, limit int, labels []string, ctx context.Conte
 	}
 	return deviceServices, totalCount, nil
 }
+
+// DeviceServicesByLabelSelector query the device services whose labels satisfy the label selector with offset and limit
+func DeviceServicesByLabelSelector(offset int, limit int, selector labelselector.Selector, dic *di.Container) (deviceServices []dtos.DeviceService, totalCount uint32, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	serviceModels, totalCount, err := dbClient.DeviceServicesByLabelSelector(offset, limit, selector)
+	if err != nil {
+		return deviceServices, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	deviceServices = make([]dtos.DeviceService, len(serviceModels))
+	for i, m := range serviceModels {
+		deviceServices[i] = dtos.FromDeviceServiceModelToDTO(m)
+	}
+	return deviceServices, totalCount, nil
+}
+
+// DeviceServicesByCursor query, in the order of AllDeviceServices, at most limit device services positioned after the cursor.  The returned
+// cursor is the zero cursor once the last page is queried.  Paging by cursor doesn't support filtering by labels.
+func DeviceServicesByCursor(cursor pkgModels.Cursor, limit int, labels []string, dic *di.Container) (deviceServices []dtos.DeviceService, totalCount uint32, next pkgModels.Cursor, err errors.EdgeX) {
+	if len(labels) > 0 {
+		return deviceServices, totalCount, next, errors.NewCommonEdgeX(errors.KindContractInvalid, "labels are not supported when paging by cursor", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	services, next, err := dbClient.DeviceServicesBeforeCursor(cursor, limit)
+	if err == nil {
+		totalCount, err = dbClient.DeviceServiceCountByLabels(nil)
+	}
+	if err != nil {
+		return deviceServices, totalCount, next, errors.NewCommonEdgeXWrapper(err)
+	}
+	deviceServices = make([]dtos.DeviceService, len(services))
+	for i, s := range services {
+		deviceServices[i] = dtos.FromDeviceServiceModelToDTO(s)
+	}
+	return deviceServices, totalCount, next, nil
+}
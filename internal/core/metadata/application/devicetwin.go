@@ -0,0 +1,303 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// deviceTwinMutex serializes the read-modify-write updates of the device twins, which are updated both through the
+// REST API and from the events of the devices
+var deviceTwinMutex sync.Mutex
+
+// AddDeviceTwin adds the twin of the device with the desired values of its device resources, by device resource name
+func AddDeviceTwin(deviceName string, desired map[string]string, ctx context.Context, dic *di.Container) (id string, edgeXerr errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	_, edgeXerr = validateDesiredResources(deviceName, desired, dic)
+	if edgeXerr != nil {
+		return "", edgeXerr
+	}
+
+	now := pkgCommon.MakeTimestamp()
+	twin := pkgModels.DeviceTwin{DeviceName: deviceName, Properties: make(map[string]pkgModels.TwinProperty, len(desired))}
+	for name, value := range desired {
+		twin.Properties[name] = pkgModels.TwinProperty{Desired: value, DesiredModified: now}
+	}
+	addedTwin, edgeXerr := dbClient.AddDeviceTwin(twin)
+	if edgeXerr != nil {
+		return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	lc.Debugf("DeviceTwin created on DB successfully. DeviceTwin ID: %s, Correlation-ID: %s ",
+		addedTwin.Id,
+		correlation.FromContext(ctx))
+
+	publishDeviceTwinDivergeSystemEvent(pkgModels.DeviceTwin{}, addedTwin, ctx, dic)
+	return addedTwin.Id, nil
+}
+
+// PatchDeviceTwinDesired sets the desired values of the device resources of the twin, a nil value unsetting the desired
+// value of the device resource.  The desired values of the other device resources are kept.
+func PatchDeviceTwinDesired(deviceName string, desired map[string]*string, ctx context.Context, dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	setValues := make(map[string]string, len(desired))
+	for name, value := range desired {
+		if value != nil {
+			setValues[name] = *value
+		}
+	}
+	_, edgeXerr := validateDesiredResources(deviceName, setValues, dic)
+	if edgeXerr != nil {
+		return edgeXerr
+	}
+
+	deviceTwinMutex.Lock()
+	defer deviceTwinMutex.Unlock()
+
+	oldTwin, edgeXerr := dbClient.DeviceTwinByDeviceName(deviceName)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	twin := copyDeviceTwin(oldTwin)
+	now := pkgCommon.MakeTimestamp()
+	for name, value := range desired {
+		p := twin.Properties[name]
+		if value == nil {
+			p.Desired = ""
+			p.DesiredModified = 0
+		} else {
+			p.Desired = *value
+			p.DesiredModified = now
+		}
+		if p.DesiredModified == 0 && p.ReportedOrigin == 0 {
+			delete(twin.Properties, name)
+			continue
+		}
+		twin.Properties[name] = p
+	}
+	if edgeXerr = dbClient.UpdateDeviceTwin(twin); edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	lc.Debugf("DeviceTwin of device %s patched on DB successfully. Correlation-ID: %s ", deviceName, correlation.FromContext(ctx))
+
+	publishDeviceTwinDivergeSystemEvent(oldTwin, twin, ctx, dic)
+	return nil
+}
+
+// validateDesiredResources validates that the device exists and that the desired values are set for writable
+// resources of its profile, and returns the device
+func validateDesiredResources(deviceName string, desired map[string]string, dic *di.Container) (models.Device, errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	device, edgeXerr := dbClient.DeviceByName(deviceName)
+	if edgeXerr != nil {
+		return device, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if len(desired) == 0 {
+		return device, nil
+	}
+	profile, edgeXerr := dbClient.DeviceProfileByName(device.ProfileName)
+	if edgeXerr != nil {
+		return device, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	for name := range desired {
+		resource, ok := deviceResourceByName(profile.DeviceResources, name)
+		if !ok {
+			return device, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device resource %s doesn't exist in device profile %s", name, profile.Name), nil)
+		}
+		if !strings.Contains(resource.Properties.ReadWrite, common.ReadWrite_W) {
+			return device, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device resource %s of device profile %s is read-only", name, profile.Name), nil)
+		}
+	}
+	return device, nil
+}
+
+func deviceResourceByName(resources []models.DeviceResource, name string) (models.DeviceResource, bool) {
+	for _, r := range resources {
+		if r.Name == name {
+			return r, true
+		}
+	}
+	return models.DeviceResource{}, false
+}
+
+// DeviceTwinByDeviceName query the device twin by device name
+func DeviceTwinByDeviceName(deviceName string, dic *di.Container) (twin pkgDtos.DeviceTwin, edgeXerr errors.EdgeX) {
+	if deviceName == "" {
+		return twin, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	t, edgeXerr := dbClient.DeviceTwinByDeviceName(deviceName)
+	if edgeXerr != nil {
+		return twin, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return pkgDtos.FromDeviceTwinModelToDTO(t), nil
+}
+
+// AllDeviceTwins query the device twins with offset and limit
+func AllDeviceTwins(offset int, limit int, dic *di.Container) (twins []pkgDtos.DeviceTwin, totalCount uint32, edgeXerr errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	twinModels, edgeXerr := dbClient.AllDeviceTwins(offset, limit)
+	if edgeXerr == nil {
+		totalCount, edgeXerr = dbClient.DeviceTwinTotalCount()
+	}
+	if edgeXerr != nil {
+		return twins, totalCount, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	twins = make([]pkgDtos.DeviceTwin, len(twinModels))
+	for i, t := range twinModels {
+		twins[i] = pkgDtos.FromDeviceTwinModelToDTO(t)
+	}
+	return twins, totalCount, nil
+}
+
+// DeviceTwinDeltaByDeviceName returns the properties of the device twin whose desired value has not been reported
+func DeviceTwinDeltaByDeviceName(deviceName string, dic *di.Container) (delta pkgDtos.DeviceTwinDelta, edgeXerr errors.EdgeX) {
+	if deviceName == "" {
+		return delta, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	device, edgeXerr := dbClient.DeviceByName(deviceName)
+	if edgeXerr != nil {
+		return delta, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	twin, edgeXerr := dbClient.DeviceTwinByDeviceName(deviceName)
+	if edgeXerr != nil {
+		return delta, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return pkgDtos.FromDeviceTwinModelToDeltaDTO(twin, device.ProfileName), nil
+}
+
+// DeleteDeviceTwinByDeviceName deletes the device twin by device name
+func DeleteDeviceTwinByDeviceName(deviceName string, ctx context.Context, dic *di.Container) errors.EdgeX {
+	if deviceName == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	deviceTwinMutex.Lock()
+	defer deviceTwinMutex.Unlock()
+
+	dbClient := container.DBClientFrom(dic.Get)
+	edgeXerr := dbClient.DeleteDeviceTwinByDeviceName(deviceName)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	bootstrapContainer.LoggingClientFrom(dic.Get).Debugf("DeviceTwin of device %s deleted on DB successfully. Correlation-ID: %s", deviceName, correlation.FromContext(ctx))
+	return nil
+}
+
+// UpdateDeviceTwinReported keeps the values of the readings of the event as the reported values of the twin of the
+// event's device, if the device has a twin.  A value is only kept when it was read after the reported value.  The
+// binary readings are left out, and the object values are kept JSON encoded.
+func UpdateDeviceTwinReported(event dtos.Event, ctx context.Context, dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	exists, edgeXerr := dbClient.DeviceTwinExists(event.DeviceName)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if !exists {
+		return nil
+	}
+
+	deviceTwinMutex.Lock()
+	defer deviceTwinMutex.Unlock()
+
+	oldTwin, edgeXerr := dbClient.DeviceTwinByDeviceName(event.DeviceName)
+	if edgeXerr != nil {
+		if errors.Kind(edgeXerr) == errors.KindEntityDoesNotExist {
+			return nil
+		}
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	twin := copyDeviceTwin(oldTwin)
+	updated := false
+	for _, r := range event.Readings {
+		var value string
+		switch r.ValueType {
+		case common.ValueTypeBinary:
+			continue
+		case common.ValueTypeObject:
+			b, err := json.Marshal(r.ObjectValue)
+			if err != nil {
+				continue
+			}
+			value = string(b)
+		default:
+			value = r.Value
+		}
+		p := twin.Properties[r.ResourceName]
+		origin := r.Origin / 1e6 // the readings originate in nanoseconds
+		if origin < p.ReportedOrigin {
+			continue
+		}
+		p.Reported = value
+		p.ReportedOrigin = origin
+		twin.Properties[r.ResourceName] = p
+		updated = true
+	}
+	if !updated {
+		return nil
+	}
+	if edgeXerr = dbClient.UpdateDeviceTwin(twin); edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	publishDeviceTwinDivergeSystemEvent(oldTwin, twin, ctx, dic)
+	return nil
+}
+
+// copyDeviceTwin returns a copy of the twin which can be modified without modifying the twin
+func copyDeviceTwin(twin pkgModels.DeviceTwin) pkgModels.DeviceTwin {
+	properties := make(map[string]pkgModels.TwinProperty, len(twin.Properties))
+	for name, p := range twin.Properties {
+		properties[name] = p
+	}
+	twin.Properties = properties
+	return twin
+}
+
+// publishDeviceTwinDivergeSystemEvent publishes the system event reporting the delta of the twin when a property of the
+// twin diverged with the update of the twin, i.e. a property whose desired value was reported before the update, or
+// whose desired value was set by the update, isn't reported.  The owner of the system event is the device service of
+// the device.
+func publishDeviceTwinDivergeSystemEvent(oldTwin pkgModels.DeviceTwin, twin pkgModels.DeviceTwin, ctx context.Context, dic *di.Container) {
+	diverged := false
+	for _, name := range twin.DivergedProperties() {
+		old, ok := oldTwin.Properties[name]
+		if !ok || !old.IsDiverged() || old.DesiredModified != twin.Properties[name].DesiredModified {
+			diverged = true
+			break
+		}
+	}
+	if !diverged {
+		return
+	}
+
+	device, edgeXerr := container.DBClientFrom(dic.Get).DeviceByName(twin.DeviceName)
+	if edgeXerr != nil {
+		bootstrapContainer.LoggingClientFrom(dic.Get).Errorf("fail to query device %s of the diverged device twin, err: %v", twin.DeviceName, edgeXerr)
+		return
+	}
+	delta := pkgDtos.FromDeviceTwinModelToDeltaDTO(twin, device.ProfileName)
+	go publishSystemEvent(pkgCommon.DeviceTwinSystemEventType, pkgCommon.SystemEventActionDiverge, device.ServiceName, delta, ctx, dic)
+}
@@ -0,0 +1,171 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+const (
+	testTwinDeviceName = "twin-device"
+	testTwinResource   = "temperature"
+)
+
+func newDeviceTwinTestContainer(dbClient *dbMock.DBClient) *di.Container {
+	return di.NewContainer(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{}
+		},
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClient
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+	})
+}
+
+func testTwinReading(resourceName string, originMillis int64, valueType string, value string) dtos.BaseReading {
+	return dtos.BaseReading{
+		DeviceName:    testTwinDeviceName,
+		ResourceName:  resourceName,
+		Origin:        originMillis * 1e6,
+		ValueType:     valueType,
+		SimpleReading: dtos.SimpleReading{Value: value},
+	}
+}
+
+func TestUpdateDeviceTwinReported(t *testing.T) {
+	twin := pkgModels.DeviceTwin{
+		Id:         "twin-id",
+		DeviceName: testTwinDeviceName,
+		Properties: map[string]pkgModels.TwinProperty{
+			testTwinResource: {Reported: "20", ReportedOrigin: 1000},
+		},
+	}
+	objectReading := testTwinReading("position", 2000, common.ValueTypeObject, "")
+	objectReading.ObjectValue = map[string]any{"x": 1}
+	binaryReading := testTwinReading("image", 2000, common.ValueTypeBinary, "")
+	binaryReading.BinaryValue = []byte{1, 2}
+
+	tests := []struct {
+		name             string
+		twinExists       bool
+		readings         []dtos.BaseReading
+		expectedProperty map[string]pkgModels.TwinProperty
+	}{
+		{"newer reading reported", true,
+			[]dtos.BaseReading{testTwinReading(testTwinResource, 2000, common.ValueTypeFloat32, "21")},
+			map[string]pkgModels.TwinProperty{testTwinResource: {Reported: "21", ReportedOrigin: 2000}}},
+		{"older reading not reported", true,
+			[]dtos.BaseReading{testTwinReading(testTwinResource, 500, common.ValueTypeFloat32, "19")},
+			nil},
+		{"latest of the readings reported", true,
+			[]dtos.BaseReading{
+				testTwinReading(testTwinResource, 3000, common.ValueTypeFloat32, "23"),
+				testTwinReading(testTwinResource, 2000, common.ValueTypeFloat32, "22"),
+			},
+			map[string]pkgModels.TwinProperty{testTwinResource: {Reported: "23", ReportedOrigin: 3000}}},
+		{"binary reading not reported", true,
+			[]dtos.BaseReading{binaryReading},
+			nil},
+		{"object reading reported as JSON", true,
+			[]dtos.BaseReading{objectReading},
+			map[string]pkgModels.TwinProperty{"position": {Reported: `{"x":1}`, ReportedOrigin: 2000}}},
+		{"device without twin", false,
+			[]dtos.BaseReading{testTwinReading(testTwinResource, 2000, common.ValueTypeFloat32, "21")},
+			nil},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("DeviceTwinExists", testTwinDeviceName).Return(testCase.twinExists, nil)
+			dbClientMock.On("DeviceTwinByDeviceName", testTwinDeviceName).Return(copyDeviceTwin(twin), nil)
+			var updated pkgModels.DeviceTwin
+			dbClientMock.On("UpdateDeviceTwin", mock.Anything).Run(func(args mock.Arguments) {
+				updated = args.Get(0).(pkgModels.DeviceTwin)
+			}).Return(nil)
+			dic := newDeviceTwinTestContainer(dbClientMock)
+
+			event := dtos.Event{DeviceName: testTwinDeviceName, Readings: testCase.readings}
+			err := UpdateDeviceTwinReported(event, context.Background(), dic)
+			require.NoError(t, err)
+
+			if testCase.expectedProperty == nil {
+				dbClientMock.AssertNotCalled(t, "UpdateDeviceTwin", mock.Anything)
+				return
+			}
+			for name, expected := range testCase.expectedProperty {
+				assert.Equal(t, expected, updated.Properties[name])
+			}
+			// the reported values of the other device resources are kept
+			if _, ok := testCase.expectedProperty[testTwinResource]; !ok {
+				assert.Equal(t, twin.Properties[testTwinResource], updated.Properties[testTwinResource])
+			}
+		})
+	}
+}
+
+func TestPublishDeviceTwinDivergeSystemEvent(t *testing.T) {
+	desired := pkgModels.TwinProperty{Desired: "28.5", DesiredModified: 1000}
+	converged := desired
+	converged.Reported, converged.ReportedOrigin = "28.50", 2000
+	diverged := desired
+	diverged.Reported, diverged.ReportedOrigin = "27", 2000
+	divergedLater := diverged
+	divergedLater.Reported, divergedLater.ReportedOrigin = "26", 3000
+	desiredChanged := divergedLater
+	desiredChanged.Desired, desiredChanged.DesiredModified = "30", 4000
+
+	tests := []struct {
+		name             string
+		oldProperty      *pkgModels.TwinProperty
+		property         pkgModels.TwinProperty
+		expectedDiverged bool
+	}{
+		{"desired value set", nil, desired, true},
+		{"desired value reported", &desired, converged, false},
+		{"reported value diverged", &converged, diverged, true},
+		{"still diverged", &diverged, divergedLater, false},
+		{"desired value changed while diverged", &divergedLater, desiredChanged, true},
+		{"no desired value", nil, pkgModels.TwinProperty{Reported: "27", ReportedOrigin: 2000}, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("DeviceByName", testTwinDeviceName).Return(models.Device{Name: testTwinDeviceName}, nil)
+			dic := newDeviceTwinTestContainer(dbClientMock)
+
+			oldTwin := pkgModels.DeviceTwin{DeviceName: testTwinDeviceName, Properties: map[string]pkgModels.TwinProperty{}}
+			if testCase.oldProperty != nil {
+				oldTwin.Properties[testTwinResource] = *testCase.oldProperty
+			}
+			twin := pkgModels.DeviceTwin{DeviceName: testTwinDeviceName, Properties: map[string]pkgModels.TwinProperty{testTwinResource: testCase.property}}
+			publishDeviceTwinDivergeSystemEvent(oldTwin, twin, context.Background(), dic)
+
+			// the device is only queried to publish the system event
+			if testCase.expectedDiverged {
+				dbClientMock.AssertCalled(t, "DeviceByName", testTwinDeviceName)
+			} else {
+				dbClientMock.AssertNotCalled(t, "DeviceByName", testTwinDeviceName)
+			}
+		})
+	}
+}
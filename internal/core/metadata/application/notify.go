@@ -9,6 +9,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"time"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
@@ -20,6 +21,9 @@ import (
 	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 )
 
 // validateDeviceCallback invoke device service's validation function for validating new or updated device
@@ -63,7 +67,7 @@ func validateDeviceCallback(device dtos.Device, dic *di.Container) errors.EdgeX
 	return nil
 }
 
-func publishUpdateDeviceProfileSystemEvent(profileDTO dtos.DeviceProfile, ctx context.Context, dic *di.Container) {
+func publishUpdateDeviceProfileSystemEvent(profileDTO dtos.DeviceProfile, revision pkgModels.DeviceProfileRevision, ctx context.Context, dic *di.Container) {
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 	devices, _, err := DevicesByProfileName(0, -1, profileDTO.Name, dic)
 	if err != nil {
@@ -71,8 +75,17 @@ func publishUpdateDeviceProfileSystemEvent(profileDTO dtos.DeviceProfile, ctx co
 		return
 	}
 
+	// Tag the system events with the revision recorded for the update, if any
+	var tags map[string]string
+	if revision.Id != "" {
+		tags = map[string]string{
+			pkgCommon.SystemEventTagRevisionId: revision.Id,
+			pkgCommon.SystemEventTagRevision:   strconv.FormatInt(revision.Revision, 10),
+		}
+	}
+
 	//Publish general system event regardless of associated devices
-	publishSystemEvent(common.DeviceProfileSystemEventType, common.SystemEventActionUpdate, common.CoreMetaDataServiceKey, profileDTO, ctx, dic)
+	publishSystemEventWithTags(common.DeviceProfileSystemEventType, common.SystemEventActionUpdate, common.CoreMetaDataServiceKey, profileDTO, tags, ctx, dic)
 	// Publish system event for each device service
 	dsMap := make(map[string]bool)
 	for _, d := range devices {
@@ -82,14 +95,18 @@ func publishUpdateDeviceProfileSystemEvent(profileDTO dtos.DeviceProfile, ctx co
 		}
 		dsMap[d.ServiceName] = true
 
-		publishSystemEvent(common.DeviceProfileSystemEventType, common.SystemEventActionUpdate, d.ServiceName, profileDTO, ctx, dic)
+		publishSystemEventWithTags(common.DeviceProfileSystemEventType, common.SystemEventActionUpdate, d.ServiceName, profileDTO, tags, ctx, dic)
 	}
 }
 
 func publishSystemEvent(eventType, action, owner string, dto any, ctx context.Context, dic *di.Container) {
+	publishSystemEventWithTags(eventType, action, owner, dto, nil, ctx, dic)
+}
+
+func publishSystemEventWithTags(eventType, action, owner string, dto any, tags map[string]string, ctx context.Context, dic *di.Container) {
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 	config := container.ConfigurationFrom(dic.Get)
-	systemEvent := dtos.NewSystemEvent(eventType, action, common.CoreMetaDataServiceKey, owner, nil, dto)
+	systemEvent := dtos.NewSystemEvent(eventType, action, common.CoreMetaDataServiceKey, owner, tags, dto)
 	messagingClient := bootstrapContainer.MessagingClientFrom(dic.Get)
 	if messagingClient == nil {
 		lc.Errorf("unable to publish '%s' System Event: %v", eventType, noMessagingClientError)
@@ -128,6 +145,14 @@ func publishSystemEvent(eventType, action, owner string, dto any, ctx context.Co
 			lc.Errorf("can not convert to device service DTO")
 			return
 		}
+	case pkgCommon.DeviceTwinSystemEventType:
+		if delta, ok := dto.(pkgDtos.DeviceTwinDelta); ok {
+			profileName = delta.ProfileName
+			detailName = delta.DeviceName
+		} else {
+			lc.Errorf("can not convert to device twin delta DTO")
+			return
+		}
 	default:
 		lc.Errorf("unrecognized system event details")
 		return
@@ -12,6 +12,7 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/labelselector"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
@@ -117,6 +118,20 @@ func AllProvisionWatchers(offset int, limit int, labels []string, dic *di.Contai
 	return provisionWatchers, totalCount, nil
 }
 
+// ProvisionWatchersByLabelSelector query the provision watchers whose labels satisfy the label selector with offset and limit
+func ProvisionWatchersByLabelSelector(offset int, limit int, selector labelselector.Selector, dic *di.Container) (provisionWatchers []dtos.ProvisionWatcher, totalCount uint32, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	pwModels, totalCount, err := dbClient.ProvisionWatchersByLabelSelector(offset, limit, selector)
+	if err != nil {
+		return provisionWatchers, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	provisionWatchers = make([]dtos.ProvisionWatcher, len(pwModels))
+	for i, m := range pwModels {
+		provisionWatchers[i] = dtos.FromProvisionWatcherModelToDTO(m)
+	}
+	return provisionWatchers, totalCount, nil
+}
+
 // DeleteProvisionWatcherByName deletes the provision watcher by name
 func DeleteProvisionWatcherByName(ctx context.Context, name string, dic *di.Container) errors.EdgeX {
 	if name == "" {
@@ -0,0 +1,46 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+// ConvertUnit converts the value from a unit to another unit of the same dimension
+func ConvertUnit(value float64, from string, to string, dic *di.Container) (float64, errors.EdgeX) {
+	if from == "" || to == "" {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, "the units to convert from and to are required", nil)
+	}
+	result, err := container.UnitsOfMeasureFrom(dic.Get).Convert(value, from, to)
+	if err != nil {
+		return 0, errors.NewCommonEdgeXWrapper(err)
+	}
+	return result, nil
+}
+
+// ConvertToCanonicalUnit converts the value of the device resource from the units to the canonical unit of the device
+// resource
+func ConvertToCanonicalUnit(value float64, profileName string, resourceName string, dic *di.Container) (result float64, from string, to string, err errors.EdgeX) {
+	resource, err := DeviceResourceByProfileNameAndResourceName(profileName, resourceName, dic)
+	if err != nil {
+		return 0, "", "", errors.NewCommonEdgeXWrapper(err)
+	}
+	from = resource.Properties.Units
+	to, ok := resource.Properties.Optional[pkgCommon.CanonicalUnit].(string)
+	if !ok || to == "" {
+		return 0, "", "", errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("DeviceResource %s of profile %s doesn't declare a canonical unit", resourceName, profileName), nil)
+	}
+	result, err = ConvertUnit(value, from, to, dic)
+	if err != nil {
+		return 0, "", "", errors.NewCommonEdgeXWrapper(err)
+	}
+	return result, from, to, nil
+}
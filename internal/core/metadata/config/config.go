@@ -27,6 +27,7 @@ type ConfigurationStruct struct {
 	Service    bootstrapConfig.ServiceInfo
 	MessageBus bootstrapConfig.MessageBusInfo
 	UoM        UoM
+	DeviceTwin DeviceTwinInfo
 }
 
 type WritableInfo struct {
@@ -50,6 +51,12 @@ type UoM struct {
 	UoMFile string
 }
 
+// DeviceTwinInfo configures the device twins, whose reported values are kept from the events published by the device
+// services when Enabled
+type DeviceTwinInfo struct {
+	Enabled bool
+}
+
 // UpdateFromRaw converts configuration received from the registry to a service-specific configuration struct which is
 // then used to overwrite the service's existing configuration struct.
 func (c *ConfigurationStruct) UpdateFromRaw(rawConfig interface{}) bool {
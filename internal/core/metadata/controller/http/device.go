@@ -14,6 +14,7 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
@@ -211,6 +212,32 @@ func (dc *DeviceController) AllDevices(c echo.Context) error {
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
+	selector, selected, err := utils.ParseLabelSelectorQueryString(c, labels)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if selected {
+		devices, totalCount, err := application.DevicesByLabelSelector(offset, limit, selector, dc.dic)
+		if err != nil {
+			return utils.WriteErrorResponse(w, ctx, lc, err, "")
+		}
+		response := responseDTO.NewMultiDevicesResponse("", "", http.StatusOK, totalCount, devices)
+		utils.WriteHttpHeader(w, ctx, http.StatusOK)
+		return pkg.EncodeAndWriteResponse(response, w, lc)
+	}
+	cursor, pagedByCursor, err := utils.ParseCursorQueryString(c, offset)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if pagedByCursor {
+		devices, totalCount, next, err := application.DevicesByCursor(cursor, limit, labels, dc.dic)
+		if err != nil {
+			return utils.WriteErrorResponse(w, ctx, lc, err, "")
+		}
+		response := pkgResponses.NewMultiDevicesWithCursorResponse("", "", http.StatusOK, totalCount, devices, next.Token())
+		utils.WriteHttpHeader(w, ctx, http.StatusOK)
+		return pkg.EncodeAndWriteResponse(response, w, lc)
+	}
 	devices, totalCount, err := application.AllDevices(offset, limit, labels, dc.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
@@ -21,6 +21,10 @@ import (
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/labelselector"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
@@ -290,6 +294,7 @@ func TestDeleteDeviceByName(t *testing.T) {
 	dic := mockDic()
 	dbClientMock := &dbMock.DBClient{}
 	dbClientMock.On("DeleteDeviceByName", device.Name).Return(nil)
+	dbClientMock.On("DeleteDeviceTwinByDeviceName", device.Name).Return(edgexErr.NewCommonEdgeX(edgexErr.KindEntityDoesNotExist, "device twin doesn't exist in the database", nil))
 	dbClientMock.On("DeleteDeviceByName", notFoundName).Return(edgexErr.NewCommonEdgeX(edgexErr.KindEntityDoesNotExist, "device doesn't exist in the database", nil))
 	dbClientMock.On("DeviceByName", notFoundName).Return(device, edgexErr.NewCommonEdgeX(edgexErr.KindEntityDoesNotExist, "device doesn't exist in the database", nil))
 	dbClientMock.On("DeviceByName", device.Name).Return(device, nil)
@@ -766,6 +771,71 @@ func TestAllDevices(t *testing.T) {
 	}
 }
 
+func TestAllDevicesByCursor(t *testing.T) {
+	device := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
+	devices := []models.Device{device, device}
+	expectedDeviceTotalCount := uint32(3)
+	next := pkgModels.Cursor{Id: device.Id}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DeviceCountByLabels", []string(nil)).Return(expectedDeviceTotalCount, nil)
+	dbClientMock.On("DevicesBeforeCursor", pkgModels.Cursor{}, 2).Return(devices, next, nil)
+	dbClientMock.On("DevicesBeforeCursor", next, 2).Return(devices[:1], pkgModels.Cursor{}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		cursor             string
+		labels             string
+		expectedCount      int
+		expectedNextCursor string
+		expectedStatusCode int
+	}{
+		{"Valid - get the first page", "", "", 2, next.Token(), http.StatusOK},
+		{"Valid - get the last page", next.Token(), "", 1, "", http.StatusOK},
+		{"Invalid - cursor along with labels", next.Token(), strings.Join(testDeviceLabels, ","), 0, "", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, common.ApiAllDeviceRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(common.Limit, "2")
+			query.Add(pkgCommon.Cursor, testCase.cursor)
+			if len(testCase.labels) > 0 {
+				query.Add(common.Labels, testCase.labels)
+			}
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = controller.AllDevices(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				return
+			}
+			var res pkgResponses.MultiDevicesWithCursorResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedCount, len(res.Devices), "Device count not as expected")
+			assert.Equal(t, expectedDeviceTotalCount, res.TotalCount, "Total count not as expected")
+			assert.Equal(t, testCase.expectedNextCursor, res.NextCursor, "Next cursor not as expected")
+		})
+	}
+}
+
 func TestDeviceByName(t *testing.T) {
 	device := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
 	emptyName := ""
@@ -917,3 +987,73 @@ func TestDevicesByProfileName(t *testing.T) {
 		})
 	}
 }
+
+func TestAllDevicesByLabelSelector(t *testing.T) {
+	device := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
+	devices := []models.Device{device, device}
+	expectedDeviceTotalCount := uint32(3)
+	selector, edgeXerr := labelselector.Parse("env=prod,line in (a,b)")
+	require.NoError(t, edgeXerr)
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("DevicesByLabelSelector", 0, 2, selector).Return(devices, expectedDeviceTotalCount, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewDeviceController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		selector           string
+		labels             string
+		cursor             bool
+		expectedStatusCode int
+	}{
+		{"Valid - get devices by label selector", "env=prod,line in (a,b)", "", false, http.StatusOK},
+		{"Invalid - invalid label selector", "line in a", "", false, http.StatusBadRequest},
+		{"Invalid - label selector with labels", "env=prod", strings.Join(testDeviceLabels, ","), false, http.StatusBadRequest},
+		{"Invalid - label selector with cursor", "env=prod", "", true, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, common.ApiAllDeviceRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(common.Limit, "2")
+			query.Add(pkgCommon.LabelSelector, testCase.selector)
+			if len(testCase.labels) > 0 {
+				query.Add(common.Labels, testCase.labels)
+			}
+			if testCase.cursor {
+				query.Add(pkgCommon.Cursor, "")
+			}
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = controller.AllDevices(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				return
+			}
+			var res responseDTO.MultiDevicesResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, len(devices), len(res.Devices), "Device count not as expected")
+			assert.Equal(t, expectedDeviceTotalCount, res.TotalCount, "Total count not as expected")
+		})
+	}
+}
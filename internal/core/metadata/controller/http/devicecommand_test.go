@@ -15,6 +15,7 @@ import (
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
@@ -81,7 +82,8 @@ func TestAddDeviceProfileDeviceCommand(t *testing.T) {
 	dic := mockDic()
 	dbClientMock := &dbMock.DBClient{}
 	dbClientMock.On("DeviceProfileByName", valid.ProfileName).Return(deviceProfile, nil)
-	dbClientMock.On("UpdateDeviceProfile", mock.Anything).Return(nil)
+	dbClientMock.On("UpdateDeviceProfileWithRevision", mock.Anything, mock.Anything).Return(pkgModels.DeviceProfileRevision{}, nil)
+	dbClientMock.On("DeviceProfileRevisionsByProfileName", 0, 1, mock.Anything).Return([]pkgModels.DeviceProfileRevision{{Revision: 1}}, nil)
 	dbClientMock.On("DevicesByProfileName", 0, -1, TestDeviceProfileName).Return([]models.Device{}, nil)
 	dbClientMock.On("DeviceCountByProfileName", TestDeviceProfileName).Return(uint32(1), nil)
 	dic.Update(di.ServiceConstructorMap{
@@ -203,7 +205,8 @@ func TestPatchDeviceProfileDeviceCommand(t *testing.T) {
 	dic := mockDic()
 	dbClientMock := &dbMock.DBClient{}
 	dbClientMock.On("DeviceProfileByName", valid.ProfileName).Return(deviceProfile, nil)
-	dbClientMock.On("UpdateDeviceProfile", mock.Anything).Return(nil)
+	dbClientMock.On("UpdateDeviceProfileWithRevision", mock.Anything, mock.Anything).Return(pkgModels.DeviceProfileRevision{}, nil)
+	dbClientMock.On("DeviceProfileRevisionsByProfileName", 0, 1, mock.Anything).Return([]pkgModels.DeviceProfileRevision{{Revision: 1}}, nil)
 	dbClientMock.On("DeviceProfileByName", notFound).Return(deviceProfile, notFoundDBError)
 	dbClientMock.On("DevicesByProfileName", 0, -1, TestDeviceProfileName).Return([]models.Device{}, nil)
 	dbClientMock.On("DeviceCountByProfileName", TestDeviceProfileName).Return(uint32(1), nil)
@@ -286,7 +289,8 @@ func TestDeleteDeviceCommandByName(t *testing.T) {
 	dbClientMock.On("DevicesByProfileName", 0, mock.Anything, TestDeviceProfileName).Return([]models.Device{}, nil)
 	dbClientMock.On("DeviceCountByProfileName", TestDeviceProfileName).Return(uint32(1), nil)
 	dbClientMock.On("DeviceProfileByName", TestDeviceProfileName).Return(dpModel, nil)
-	dbClientMock.On("UpdateDeviceProfile", mock.Anything).Return(nil)
+	dbClientMock.On("UpdateDeviceProfileWithRevision", mock.Anything, mock.Anything).Return(pkgModels.DeviceProfileRevision{}, nil)
+	dbClientMock.On("DeviceProfileRevisionsByProfileName", 0, 1, mock.Anything).Return([]pkgModels.DeviceProfileRevision{{Revision: 1}}, nil)
 
 	dbClientMock.On("DevicesByProfileName", 0, 1, deviceExists).Return([]models.Device{models.Device{}}, nil)
 
@@ -0,0 +1,209 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	pkgRequests "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/requests"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/labelselector"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+const testDeviceGroupName = "TestDeviceGroup"
+
+func buildTestAddDeviceGroupRequest() pkgRequests.AddDeviceGroupRequest {
+	return pkgRequests.AddDeviceGroupRequest{
+		BaseRequest: commonDTO.BaseRequest{
+			RequestId:   ExampleUUID,
+			Versionable: commonDTO.NewVersionable(),
+		},
+		DeviceGroup: pkgDtos.DeviceGroup{
+			Name:          testDeviceGroupName,
+			DeviceNames:   []string{TestDeviceName},
+			LabelSelector: "env=prod",
+		},
+	}
+}
+
+func TestAddDeviceGroup(t *testing.T) {
+	notFoundDeviceName := "notFoundDevice"
+
+	valid := buildTestAddDeviceGroupRequest()
+	deviceNotFound := buildTestAddDeviceGroupRequest()
+	deviceNotFound.DeviceGroup.DeviceNames = []string{notFoundDeviceName}
+	noMembers := buildTestAddDeviceGroupRequest()
+	noMembers.DeviceGroup.DeviceNames = nil
+	noMembers.DeviceGroup.LabelSelector = ""
+	invalidSelector := buildTestAddDeviceGroupRequest()
+	invalidSelector.DeviceGroup.LabelSelector = "line in a"
+	noName := buildTestAddDeviceGroupRequest()
+	noName.DeviceGroup.Name = ""
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceNameExists", TestDeviceName).Return(true, nil)
+	dbClientMock.On("DeviceNameExists", notFoundDeviceName).Return(false, nil)
+	dbClientMock.On("AddDeviceGroup", mock.Anything).Return(func(g pkgModels.DeviceGroup) pkgModels.DeviceGroup {
+		g.Id = ExampleUUID
+		return g
+	}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceGroupController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		request            []pkgRequests.AddDeviceGroupRequest
+		expectedStatusCode int
+		errorExpected      bool
+	}{
+		{"Valid", []pkgRequests.AddDeviceGroupRequest{valid}, http.StatusCreated, false},
+		{"Invalid - device not found", []pkgRequests.AddDeviceGroupRequest{deviceNotFound}, http.StatusBadRequest, false},
+		{"Invalid - no members", []pkgRequests.AddDeviceGroupRequest{noMembers}, http.StatusBadRequest, true},
+		{"Invalid - invalid label selector", []pkgRequests.AddDeviceGroupRequest{invalidSelector}, http.StatusBadRequest, true},
+		{"Invalid - no name", []pkgRequests.AddDeviceGroupRequest{noName}, http.StatusBadRequest, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			jsonData, err := json.Marshal(testCase.request)
+			require.NoError(t, err)
+
+			reader := strings.NewReader(string(jsonData))
+			req, err := http.NewRequest(http.MethodPost, pkgCommon.ApiDeviceGroupRoute, reader)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = controller.AddDeviceGroup(c)
+			require.NoError(t, err)
+
+			if testCase.errorExpected {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				return
+			}
+
+			var res []commonDTO.BaseWithIdResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusMultiStatus, recorder.Result().StatusCode, "HTTP status code not as expected")
+			require.Len(t, res, 1)
+			assert.Equal(t, testCase.expectedStatusCode, res[0].StatusCode, "BaseResponse status code not as expected")
+			if testCase.expectedStatusCode == http.StatusCreated {
+				assert.Equal(t, ExampleUUID, res[0].Id, "Response id not as expected")
+			} else {
+				assert.NotEmpty(t, res[0].Message, "Response message doesn't contain the error message")
+			}
+		})
+	}
+}
+
+func TestDevicesByDeviceGroupName(t *testing.T) {
+	listed := models.Device{Name: "listed"}
+	selected := models.Device{Name: "selected", Labels: []string{"env=prod"}}
+	group := pkgModels.DeviceGroup{
+		Id:            ExampleUUID,
+		Name:          testDeviceGroupName,
+		DeviceNames:   []string{listed.Name, selected.Name, "deleted"},
+		LabelSelector: "env=prod",
+	}
+	selector, edgeXerr := labelselector.Parse(group.LabelSelector)
+	require.NoError(t, edgeXerr)
+	notFoundName := "notFoundGroup"
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceGroupByName", group.Name).Return(group, nil)
+	dbClientMock.On("DeviceGroupByName", notFoundName).Return(pkgModels.DeviceGroup{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device group doesn't exist in the database", nil))
+	dbClientMock.On("DeviceByName", listed.Name).Return(listed, nil)
+	dbClientMock.On("DeviceByName", selected.Name).Return(selected, nil)
+	dbClientMock.On("DeviceByName", "deleted").Return(models.Device{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device doesn't exist in the database", nil))
+	dbClientMock.On("DevicesByLabelSelector", 0, -1, selector).Return([]models.Device{selected}, uint32(1), nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceGroupController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		groupName          string
+		offset             string
+		expectedNames      []string
+		expectedStatusCode int
+	}{
+		{"Valid - all devices", group.Name, "0", []string{listed.Name, selected.Name}, http.StatusOK},
+		{"Valid - with offset", group.Name, "1", []string{selected.Name}, http.StatusOK},
+		{"Invalid - offset out of range", group.Name, "3", nil, http.StatusRequestedRangeNotSatisfiable},
+		{"Invalid - device group not found", notFoundName, "0", nil, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiDeviceGroupDevicesByNameEchoRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(common.Offset, testCase.offset)
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(testCase.groupName)
+			err = controller.DevicesByDeviceGroupName(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				return
+			}
+			var res responseDTO.MultiDevicesResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, uint32(2), res.TotalCount, "Total count not as expected")
+			var names []string
+			for _, d := range res.Devices {
+				names = append(names, d.Name)
+			}
+			assert.Equal(t, testCase.expectedNames, names)
+			assert.IsType(t, []dtos.Device{}, res.Devices)
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
@@ -264,6 +265,32 @@ func (dc *DeviceProfileController) AllDeviceProfiles(c echo.Context) error {
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
+	selector, selected, err := utils.ParseLabelSelectorQueryString(c, labels)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if selected {
+		deviceProfiles, totalCount, err := application.DeviceProfilesByLabelSelector(offset, limit, selector, dc.dic)
+		if err != nil {
+			return utils.WriteErrorResponse(w, ctx, lc, err, "")
+		}
+		response := responseDTO.NewMultiDeviceProfilesResponse("", "", http.StatusOK, totalCount, deviceProfiles)
+		utils.WriteHttpHeader(w, ctx, http.StatusOK)
+		return pkg.EncodeAndWriteResponse(response, w, lc)
+	}
+	cursor, pagedByCursor, err := utils.ParseCursorQueryString(c, offset)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if pagedByCursor {
+		deviceProfiles, totalCount, next, err := application.DeviceProfilesByCursor(cursor, limit, labels, dc.dic)
+		if err != nil {
+			return utils.WriteErrorResponse(w, ctx, lc, err, "")
+		}
+		response := pkgResponses.NewMultiDeviceProfilesWithCursorResponse("", "", http.StatusOK, totalCount, deviceProfiles, next.Token())
+		utils.WriteHttpHeader(w, ctx, http.StatusOK)
+		return pkg.EncodeAndWriteResponse(response, w, lc)
+	}
 	deviceProfiles, totalCount, err := application.AllDeviceProfiles(offset, limit, labels, dc.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
@@ -34,6 +34,7 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
@@ -179,7 +180,8 @@ func TestAddDeviceProfile_Created(t *testing.T) {
 
 	dic := mockDic()
 	dbClientMock := &mocks.DBClient{}
-	dbClientMock.On("AddDeviceProfile", deviceProfileModel).Return(deviceProfileModel, nil)
+	dbClientMock.On("AddDeviceProfileWithRevision", deviceProfileModel, mock.Anything).Return(deviceProfileModel, nil)
+	dbClientMock.On("DeviceProfileRevisionsByProfileName", 0, 1, mock.Anything).Return([]pkgModels.DeviceProfileRevision{}, nil)
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -323,8 +325,9 @@ func TestAddDeviceProfile_Duplicated(t *testing.T) {
 
 	dic := mockDic()
 	dbClientMock := &mocks.DBClient{}
-	dbClientMock.On("AddDeviceProfile", duplicateNameModel).Return(duplicateNameModel, duplicateNameDBError)
-	dbClientMock.On("AddDeviceProfile", duplicateIdModel).Return(duplicateIdModel, duplicateIdDBError)
+	dbClientMock.On("AddDeviceProfileWithRevision", duplicateNameModel, mock.Anything).Return(duplicateNameModel, duplicateNameDBError)
+	dbClientMock.On("AddDeviceProfileWithRevision", duplicateIdModel, mock.Anything).Return(duplicateIdModel, duplicateIdDBError)
+	dbClientMock.On("DeviceProfileRevisionsByProfileName", 0, 1, mock.Anything).Return([]pkgModels.DeviceProfileRevision{}, nil)
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -394,8 +397,9 @@ func TestAddDeviceProfile_UnitsOfMeasure_Validation(t *testing.T) {
 	dic := mockDic()
 	container.ConfigurationFrom(dic.Get).Writable.UoM.Validation = true
 	dbClientMock := &mocks.DBClient{}
-	dbClientMock.On("AddDeviceProfile", deviceProfileModel).Return(deviceProfileModel, nil)
-	dbClientMock.On("AddDeviceProfile", noUnitsModel).Return(noUnitsModel, nil)
+	dbClientMock.On("AddDeviceProfileWithRevision", deviceProfileModel, mock.Anything).Return(deviceProfileModel, nil)
+	dbClientMock.On("AddDeviceProfileWithRevision", noUnitsModel, mock.Anything).Return(noUnitsModel, nil)
+	dbClientMock.On("DeviceProfileRevisionsByProfileName", 0, 1, mock.Anything).Return([]pkgModels.DeviceProfileRevision{}, nil)
 	uomMock := &mocks.UnitsOfMeasure{}
 	uomMock.On("Validate", TestUnits).Return(true)
 	uomMock.On("Validate", "").Return(true)
@@ -500,8 +504,9 @@ func TestUpdateDeviceProfile(t *testing.T) {
 
 	dic := mockDic()
 	dbClientMock := &mocks.DBClient{}
-	dbClientMock.On("UpdateDeviceProfile", deviceProfileModel).Return(nil)
-	dbClientMock.On("UpdateDeviceProfile", notFoundDeviceProfileModel).Return(notFoundDBError)
+	dbClientMock.On("UpdateDeviceProfileWithRevision", deviceProfileModel, mock.Anything).Return(pkgModels.DeviceProfileRevision{}, nil)
+	dbClientMock.On("UpdateDeviceProfileWithRevision", notFoundDeviceProfileModel, mock.Anything).Return(pkgModels.DeviceProfileRevision{}, notFoundDBError)
+	dbClientMock.On("DeviceProfileRevisionsByProfileName", 0, 1, mock.Anything).Return([]pkgModels.DeviceProfileRevision{{Revision: 1}}, nil)
 	dbClientMock.On("DeviceCountByProfileName", deviceProfileModel.Name).Return(uint32(1), nil)
 	dbClientMock.On("DevicesByProfileName", 0, -1, deviceProfileModel.Name).Return([]models.Device{{ServiceName: testDeviceServiceName}}, nil)
 	dbClientMock.On("DeviceServiceByName", testDeviceServiceName).Return(models.DeviceService{}, nil)
@@ -643,7 +648,8 @@ func TestPatchDeviceProfileBasicInfo(t *testing.T) {
 	dbClientMock.On("DeviceProfileById", *valid.BasicInfo.Id).Return(dpModel, nil)
 	dbClientMock.On("DeviceProfileByName", *valid.BasicInfo.Name).Return(dpModel, nil)
 	dbClientMock.On("DeviceProfileByName", notFoundName).Return(dpModel, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
-	dbClientMock.On("UpdateDeviceProfile", mock.Anything).Return(nil)
+	dbClientMock.On("UpdateDeviceProfileWithRevision", mock.Anything, mock.Anything).Return(pkgModels.DeviceProfileRevision{}, nil)
+	dbClientMock.On("DeviceProfileRevisionsByProfileName", 0, 1, mock.Anything).Return([]pkgModels.DeviceProfileRevision{{Revision: 1}}, nil)
 	dbClientMock.On("DeviceCountByProfileName", *valid.BasicInfo.Name).Return(uint32(1), nil)
 	dbClientMock.On("DevicesByProfileName", 0, -1, *valid.BasicInfo.Name).Return([]models.Device{{ServiceName: testDeviceServiceName}}, nil)
 	dic.Update(di.ServiceConstructorMap{
@@ -717,7 +723,8 @@ func TestAddDeviceProfileByYaml_Created(t *testing.T) {
 
 	dic := mockDic()
 	dbClientMock := &mocks.DBClient{}
-	dbClientMock.On("AddDeviceProfile", deviceProfileModel).Return(deviceProfileModel, nil)
+	dbClientMock.On("AddDeviceProfileWithRevision", deviceProfileModel, mock.Anything).Return(deviceProfileModel, nil)
+	dbClientMock.On("DeviceProfileRevisionsByProfileName", 0, 1, mock.Anything).Return([]pkgModels.DeviceProfileRevision{}, nil)
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -834,7 +841,8 @@ func TestAddDeviceProfileByYaml_Duplicated(t *testing.T) {
 
 	dic := mockDic()
 	dbClientMock := &mocks.DBClient{}
-	dbClientMock.On("AddDeviceProfile", deviceProfileModel).Return(deviceProfileModel, dbError)
+	dbClientMock.On("AddDeviceProfileWithRevision", deviceProfileModel, mock.Anything).Return(deviceProfileModel, dbError)
+	dbClientMock.On("DeviceProfileRevisionsByProfileName", 0, 1, mock.Anything).Return([]pkgModels.DeviceProfileRevision{}, nil)
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -944,8 +952,9 @@ func TestUpdateDeviceProfileByYaml(t *testing.T) {
 
 	dic := mockDic()
 	dbClientMock := &mocks.DBClient{}
-	dbClientMock.On("UpdateDeviceProfile", validDeviceProfileModel).Return(nil)
-	dbClientMock.On("UpdateDeviceProfile", notFoundDeviceProfileModel).Return(notFoundDBError)
+	dbClientMock.On("UpdateDeviceProfileWithRevision", validDeviceProfileModel, mock.Anything).Return(pkgModels.DeviceProfileRevision{}, nil)
+	dbClientMock.On("UpdateDeviceProfileWithRevision", notFoundDeviceProfileModel, mock.Anything).Return(pkgModels.DeviceProfileRevision{}, notFoundDBError)
+	dbClientMock.On("DeviceProfileRevisionsByProfileName", 0, 1, mock.Anything).Return([]pkgModels.DeviceProfileRevision{{Revision: 1}}, nil)
 	dbClientMock.On("DeviceCountByProfileName", validDeviceProfileModel.Name).Return(uint32(1), nil)
 	dbClientMock.On("DevicesByProfileName", 0, -1, validDeviceProfileModel.Name).Return([]models.Device{{ServiceName: testDeviceServiceName}}, nil)
 	dbClientMock.On("DeviceServiceByName", testDeviceServiceName).Return(models.DeviceService{}, nil)
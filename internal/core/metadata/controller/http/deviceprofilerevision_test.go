@@ -0,0 +1,280 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+func buildTestDeviceProfileRevisions() (current pkgModels.DeviceProfileRevision, previous pkgModels.DeviceProfileRevision) {
+	profile := dtos.ToDeviceProfileModel(buildTestDeviceProfileRequest().Profile)
+	profile.Id = ExampleUUID
+	previousProfile := profile
+	previousProfile.Description = "previous description"
+	previous = pkgModels.DeviceProfileRevision{
+		Id:          "8ff4ab38-15ef-4da8-9eb6-5d06a4a4d8d3",
+		ProfileName: profile.Name,
+		Revision:    1,
+		Action:      pkgModels.DeviceProfileRevisionActionAdd,
+		Profile:     previousProfile,
+	}
+	current = pkgModels.DeviceProfileRevision{
+		Id:          "0d0aa6a1-b0f6-49c9-9b16-1b3d7b4aa5bd",
+		ProfileName: profile.Name,
+		Revision:    2,
+		Author:      "admin",
+		Action:      pkgModels.DeviceProfileRevisionActionUpdate,
+		Profile:     profile,
+		Changes: []pkgModels.DeviceProfileChange{
+			{Path: "description", Previous: previousProfile.Description, Current: profile.Description},
+		},
+	}
+	return current, previous
+}
+
+func TestDeviceProfileRevisionsByName(t *testing.T) {
+	current, previous := buildTestDeviceProfileRevisions()
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileRevisionsByProfileName", 0, 20, TestDeviceProfileName).Return([]pkgModels.DeviceProfileRevision{current, previous}, nil)
+	dbClientMock.On("DeviceProfileRevisionsByProfileName", 1, 1, TestDeviceProfileName).Return([]pkgModels.DeviceProfileRevision{previous}, nil)
+	dbClientMock.On("DeviceProfileRevisionCountByProfileName", TestDeviceProfileName).Return(uint32(2), nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceProfileRevisionController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		offset             string
+		limit              string
+		expectedRevisions  []int64
+		expectedStatusCode int
+	}{
+		{"Valid - all revisions", "0", "20", []int64{2, 1}, http.StatusOK},
+		{"Valid - with offset and limit", "1", "1", []int64{1}, http.StatusOK},
+		{"Invalid - invalid offset format", "aaa", "1", nil, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiDeviceProfileRevisionsByNameEchoRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(common.Offset, testCase.offset)
+			query.Add(common.Limit, testCase.limit)
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(TestDeviceProfileName)
+			err = controller.DeviceProfileRevisionsByName(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				return
+			}
+			var res pkgResponses.MultiDeviceProfileRevisionsResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, uint32(2), res.TotalCount, "Total count not as expected")
+			var revisions []int64
+			for _, r := range res.Revisions {
+				revisions = append(revisions, r.Revision)
+			}
+			assert.Equal(t, testCase.expectedRevisions, revisions)
+		})
+	}
+}
+
+func TestDeviceProfileRevisionByNameAndNumber(t *testing.T) {
+	current, _ := buildTestDeviceProfileRevisions()
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileRevisionByNumber", TestDeviceProfileName, int64(2)).Return(current, nil)
+	dbClientMock.On("DeviceProfileRevisionByNumber", TestDeviceProfileName, int64(3)).Return(pkgModels.DeviceProfileRevision{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device profile revision doesn't exist in the database", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceProfileRevisionController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		revision           string
+		expectedStatusCode int
+	}{
+		{"Valid", "2", http.StatusOK},
+		{"Invalid - revision not found", "3", http.StatusNotFound},
+		{"Invalid - invalid revision format", "latest", http.StatusBadRequest},
+		{"Invalid - revision not positive", "0", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiDeviceProfileRevisionByNameAndNumberEchoRoute, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name, pkgCommon.Revision)
+			c.SetParamValues(TestDeviceProfileName, testCase.revision)
+			err = controller.DeviceProfileRevisionByNameAndNumber(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				return
+			}
+			var res pkgResponses.DeviceProfileRevisionResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, current.Id, res.Revision.Id)
+			assert.Equal(t, current.Author, res.Revision.Author)
+			require.Len(t, res.Revision.Changes, 1)
+			assert.Equal(t, "description", res.Revision.Changes[0].Path)
+		})
+	}
+}
+
+func TestRollbackDeviceProfile(t *testing.T) {
+	current, previous := buildTestDeviceProfileRevisions()
+	rolledBack := previous.Profile
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileRevisionByNumber", TestDeviceProfileName, int64(1)).Return(previous, nil)
+	dbClientMock.On("DeviceProfileRevisionByNumber", TestDeviceProfileName, int64(3)).Return(pkgModels.DeviceProfileRevision{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device profile revision doesn't exist in the database", nil))
+	dbClientMock.On("DeviceProfileByName", TestDeviceProfileName).Return(current.Profile, nil)
+	dbClientMock.On("DeviceProfileRevisionsByProfileName", 0, 1, TestDeviceProfileName).Return([]pkgModels.DeviceProfileRevision{current}, nil)
+	dbClientMock.On("UpdateDeviceProfileWithRevision", rolledBack, mock.Anything).Return(func(dp models.DeviceProfile, r pkgModels.DeviceProfileRevision) pkgModels.DeviceProfileRevision {
+		r.Id = ExampleUUID
+		return r
+	}, nil)
+	dbClientMock.On("DevicesByProfileName", 0, -1, TestDeviceProfileName).Return([]models.Device{}, nil)
+	dbClientMock.On("DeviceCountByProfileName", TestDeviceProfileName).Return(uint32(0), nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceProfileRevisionController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		revision           string
+		expectedStatusCode int
+	}{
+		{"Valid", "1", http.StatusOK},
+		{"Invalid - revision not found", "3", http.StatusNotFound},
+		{"Invalid - invalid revision format", "first", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodPost, pkgCommon.ApiDeviceProfileRollbackByNameAndNumberEchoRoute, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name, pkgCommon.Revision)
+			c.SetParamValues(TestDeviceProfileName, testCase.revision)
+			err = controller.RollbackDeviceProfile(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				return
+			}
+			var res pkgResponses.DeviceProfileRevisionResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, int64(3), res.Revision.Revision)
+			assert.Equal(t, pkgModels.DeviceProfileRevisionActionRollback, res.Revision.Action)
+			assert.Equal(t, int64(1), res.Revision.RolledBackTo)
+			require.Len(t, res.Revision.Changes, 1)
+			assert.Equal(t, "description", res.Revision.Changes[0].Path)
+			assert.Equal(t, current.Profile.Description, res.Revision.Changes[0].Previous)
+			assert.Equal(t, previous.Profile.Description, res.Revision.Changes[0].Current)
+		})
+	}
+}
+
+func TestRollbackDeviceProfile_StrictProfileChanges(t *testing.T) {
+	dic := mockDic()
+	configuration := container.ConfigurationFrom(dic.Get)
+	configuration.Writable.ProfileChange.StrictDeviceProfileChanges = true
+	dic.Update(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return configuration
+		},
+	})
+
+	controller := NewDeviceProfileRevisionController(dic)
+	require.NotNil(t, controller)
+
+	req, err := http.NewRequest(http.MethodPost, pkgCommon.ApiDeviceProfileRollbackByNameAndNumberEchoRoute, http.NoBody)
+	require.NoError(t, err)
+
+	e := echo.New()
+	// Act
+	recorder := httptest.NewRecorder()
+	c := e.NewContext(req, recorder)
+	c.SetParamNames(common.Name, pkgCommon.Revision)
+	c.SetParamValues(TestDeviceProfileName, "1")
+	err = controller.RollbackDeviceProfile(c)
+	require.NoError(t, err)
+
+	var res commonDTO.BaseResponse
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, http.StatusLocked, recorder.Result().StatusCode, "HTTP status code not as expected")
+	assert.Equal(t, http.StatusLocked, res.StatusCode, "HTTP status code not as expected")
+	assert.NotEmpty(t, res.Message, "Message is empty")
+}
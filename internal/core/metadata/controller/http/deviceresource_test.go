@@ -15,6 +15,7 @@ import (
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
@@ -158,7 +159,8 @@ func TestAddDeviceProfileResource(t *testing.T) {
 	dbClientMock := &mocks.DBClient{}
 	dbClientMock.On("DeviceProfileByName", valid.ProfileName).Return(deviceProfile, nil)
 	dbClientMock.On("DeviceProfileByName", notFoundProfileName.ProfileName).Return(deviceProfile, notFoundDBError)
-	dbClientMock.On("UpdateDeviceProfile", mock.Anything).Return(nil)
+	dbClientMock.On("UpdateDeviceProfileWithRevision", mock.Anything, mock.Anything).Return(pkgModels.DeviceProfileRevision{}, nil)
+	dbClientMock.On("DeviceProfileRevisionsByProfileName", 0, 1, mock.Anything).Return([]pkgModels.DeviceProfileRevision{{Revision: 1}}, nil)
 	dbClientMock.On("DevicesByProfileName", 0, -1, TestDeviceProfileName).Return([]models.Device{}, nil)
 	dbClientMock.On("DeviceCountByProfileName", TestDeviceProfileName).Return(uint32(1), nil)
 	dic.Update(di.ServiceConstructorMap{
@@ -270,7 +272,8 @@ func TestAddDeviceProfileResource_UnitsOfMeasure_Validation(t *testing.T) {
 	container.ConfigurationFrom(dic.Get).Writable.UoM.Validation = true
 	dbClientMock := &mocks.DBClient{}
 	dbClientMock.On("DeviceProfileByName", validReq.ProfileName).Return(deviceProfile, nil)
-	dbClientMock.On("UpdateDeviceProfile", mock.Anything).Return(nil)
+	dbClientMock.On("UpdateDeviceProfileWithRevision", mock.Anything, mock.Anything).Return(pkgModels.DeviceProfileRevision{}, nil)
+	dbClientMock.On("DeviceProfileRevisionsByProfileName", 0, 1, mock.Anything).Return([]pkgModels.DeviceProfileRevision{{Revision: 1}}, nil)
 	dbClientMock.On("DevicesByProfileName", 0, -1, validReq.ProfileName).Return([]models.Device{}, nil)
 	dbClientMock.On("DeviceCountByProfileName", validReq.ProfileName).Return(uint32(1), nil)
 	uomMock := &mocks.UnitsOfMeasure{}
@@ -357,7 +360,8 @@ func TestPatchDeviceProfileResource(t *testing.T) {
 	dbClientMock.On("DeviceProfileByName", valid.ProfileName).Return(deviceProfile, nil)
 	dbClientMock.On("DevicesByProfileName", 0, mock.Anything, valid.ProfileName).Return([]models.Device{}, nil)
 	dbClientMock.On("DeviceCountByProfileName", TestDeviceProfileName).Return(uint32(1), nil)
-	dbClientMock.On("UpdateDeviceProfile", mock.Anything).Return(nil)
+	dbClientMock.On("UpdateDeviceProfileWithRevision", mock.Anything, mock.Anything).Return(pkgModels.DeviceProfileRevision{}, nil)
+	dbClientMock.On("DeviceProfileRevisionsByProfileName", 0, 1, mock.Anything).Return([]pkgModels.DeviceProfileRevision{{Revision: 1}}, nil)
 
 	dbClientMock.On("DeviceProfileByName", deviceExistsProfileName).Return(deviceExistsProfile, nil)
 	dbClientMock.On("DevicesByProfileName", 0, -1, deviceExistsProfileName).Return([]models.Device{{}}, nil)
@@ -444,7 +448,8 @@ func TestDeleteDeviceResourceByName(t *testing.T) {
 	dbClientMock.On("DevicesByProfileName", 0, mock.Anything, TestDeviceProfileName).Return([]models.Device{}, nil)
 	dbClientMock.On("DeviceCountByProfileName", TestDeviceProfileName).Return(uint32(1), nil)
 	dbClientMock.On("DeviceProfileByName", TestDeviceProfileName).Return(dpModel, nil)
-	dbClientMock.On("UpdateDeviceProfile", mock.Anything).Return(nil)
+	dbClientMock.On("UpdateDeviceProfileWithRevision", mock.Anything, mock.Anything).Return(pkgModels.DeviceProfileRevision{}, nil)
+	dbClientMock.On("DeviceProfileRevisionsByProfileName", 0, 1, mock.Anything).Return([]pkgModels.DeviceProfileRevision{{Revision: 1}}, nil)
 
 	dbClientMock.On("DevicesByProfileName", 0, 1, deviceExists).Return([]models.Device{models.Device{}}, nil)
 
@@ -14,6 +14,7 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
@@ -178,6 +179,32 @@ func (dc *DeviceServiceController) AllDeviceServices(c echo.Context) error {
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
+	selector, selected, err := utils.ParseLabelSelectorQueryString(c, labels)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if selected {
+		deviceServices, totalCount, err := application.DeviceServicesByLabelSelector(offset, limit, selector, dc.dic)
+		if err != nil {
+			return utils.WriteErrorResponse(w, ctx, lc, err, "")
+		}
+		response := responseDTO.NewMultiDeviceServicesResponse("", "", http.StatusOK, totalCount, deviceServices)
+		utils.WriteHttpHeader(w, ctx, http.StatusOK)
+		return pkg.EncodeAndWriteResponse(response, w, lc)
+	}
+	cursor, pagedByCursor, err := utils.ParseCursorQueryString(c, offset)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if pagedByCursor {
+		deviceServices, totalCount, next, err := application.DeviceServicesByCursor(cursor, limit, labels, dc.dic)
+		if err != nil {
+			return utils.WriteErrorResponse(w, ctx, lc, err, "")
+		}
+		response := pkgResponses.NewMultiDeviceServicesWithCursorResponse("", "", http.StatusOK, totalCount, deviceServices, next.Token())
+		utils.WriteHttpHeader(w, ctx, http.StatusOK)
+		return pkg.EncodeAndWriteResponse(response, w, lc)
+	}
 	deviceServices, totalCount, err := application.AllDeviceServices(offset, limit, labels, ctx, dc.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
@@ -0,0 +1,207 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgRequests "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/requests"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+const testReadOnlyResourceName = "TestReadOnlyResource"
+
+func buildTestAddDeviceTwinRequest() pkgRequests.AddDeviceTwinRequest {
+	return pkgRequests.AddDeviceTwinRequest{
+		BaseRequest: commonDTO.BaseRequest{
+			RequestId:   ExampleUUID,
+			Versionable: commonDTO.NewVersionable(),
+		},
+		DeviceName: TestDeviceName,
+		Desired:    map[string]string{TestDeviceResourceName: "28"},
+	}
+}
+
+func TestAddDeviceTwin(t *testing.T) {
+	device := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
+	profile := dtos.ToDeviceProfileModel(buildTestDeviceProfileRequest().Profile)
+	profile.DeviceResources = append(profile.DeviceResources, models.DeviceResource{
+		Name:       testReadOnlyResourceName,
+		Properties: models.ResourceProperties{ValueType: common.ValueTypeInt16, ReadWrite: common.ReadWrite_R},
+	})
+	notFoundDeviceName := "notFoundDevice"
+
+	valid := buildTestAddDeviceTwinRequest()
+	unknownResource := buildTestAddDeviceTwinRequest()
+	unknownResource.Desired = map[string]string{"unknown": "28"}
+	readOnlyResource := buildTestAddDeviceTwinRequest()
+	readOnlyResource.Desired = map[string]string{testReadOnlyResourceName: "28"}
+	deviceNotFound := buildTestAddDeviceTwinRequest()
+	deviceNotFound.DeviceName = notFoundDeviceName
+	noDeviceName := buildTestAddDeviceTwinRequest()
+	noDeviceName.DeviceName = ""
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceByName", device.Name).Return(device, nil)
+	dbClientMock.On("DeviceByName", notFoundDeviceName).Return(models.Device{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device doesn't exist in the database", nil))
+	dbClientMock.On("DeviceProfileByName", device.ProfileName).Return(profile, nil)
+	dbClientMock.On("AddDeviceTwin", mock.Anything).Return(func(twin pkgModels.DeviceTwin) pkgModels.DeviceTwin {
+		twin.Id = ExampleUUID
+		return twin
+	}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceTwinController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		request            []pkgRequests.AddDeviceTwinRequest
+		expectedStatusCode int
+		errorExpected      bool
+	}{
+		{"Valid", []pkgRequests.AddDeviceTwinRequest{valid}, http.StatusCreated, false},
+		{"Invalid - unknown device resource", []pkgRequests.AddDeviceTwinRequest{unknownResource}, http.StatusBadRequest, false},
+		{"Invalid - read-only device resource", []pkgRequests.AddDeviceTwinRequest{readOnlyResource}, http.StatusBadRequest, false},
+		{"Invalid - device not found", []pkgRequests.AddDeviceTwinRequest{deviceNotFound}, http.StatusNotFound, false},
+		{"Invalid - no device name", []pkgRequests.AddDeviceTwinRequest{noDeviceName}, http.StatusBadRequest, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			jsonData, err := json.Marshal(testCase.request)
+			require.NoError(t, err)
+
+			reader := strings.NewReader(string(jsonData))
+			req, err := http.NewRequest(http.MethodPost, pkgCommon.ApiDeviceTwinRoute, reader)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = controller.AddDeviceTwin(c)
+			require.NoError(t, err)
+
+			if testCase.errorExpected {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				return
+			}
+
+			var res []commonDTO.BaseWithIdResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusMultiStatus, recorder.Result().StatusCode, "HTTP status code not as expected")
+			require.Len(t, res, 1)
+			assert.Equal(t, testCase.expectedStatusCode, res[0].StatusCode, "BaseResponse status code not as expected")
+			if testCase.expectedStatusCode == http.StatusCreated {
+				assert.Equal(t, ExampleUUID, res[0].Id, "Response id not as expected")
+			} else {
+				assert.NotEmpty(t, res[0].Message, "Response message doesn't contain the error message")
+			}
+		})
+	}
+}
+
+func TestDeviceTwinDeltaByDeviceName(t *testing.T) {
+	device := dtos.ToDeviceModel(buildTestDeviceRequest().Device)
+	twin := pkgModels.DeviceTwin{
+		Id:         ExampleUUID,
+		DeviceName: device.Name,
+		Properties: map[string]pkgModels.TwinProperty{
+			"inSync":      {Desired: "28.5", DesiredModified: 1, Reported: "28.50", ReportedOrigin: 2},
+			"diverged":    {Desired: "on", DesiredModified: 1, Reported: "off", ReportedOrigin: 2},
+			"notReported": {Desired: "10", DesiredModified: 1},
+			"notDesired":  {Reported: "3", ReportedOrigin: 2},
+		},
+	}
+	noTwinDeviceName := "noTwinDevice"
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceByName", device.Name).Return(device, nil)
+	dbClientMock.On("DeviceByName", noTwinDeviceName).Return(models.Device{Name: noTwinDeviceName}, nil)
+	dbClientMock.On("DeviceTwinByDeviceName", device.Name).Return(twin, nil)
+	dbClientMock.On("DeviceTwinByDeviceName", noTwinDeviceName).Return(pkgModels.DeviceTwin{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device twin doesn't exist in the database", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewDeviceTwinController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		deviceName         string
+		errorExpected      bool
+		expectedStatusCode int
+	}{
+		{"Valid", device.Name, false, http.StatusOK},
+		{"Invalid - device twin not found", noTwinDeviceName, true, http.StatusNotFound},
+		{"Invalid - empty device name", "", true, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiDeviceTwinDeltaByDeviceNameEchoRoute, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(testCase.deviceName)
+			err = controller.DeviceTwinDeltaByDeviceName(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.errorExpected {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				return
+			}
+			var res pkgResponses.DeviceTwinDeltaResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, device.Name, res.Delta.DeviceName)
+			assert.Equal(t, device.ProfileName, res.Delta.ProfileName)
+			require.Len(t, res.Delta.Properties, 2)
+			assert.Contains(t, res.Delta.Properties, "diverged")
+			assert.Contains(t, res.Delta.Properties, "notReported")
+		})
+	}
+}
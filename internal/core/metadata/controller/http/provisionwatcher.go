@@ -163,6 +163,19 @@ func (pwc *ProvisionWatcherController) AllProvisionWatchers(c echo.Context) erro
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
+	selector, selected, err := utils.ParseLabelSelectorQueryString(c, labels)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if selected {
+		provisionWatchers, totalCount, err := application.ProvisionWatchersByLabelSelector(offset, limit, selector, pwc.dic)
+		if err != nil {
+			return utils.WriteErrorResponse(w, ctx, lc, err, "")
+		}
+		response := responseDTO.NewMultiProvisionWatchersResponse("", "", http.StatusOK, totalCount, provisionWatchers)
+		utils.WriteHttpHeader(w, ctx, http.StatusOK)
+		return pkg.EncodeAndWriteResponse(response, w, lc)
+	}
 	provisionWatchers, totalCount, err := application.AllProvisionWatchers(offset, limit, labels, pwc.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
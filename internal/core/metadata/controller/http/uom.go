@@ -6,15 +6,21 @@
 package http
 
 import (
+	"fmt"
 	"net/http"
+	"strconv"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 
 	"github.com/labstack/echo/v4"
@@ -48,3 +54,64 @@ func (uc *UnitOfMeasureController) UnitsOfMeasure(c echo.Context) error {
 		return pkg.EncodeAndWriteResponse(response, w, lc)
 	}
 }
+
+func (uc *UnitOfMeasureController) ConvertUnit(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	lc := bootstrapContainer.LoggingClientFrom(uc.dic.Get)
+
+	value, err := parseValueQueryString(c)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	from := c.QueryParam(pkgCommon.From)
+	to := c.QueryParam(pkgCommon.To)
+
+	result, err := application.ConvertUnit(value, from, to, uc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := pkgResponses.NewUnitConversionResponse("", "", http.StatusOK, value, from, to, result)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+func (uc *UnitOfMeasureController) ConvertToCanonicalUnit(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	lc := bootstrapContainer.LoggingClientFrom(uc.dic.Get)
+
+	// URL parameters
+	profileName := c.Param(common.ProfileName)
+	resourceName := c.Param(common.ResourceName)
+
+	value, err := parseValueQueryString(c)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	result, from, to, err := application.ConvertToCanonicalUnit(value, profileName, resourceName, uc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := pkgResponses.NewUnitConversionResponse("", "", http.StatusOK, value, from, to, result)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// parseValueQueryString parses the required value query string to a float
+func parseValueQueryString(c echo.Context) (float64, errors.EdgeX) {
+	s := c.QueryParam(pkgCommon.Value)
+	if s == "" {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("querystring %s is required", pkgCommon.Value), nil)
+	}
+	value, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse querystring %s's value %s into float", pkgCommon.Value, s), err)
+	}
+	return value, nil
+}
@@ -15,11 +15,15 @@ import (
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces/mocks"
 	"github.com/edgexfoundry/edgex-go/internal/core/metadata/uom"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgResponses "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
 
 	"github.com/labstack/echo/v4"
 )
@@ -88,3 +92,125 @@ func TestUnitOfMeasureController_UnitsOfMeasure(t *testing.T) {
 		})
 	}
 }
+
+func TestUnitOfMeasureController_ConvertUnit(t *testing.T) {
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		container.UnitsOfMeasureInterfaceName: func(get di.Get) interface{} {
+			return &uom.UnitsOfMeasureImpl{}
+		},
+	})
+
+	controller := NewUnitOfMeasureController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		value              string
+		from               string
+		to                 string
+		expectedResult     float64
+		expectedStatusCode int
+	}{
+		{"valid", "2", "bar", "psi", 29.007547546, http.StatusOK},
+		{"valid - offset units", "25", "C", "F", 77, http.StatusOK},
+		{"invalid - no value", "", "bar", "psi", 0, http.StatusBadRequest},
+		{"invalid - value not a number", "two", "bar", "psi", 0, http.StatusBadRequest},
+		{"invalid - no unit to convert to", "2", "bar", "", 0, http.StatusBadRequest},
+		{"invalid - unknown unit", "2", "bar", "foo", 0, http.StatusBadRequest},
+		{"invalid - different dimensions", "2", "bar", "C", 0, http.StatusBadRequest},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiUnitsOfMeasureConvertRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(pkgCommon.Value, testCase.value)
+			query.Add(pkgCommon.From, testCase.from)
+			query.Add(pkgCommon.To, testCase.to)
+			req.URL.RawQuery = query.Encode()
+
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = controller.ConvertUnit(c)
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			var res pkgResponses.UnitConversionResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			if testCase.expectedStatusCode != http.StatusOK {
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				return
+			}
+			assert.Equal(t, testCase.from, res.From)
+			assert.Equal(t, testCase.to, res.To)
+			assert.InDelta(t, testCase.expectedResult, res.Result, 1e-6)
+		})
+	}
+}
+
+func TestUnitOfMeasureController_ConvertToCanonicalUnit(t *testing.T) {
+	profile := models.DeviceProfile{
+		Name: TestDeviceProfileName,
+		DeviceResources: []models.DeviceResource{
+			{Name: "pressure", Properties: models.ResourceProperties{Units: "psi", Optional: map[string]any{pkgCommon.CanonicalUnit: "kPa"}}},
+			{Name: "temperature", Properties: models.ResourceProperties{Units: "C"}},
+		},
+	}
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeviceProfileByName", TestDeviceProfileName).Return(profile, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		container.UnitsOfMeasureInterfaceName: func(get di.Get) interface{} {
+			return &uom.UnitsOfMeasureImpl{}
+		},
+	})
+
+	controller := NewUnitOfMeasureController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		resourceName       string
+		expectedStatusCode int
+	}{
+		{"valid", "pressure", http.StatusOK},
+		{"invalid - no canonical unit", "temperature", http.StatusBadRequest},
+		{"invalid - resource not found", "humidity", http.StatusNotFound},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, pkgCommon.ApiUnitsOfMeasureConvertByProfileAndResourceEchoRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(pkgCommon.Value, "14.503773773")
+			req.URL.RawQuery = query.Encode()
+
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.ProfileName, common.ResourceName)
+			c.SetParamValues(TestDeviceProfileName, testCase.resourceName)
+			err = controller.ConvertToCanonicalUnit(c)
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				return
+			}
+			var res pkgResponses.UnitConversionResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, "psi", res.From)
+			assert.Equal(t, "kPa", res.To)
+			assert.InDelta(t, 100, res.Result, 1e-6)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/fxamacker/cbor/v2"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/application"
+	metadataContainer "github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+)
+
+// SubscribeEvents subscribes to the events published by the device services to keep the reported values of the
+// device twins
+func SubscribeEvents(ctx context.Context, dic *di.Container) errors.EdgeX {
+	messageBusInfo := metadataContainer.ConfigurationFrom(dic.Get).MessageBus
+	lc := container.LoggingClientFrom(dic.Get)
+
+	messageBus := container.MessagingClientFrom(dic.Get)
+	if messageBus == nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "MessageBus client is not available", nil)
+	}
+
+	messages := make(chan types.MessageEnvelope)
+	messageErrors := make(chan error)
+
+	subscribeTopic := common.BuildTopic(messageBusInfo.GetBaseTopicPrefix(), common.CoreDataEventSubscribeTopic)
+
+	topics := []types.TopicChannel{
+		{
+			Topic:    subscribeTopic,
+			Messages: messages,
+		},
+	}
+
+	err := messageBus.Subscribe(topics, messageErrors)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				lc.Infof("Exiting waiting for MessageBus '%s' topic messages", subscribeTopic)
+				return
+			case e := <-messageErrors:
+				lc.Error(e.Error())
+			case msgEnvelope := <-messages:
+				lc.Debugf("Event received from MessageBus. Topic: %s, Correlation-id: %s", msgEnvelope.ReceivedTopic, msgEnvelope.CorrelationID)
+				event := &requests.AddEventRequest{}
+				err = unmarshalPayload(msgEnvelope, event)
+				if err != nil {
+					lc.Errorf("fail to unmarshal event, %v", err)
+					break
+				}
+				err = application.UpdateDeviceTwinReported(event.Event, ctx, dic)
+				if err != nil {
+					lc.Errorf("fail to update the reported values of the device twin of device %s, %v", event.Event.DeviceName, err)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+func unmarshalPayload(envelope types.MessageEnvelope, target interface{}) error {
+	var err error
+	switch envelope.ContentType {
+	case common.ContentTypeJSON:
+		err = json.Unmarshal(envelope.Payload, target)
+
+	case common.ContentTypeCBOR:
+		err = cbor.Unmarshal(envelope.Payload, target)
+
+	default:
+		err = fmt.Errorf("unsupported content-type '%s' recieved", envelope.ContentType)
+	}
+	return err
+}
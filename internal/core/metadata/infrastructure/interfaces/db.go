@@ -8,6 +8,9 @@ package interfaces
 import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 	model "github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/labelselector"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 )
 
 type DBClient interface {
@@ -21,12 +24,14 @@ type DBClient interface {
 	DeleteDeviceProfileByName(name string) errors.EdgeX
 	DeviceProfileNameExists(name string) (bool, errors.EdgeX)
 	AllDeviceProfiles(offset int, limit int, labels []string) ([]model.DeviceProfile, errors.EdgeX)
+	DeviceProfilesBeforeCursor(cursor pkgModels.Cursor, limit int) ([]model.DeviceProfile, pkgModels.Cursor, errors.EdgeX)
 	DeviceProfilesByModel(offset int, limit int, model string) ([]model.DeviceProfile, errors.EdgeX)
 	DeviceProfilesByManufacturer(offset int, limit int, manufacturer string) ([]model.DeviceProfile, errors.EdgeX)
 	DeviceProfilesByManufacturerAndModel(offset int, limit int, manufacturer string, model string) ([]model.DeviceProfile, uint32, errors.EdgeX)
 	DeviceProfileCountByLabels(labels []string) (uint32, errors.EdgeX)
 	DeviceProfileCountByManufacturer(manufacturer string) (uint32, errors.EdgeX)
 	DeviceProfileCountByModel(model string) (uint32, errors.EdgeX)
+	DeviceProfilesByLabelSelector(offset int, limit int, selector labelselector.Selector) ([]model.DeviceProfile, uint32, errors.EdgeX)
 
 	AddDeviceService(ds model.DeviceService) (model.DeviceService, errors.EdgeX)
 	DeviceServiceById(id string) (model.DeviceService, errors.EdgeX)
@@ -35,8 +40,10 @@ type DBClient interface {
 	DeleteDeviceServiceByName(name string) errors.EdgeX
 	DeviceServiceNameExists(name string) (bool, errors.EdgeX)
 	AllDeviceServices(offset int, limit int, labels []string) ([]model.DeviceService, errors.EdgeX)
+	DeviceServicesBeforeCursor(cursor pkgModels.Cursor, limit int) ([]model.DeviceService, pkgModels.Cursor, errors.EdgeX)
 	UpdateDeviceService(ds model.DeviceService) errors.EdgeX
 	DeviceServiceCountByLabels(labels []string) (uint32, errors.EdgeX)
+	DeviceServicesByLabelSelector(offset int, limit int, selector labelselector.Selector) ([]model.DeviceService, uint32, errors.EdgeX)
 
 	AddDevice(d model.Device) (model.Device, errors.EdgeX)
 	DeleteDeviceById(id string) errors.EdgeX
@@ -47,11 +54,13 @@ type DBClient interface {
 	DeviceById(id string) (model.Device, errors.EdgeX)
 	DeviceByName(name string) (model.Device, errors.EdgeX)
 	AllDevices(offset int, limit int, labels []string) ([]model.Device, errors.EdgeX)
+	DevicesBeforeCursor(cursor pkgModels.Cursor, limit int) ([]model.Device, pkgModels.Cursor, errors.EdgeX)
 	DevicesByProfileName(offset int, limit int, profileName string) ([]model.Device, errors.EdgeX)
 	UpdateDevice(d model.Device) errors.EdgeX
 	DeviceCountByLabels(labels []string) (uint32, errors.EdgeX)
 	DeviceCountByProfileName(profileName string) (uint32, errors.EdgeX)
 	DeviceCountByServiceName(serviceName string) (uint32, errors.EdgeX)
+	DevicesByLabelSelector(offset int, limit int, selector labelselector.Selector) ([]model.Device, uint32, errors.EdgeX)
 
 	AddProvisionWatcher(pw model.ProvisionWatcher) (model.ProvisionWatcher, errors.EdgeX)
 	ProvisionWatcherById(id string) (model.ProvisionWatcher, errors.EdgeX)
@@ -64,4 +73,28 @@ type DBClient interface {
 	ProvisionWatcherCountByLabels(labels []string) (uint32, errors.EdgeX)
 	ProvisionWatcherCountByServiceName(name string) (uint32, errors.EdgeX)
 	ProvisionWatcherCountByProfileName(name string) (uint32, errors.EdgeX)
+	ProvisionWatchersByLabelSelector(offset int, limit int, selector labelselector.Selector) ([]model.ProvisionWatcher, uint32, errors.EdgeX)
+
+	AddDeviceTwin(twin pkgModels.DeviceTwin) (pkgModels.DeviceTwin, errors.EdgeX)
+	DeviceTwinByDeviceName(deviceName string) (pkgModels.DeviceTwin, errors.EdgeX)
+	DeviceTwinExists(deviceName string) (bool, errors.EdgeX)
+	AllDeviceTwins(offset int, limit int) ([]pkgModels.DeviceTwin, errors.EdgeX)
+	UpdateDeviceTwin(twin pkgModels.DeviceTwin) errors.EdgeX
+	DeleteDeviceTwinByDeviceName(deviceName string) errors.EdgeX
+	DeviceTwinTotalCount() (uint32, errors.EdgeX)
+
+	AddDeviceGroup(g pkgModels.DeviceGroup) (pkgModels.DeviceGroup, errors.EdgeX)
+	DeviceGroupById(id string) (pkgModels.DeviceGroup, errors.EdgeX)
+	DeviceGroupByName(name string) (pkgModels.DeviceGroup, errors.EdgeX)
+	AllDeviceGroups(offset int, limit int) ([]pkgModels.DeviceGroup, errors.EdgeX)
+	UpdateDeviceGroup(g pkgModels.DeviceGroup) errors.EdgeX
+	DeleteDeviceGroupByName(name string) errors.EdgeX
+	DeviceGroupTotalCount() (uint32, errors.EdgeX)
+
+	AddDeviceProfileRevision(r pkgModels.DeviceProfileRevision) (pkgModels.DeviceProfileRevision, errors.EdgeX)
+	AddDeviceProfileWithRevision(dp model.DeviceProfile, r pkgModels.DeviceProfileRevision) (model.DeviceProfile, errors.EdgeX)
+	UpdateDeviceProfileWithRevision(dp model.DeviceProfile, r pkgModels.DeviceProfileRevision) (pkgModels.DeviceProfileRevision, errors.EdgeX)
+	DeviceProfileRevisionByNumber(profileName string, revision int64) (pkgModels.DeviceProfileRevision, errors.EdgeX)
+	DeviceProfileRevisionsByProfileName(offset int, limit int, profileName string) ([]pkgModels.DeviceProfileRevision, errors.EdgeX)
+	DeviceProfileRevisionCountByProfileName(profileName string) (uint32, errors.EdgeX)
 }
@@ -5,9 +5,13 @@ package mocks
 import (
 	errors "github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 
+	labelselector "github.com/edgexfoundry/edgex-go/internal/pkg/labelselector"
+
 	mock "github.com/stretchr/testify/mock"
 
 	models "github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	pkgmodels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 )
 
 // DBClient is an autogenerated mock type for the DBClient type
@@ -38,6 +42,29 @@ func (_m *DBClient) AddDevice(d models.Device) (models.Device, errors.EdgeX) {
 	return r0, r1
 }
 
+// AddDeviceGroup provides a mock function with given fields: g
+func (_m *DBClient) AddDeviceGroup(g pkgmodels.DeviceGroup) (pkgmodels.DeviceGroup, errors.EdgeX) {
+	ret := _m.Called(g)
+
+	var r0 pkgmodels.DeviceGroup
+	if rf, ok := ret.Get(0).(func(pkgmodels.DeviceGroup) pkgmodels.DeviceGroup); ok {
+		r0 = rf(g)
+	} else {
+		r0 = ret.Get(0).(pkgmodels.DeviceGroup)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(pkgmodels.DeviceGroup) errors.EdgeX); ok {
+		r1 = rf(g)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AddDeviceProfile provides a mock function with given fields: e
 func (_m *DBClient) AddDeviceProfile(e models.DeviceProfile) (models.DeviceProfile, errors.EdgeX) {
 	ret := _m.Called(e)
@@ -61,6 +88,52 @@ func (_m *DBClient) AddDeviceProfile(e models.DeviceProfile) (models.DeviceProfi
 	return r0, r1
 }
 
+// AddDeviceProfileRevision provides a mock function with given fields: r
+func (_m *DBClient) AddDeviceProfileRevision(r pkgmodels.DeviceProfileRevision) (pkgmodels.DeviceProfileRevision, errors.EdgeX) {
+	ret := _m.Called(r)
+
+	var r0 pkgmodels.DeviceProfileRevision
+	if rf, ok := ret.Get(0).(func(pkgmodels.DeviceProfileRevision) pkgmodels.DeviceProfileRevision); ok {
+		r0 = rf(r)
+	} else {
+		r0 = ret.Get(0).(pkgmodels.DeviceProfileRevision)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(pkgmodels.DeviceProfileRevision) errors.EdgeX); ok {
+		r1 = rf(r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddDeviceProfileWithRevision provides a mock function with given fields: dp, r
+func (_m *DBClient) AddDeviceProfileWithRevision(dp models.DeviceProfile, r pkgmodels.DeviceProfileRevision) (models.DeviceProfile, errors.EdgeX) {
+	ret := _m.Called(dp, r)
+
+	var r0 models.DeviceProfile
+	if rf, ok := ret.Get(0).(func(models.DeviceProfile, pkgmodels.DeviceProfileRevision) models.DeviceProfile); ok {
+		r0 = rf(dp, r)
+	} else {
+		r0 = ret.Get(0).(models.DeviceProfile)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(models.DeviceProfile, pkgmodels.DeviceProfileRevision) errors.EdgeX); ok {
+		r1 = rf(dp, r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AddDeviceService provides a mock function with given fields: ds
 func (_m *DBClient) AddDeviceService(ds models.DeviceService) (models.DeviceService, errors.EdgeX) {
 	ret := _m.Called(ds)
@@ -84,6 +157,29 @@ func (_m *DBClient) AddDeviceService(ds models.DeviceService) (models.DeviceServ
 	return r0, r1
 }
 
+// AddDeviceTwin provides a mock function with given fields: twin
+func (_m *DBClient) AddDeviceTwin(twin pkgmodels.DeviceTwin) (pkgmodels.DeviceTwin, errors.EdgeX) {
+	ret := _m.Called(twin)
+
+	var r0 pkgmodels.DeviceTwin
+	if rf, ok := ret.Get(0).(func(pkgmodels.DeviceTwin) pkgmodels.DeviceTwin); ok {
+		r0 = rf(twin)
+	} else {
+		r0 = ret.Get(0).(pkgmodels.DeviceTwin)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(pkgmodels.DeviceTwin) errors.EdgeX); ok {
+		r1 = rf(twin)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AddProvisionWatcher provides a mock function with given fields: pw
 func (_m *DBClient) AddProvisionWatcher(pw models.ProvisionWatcher) (models.ProvisionWatcher, errors.EdgeX) {
 	ret := _m.Called(pw)
@@ -107,6 +203,31 @@ func (_m *DBClient) AddProvisionWatcher(pw models.ProvisionWatcher) (models.Prov
 	return r0, r1
 }
 
+// AllDeviceGroups provides a mock function with given fields: offset, limit
+func (_m *DBClient) AllDeviceGroups(offset int, limit int) ([]pkgmodels.DeviceGroup, errors.EdgeX) {
+	ret := _m.Called(offset, limit)
+
+	var r0 []pkgmodels.DeviceGroup
+	if rf, ok := ret.Get(0).(func(int, int) []pkgmodels.DeviceGroup); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgmodels.DeviceGroup)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AllDeviceProfiles provides a mock function with given fields: offset, limit, labels
 func (_m *DBClient) AllDeviceProfiles(offset int, limit int, labels []string) ([]models.DeviceProfile, errors.EdgeX) {
 	ret := _m.Called(offset, limit, labels)
@@ -157,6 +278,31 @@ func (_m *DBClient) AllDeviceServices(offset int, limit int, labels []string) ([
 	return r0, r1
 }
 
+// AllDeviceTwins provides a mock function with given fields: offset, limit
+func (_m *DBClient) AllDeviceTwins(offset int, limit int) ([]pkgmodels.DeviceTwin, errors.EdgeX) {
+	ret := _m.Called(offset, limit)
+
+	var r0 []pkgmodels.DeviceTwin
+	if rf, ok := ret.Get(0).(func(int, int) []pkgmodels.DeviceTwin); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgmodels.DeviceTwin)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AllDevices provides a mock function with given fields: offset, limit, labels
 func (_m *DBClient) AllDevices(offset int, limit int, labels []string) ([]models.Device, errors.EdgeX) {
 	ret := _m.Called(offset, limit, labels)
@@ -244,6 +390,22 @@ func (_m *DBClient) DeleteDeviceByName(name string) errors.EdgeX {
 	return r0
 }
 
+// DeleteDeviceGroupByName provides a mock function with given fields: name
+func (_m *DBClient) DeleteDeviceGroupByName(name string) errors.EdgeX {
+	ret := _m.Called(name)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // DeleteDeviceProfileById provides a mock function with given fields: id
 func (_m *DBClient) DeleteDeviceProfileById(id string) errors.EdgeX {
 	ret := _m.Called(id)
@@ -308,6 +470,22 @@ func (_m *DBClient) DeleteDeviceServiceByName(name string) errors.EdgeX {
 	return r0
 }
 
+// DeleteDeviceTwinByDeviceName provides a mock function with given fields: deviceName
+func (_m *DBClient) DeleteDeviceTwinByDeviceName(deviceName string) errors.EdgeX {
+	ret := _m.Called(deviceName)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(deviceName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // DeleteProvisionWatcherByName provides a mock function with given fields: name
 func (_m *DBClient) DeleteProvisionWatcherByName(name string) errors.EdgeX {
 	ret := _m.Called(name)
@@ -439,6 +617,75 @@ func (_m *DBClient) DeviceCountByServiceName(serviceName string) (uint32, errors
 	return r0, r1
 }
 
+// DeviceGroupById provides a mock function with given fields: id
+func (_m *DBClient) DeviceGroupById(id string) (pkgmodels.DeviceGroup, errors.EdgeX) {
+	ret := _m.Called(id)
+
+	var r0 pkgmodels.DeviceGroup
+	if rf, ok := ret.Get(0).(func(string) pkgmodels.DeviceGroup); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(pkgmodels.DeviceGroup)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceGroupByName provides a mock function with given fields: name
+func (_m *DBClient) DeviceGroupByName(name string) (pkgmodels.DeviceGroup, errors.EdgeX) {
+	ret := _m.Called(name)
+
+	var r0 pkgmodels.DeviceGroup
+	if rf, ok := ret.Get(0).(func(string) pkgmodels.DeviceGroup); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(pkgmodels.DeviceGroup)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceGroupTotalCount provides a mock function with given fields:
+func (_m *DBClient) DeviceGroupTotalCount() (uint32, errors.EdgeX) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // DeviceIdExists provides a mock function with given fields: id
 func (_m *DBClient) DeviceIdExists(id string) (bool, errors.EdgeX) {
 	ret := _m.Called(id)
@@ -623,6 +870,141 @@ func (_m *DBClient) DeviceProfileNameExists(name string) (bool, errors.EdgeX) {
 	return r0, r1
 }
 
+// DeviceProfileRevisionByNumber provides a mock function with given fields: profileName, revision
+func (_m *DBClient) DeviceProfileRevisionByNumber(profileName string, revision int64) (pkgmodels.DeviceProfileRevision, errors.EdgeX) {
+	ret := _m.Called(profileName, revision)
+
+	var r0 pkgmodels.DeviceProfileRevision
+	if rf, ok := ret.Get(0).(func(string, int64) pkgmodels.DeviceProfileRevision); ok {
+		r0 = rf(profileName, revision)
+	} else {
+		r0 = ret.Get(0).(pkgmodels.DeviceProfileRevision)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, int64) errors.EdgeX); ok {
+		r1 = rf(profileName, revision)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceProfileRevisionCountByProfileName provides a mock function with given fields: profileName
+func (_m *DBClient) DeviceProfileRevisionCountByProfileName(profileName string) (uint32, errors.EdgeX) {
+	ret := _m.Called(profileName)
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func(string) uint32); ok {
+		r0 = rf(profileName)
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(profileName)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceProfileRevisionsByProfileName provides a mock function with given fields: offset, limit, profileName
+func (_m *DBClient) DeviceProfileRevisionsByProfileName(offset int, limit int, profileName string) ([]pkgmodels.DeviceProfileRevision, errors.EdgeX) {
+	ret := _m.Called(offset, limit, profileName)
+
+	var r0 []pkgmodels.DeviceProfileRevision
+	if rf, ok := ret.Get(0).(func(int, int, string) []pkgmodels.DeviceProfileRevision); ok {
+		r0 = rf(offset, limit, profileName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgmodels.DeviceProfileRevision)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(int, int, string) errors.EdgeX); ok {
+		r1 = rf(offset, limit, profileName)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceProfilesBeforeCursor provides a mock function with given fields: cursor, limit
+func (_m *DBClient) DeviceProfilesBeforeCursor(cursor pkgmodels.Cursor, limit int) ([]models.DeviceProfile, pkgmodels.Cursor, errors.EdgeX) {
+	ret := _m.Called(cursor, limit)
+
+	var r0 []models.DeviceProfile
+	if rf, ok := ret.Get(0).(func(pkgmodels.Cursor, int) []models.DeviceProfile); ok {
+		r0 = rf(cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DeviceProfile)
+		}
+	}
+
+	var r1 pkgmodels.Cursor
+	if rf, ok := ret.Get(1).(func(pkgmodels.Cursor, int) pkgmodels.Cursor); ok {
+		r1 = rf(cursor, limit)
+	} else {
+		r1 = ret.Get(1).(pkgmodels.Cursor)
+	}
+
+	var r2 errors.EdgeX
+	if rf, ok := ret.Get(2).(func(pkgmodels.Cursor, int) errors.EdgeX); ok {
+		r2 = rf(cursor, limit)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1, r2
+}
+
+// DeviceProfilesByLabelSelector provides a mock function with given fields: offset, limit, selector
+func (_m *DBClient) DeviceProfilesByLabelSelector(offset int, limit int, selector labelselector.Selector) ([]models.DeviceProfile, uint32, errors.EdgeX) {
+	ret := _m.Called(offset, limit, selector)
+
+	var r0 []models.DeviceProfile
+	if rf, ok := ret.Get(0).(func(int, int, labelselector.Selector) []models.DeviceProfile); ok {
+		r0 = rf(offset, limit, selector)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DeviceProfile)
+		}
+	}
+
+	var r1 uint32
+	if rf, ok := ret.Get(1).(func(int, int, labelselector.Selector) uint32); ok {
+		r1 = rf(offset, limit, selector)
+	} else {
+		r1 = ret.Get(1).(uint32)
+	}
+
+	var r2 errors.EdgeX
+	if rf, ok := ret.Get(2).(func(int, int, labelselector.Selector) errors.EdgeX); ok {
+		r2 = rf(offset, limit, selector)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1, r2
+}
+
 // DeviceProfilesByManufacturer provides a mock function with given fields: offset, limit, manufacturer
 func (_m *DBClient) DeviceProfilesByManufacturer(offset int, limit int, manufacturer string) ([]models.DeviceProfile, errors.EdgeX) {
 	ret := _m.Called(offset, limit, manufacturer)
@@ -797,6 +1179,203 @@ func (_m *DBClient) DeviceServiceNameExists(name string) (bool, errors.EdgeX) {
 	return r0, r1
 }
 
+// DeviceServicesBeforeCursor provides a mock function with given fields: cursor, limit
+func (_m *DBClient) DeviceServicesBeforeCursor(cursor pkgmodels.Cursor, limit int) ([]models.DeviceService, pkgmodels.Cursor, errors.EdgeX) {
+	ret := _m.Called(cursor, limit)
+
+	var r0 []models.DeviceService
+	if rf, ok := ret.Get(0).(func(pkgmodels.Cursor, int) []models.DeviceService); ok {
+		r0 = rf(cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DeviceService)
+		}
+	}
+
+	var r1 pkgmodels.Cursor
+	if rf, ok := ret.Get(1).(func(pkgmodels.Cursor, int) pkgmodels.Cursor); ok {
+		r1 = rf(cursor, limit)
+	} else {
+		r1 = ret.Get(1).(pkgmodels.Cursor)
+	}
+
+	var r2 errors.EdgeX
+	if rf, ok := ret.Get(2).(func(pkgmodels.Cursor, int) errors.EdgeX); ok {
+		r2 = rf(cursor, limit)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1, r2
+}
+
+// DeviceServicesByLabelSelector provides a mock function with given fields: offset, limit, selector
+func (_m *DBClient) DeviceServicesByLabelSelector(offset int, limit int, selector labelselector.Selector) ([]models.DeviceService, uint32, errors.EdgeX) {
+	ret := _m.Called(offset, limit, selector)
+
+	var r0 []models.DeviceService
+	if rf, ok := ret.Get(0).(func(int, int, labelselector.Selector) []models.DeviceService); ok {
+		r0 = rf(offset, limit, selector)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DeviceService)
+		}
+	}
+
+	var r1 uint32
+	if rf, ok := ret.Get(1).(func(int, int, labelselector.Selector) uint32); ok {
+		r1 = rf(offset, limit, selector)
+	} else {
+		r1 = ret.Get(1).(uint32)
+	}
+
+	var r2 errors.EdgeX
+	if rf, ok := ret.Get(2).(func(int, int, labelselector.Selector) errors.EdgeX); ok {
+		r2 = rf(offset, limit, selector)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1, r2
+}
+
+// DeviceTwinByDeviceName provides a mock function with given fields: deviceName
+func (_m *DBClient) DeviceTwinByDeviceName(deviceName string) (pkgmodels.DeviceTwin, errors.EdgeX) {
+	ret := _m.Called(deviceName)
+
+	var r0 pkgmodels.DeviceTwin
+	if rf, ok := ret.Get(0).(func(string) pkgmodels.DeviceTwin); ok {
+		r0 = rf(deviceName)
+	} else {
+		r0 = ret.Get(0).(pkgmodels.DeviceTwin)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(deviceName)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceTwinExists provides a mock function with given fields: deviceName
+func (_m *DBClient) DeviceTwinExists(deviceName string) (bool, errors.EdgeX) {
+	ret := _m.Called(deviceName)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(deviceName)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(deviceName)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeviceTwinTotalCount provides a mock function with given fields:
+func (_m *DBClient) DeviceTwinTotalCount() (uint32, errors.EdgeX) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DevicesBeforeCursor provides a mock function with given fields: cursor, limit
+func (_m *DBClient) DevicesBeforeCursor(cursor pkgmodels.Cursor, limit int) ([]models.Device, pkgmodels.Cursor, errors.EdgeX) {
+	ret := _m.Called(cursor, limit)
+
+	var r0 []models.Device
+	if rf, ok := ret.Get(0).(func(pkgmodels.Cursor, int) []models.Device); ok {
+		r0 = rf(cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Device)
+		}
+	}
+
+	var r1 pkgmodels.Cursor
+	if rf, ok := ret.Get(1).(func(pkgmodels.Cursor, int) pkgmodels.Cursor); ok {
+		r1 = rf(cursor, limit)
+	} else {
+		r1 = ret.Get(1).(pkgmodels.Cursor)
+	}
+
+	var r2 errors.EdgeX
+	if rf, ok := ret.Get(2).(func(pkgmodels.Cursor, int) errors.EdgeX); ok {
+		r2 = rf(cursor, limit)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1, r2
+}
+
+// DevicesByLabelSelector provides a mock function with given fields: offset, limit, selector
+func (_m *DBClient) DevicesByLabelSelector(offset int, limit int, selector labelselector.Selector) ([]models.Device, uint32, errors.EdgeX) {
+	ret := _m.Called(offset, limit, selector)
+
+	var r0 []models.Device
+	if rf, ok := ret.Get(0).(func(int, int, labelselector.Selector) []models.Device); ok {
+		r0 = rf(offset, limit, selector)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Device)
+		}
+	}
+
+	var r1 uint32
+	if rf, ok := ret.Get(1).(func(int, int, labelselector.Selector) uint32); ok {
+		r1 = rf(offset, limit, selector)
+	} else {
+		r1 = ret.Get(1).(uint32)
+	}
+
+	var r2 errors.EdgeX
+	if rf, ok := ret.Get(2).(func(int, int, labelselector.Selector) errors.EdgeX); ok {
+		r2 = rf(offset, limit, selector)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1, r2
+}
+
 // DevicesByProfileName provides a mock function with given fields: offset, limit, profileName
 func (_m *DBClient) DevicesByProfileName(offset int, limit int, profileName string) ([]models.Device, errors.EdgeX) {
 	ret := _m.Called(offset, limit, profileName)
@@ -962,6 +1541,38 @@ func (_m *DBClient) ProvisionWatcherCountByServiceName(name string) (uint32, err
 	return r0, r1
 }
 
+// ProvisionWatchersByLabelSelector provides a mock function with given fields: offset, limit, selector
+func (_m *DBClient) ProvisionWatchersByLabelSelector(offset int, limit int, selector labelselector.Selector) ([]models.ProvisionWatcher, uint32, errors.EdgeX) {
+	ret := _m.Called(offset, limit, selector)
+
+	var r0 []models.ProvisionWatcher
+	if rf, ok := ret.Get(0).(func(int, int, labelselector.Selector) []models.ProvisionWatcher); ok {
+		r0 = rf(offset, limit, selector)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ProvisionWatcher)
+		}
+	}
+
+	var r1 uint32
+	if rf, ok := ret.Get(1).(func(int, int, labelselector.Selector) uint32); ok {
+		r1 = rf(offset, limit, selector)
+	} else {
+		r1 = ret.Get(1).(uint32)
+	}
+
+	var r2 errors.EdgeX
+	if rf, ok := ret.Get(2).(func(int, int, labelselector.Selector) errors.EdgeX); ok {
+		r2 = rf(offset, limit, selector)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1, r2
+}
+
 // ProvisionWatchersByProfileName provides a mock function with given fields: offset, limit, name
 func (_m *DBClient) ProvisionWatchersByProfileName(offset int, limit int, name string) ([]models.ProvisionWatcher, errors.EdgeX) {
 	ret := _m.Called(offset, limit, name)
@@ -1028,6 +1639,22 @@ func (_m *DBClient) UpdateDevice(d models.Device) errors.EdgeX {
 	return r0
 }
 
+// UpdateDeviceGroup provides a mock function with given fields: g
+func (_m *DBClient) UpdateDeviceGroup(g pkgmodels.DeviceGroup) errors.EdgeX {
+	ret := _m.Called(g)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(pkgmodels.DeviceGroup) errors.EdgeX); ok {
+		r0 = rf(g)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // UpdateDeviceProfile provides a mock function with given fields: e
 func (_m *DBClient) UpdateDeviceProfile(e models.DeviceProfile) errors.EdgeX {
 	ret := _m.Called(e)
@@ -1044,6 +1671,29 @@ func (_m *DBClient) UpdateDeviceProfile(e models.DeviceProfile) errors.EdgeX {
 	return r0
 }
 
+// UpdateDeviceProfileWithRevision provides a mock function with given fields: dp, r
+func (_m *DBClient) UpdateDeviceProfileWithRevision(dp models.DeviceProfile, r pkgmodels.DeviceProfileRevision) (pkgmodels.DeviceProfileRevision, errors.EdgeX) {
+	ret := _m.Called(dp, r)
+
+	var r0 pkgmodels.DeviceProfileRevision
+	if rf, ok := ret.Get(0).(func(models.DeviceProfile, pkgmodels.DeviceProfileRevision) pkgmodels.DeviceProfileRevision); ok {
+		r0 = rf(dp, r)
+	} else {
+		r0 = ret.Get(0).(pkgmodels.DeviceProfileRevision)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(models.DeviceProfile, pkgmodels.DeviceProfileRevision) errors.EdgeX); ok {
+		r1 = rf(dp, r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // UpdateDeviceService provides a mock function with given fields: ds
 func (_m *DBClient) UpdateDeviceService(ds models.DeviceService) errors.EdgeX {
 	ret := _m.Called(ds)
@@ -1060,6 +1710,22 @@ func (_m *DBClient) UpdateDeviceService(ds models.DeviceService) errors.EdgeX {
 	return r0
 }
 
+// UpdateDeviceTwin provides a mock function with given fields: twin
+func (_m *DBClient) UpdateDeviceTwin(twin pkgmodels.DeviceTwin) errors.EdgeX {
+	ret := _m.Called(twin)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(pkgmodels.DeviceTwin) errors.EdgeX); ok {
+		r0 = rf(twin)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // UpdateProvisionWatcher provides a mock function with given fields: pw
 func (_m *DBClient) UpdateProvisionWatcher(pw models.ProvisionWatcher) errors.EdgeX {
 	ret := _m.Called(pw)
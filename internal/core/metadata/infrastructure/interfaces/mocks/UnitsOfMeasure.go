@@ -2,13 +2,61 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	errors "github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // UnitsOfMeasure is an autogenerated mock type for the UnitsOfMeasure type
 type UnitsOfMeasure struct {
 	mock.Mock
 }
 
+// Convert provides a mock function with given fields: value, from, to
+func (_m *UnitsOfMeasure) Convert(value float64, from string, to string) (float64, errors.EdgeX) {
+	ret := _m.Called(value, from, to)
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func(float64, string, string) float64); ok {
+		r0 = rf(value, from, to)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(float64, string, string) errors.EdgeX); ok {
+		r1 = rf(value, from, to)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// Dimension provides a mock function with given fields: unit
+func (_m *UnitsOfMeasure) Dimension(unit string) (string, bool) {
+	ret := _m.Called(unit)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(unit)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(unit)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // Validate provides a mock function with given fields: _a0
 func (_m *UnitsOfMeasure) Validate(_a0 string) bool {
 	ret := _m.Called(_a0)
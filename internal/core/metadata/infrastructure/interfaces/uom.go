@@ -5,10 +5,18 @@
 
 package interfaces
 
+import "github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
 // UnitsOfMeasure defines required functionality to perform units of measure
-// validation in EdgeX
+// validation and conversion in EdgeX
 type UnitsOfMeasure interface {
 	// Validate validates DeviceResource's unit against the list of
 	// units of measure by core metadata.
 	Validate(string) bool
+	// Dimension returns the dimension of the unit, e.g. pressure, and
+	// whether the unit is known.
+	Dimension(unit string) (string, bool)
+	// Convert converts the value from a unit to another unit of the
+	// same dimension.
+	Convert(value float64, from string, to string) (float64, errors.EdgeX)
 }
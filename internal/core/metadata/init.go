@@ -20,9 +20,13 @@ import (
 	"context"
 	"sync"
 
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/metadata/controller/messaging"
+
 	"github.com/labstack/echo/v4"
 )
 
@@ -44,5 +48,16 @@ func NewBootstrap(router *echo.Echo, serviceName string) *Bootstrap {
 func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
 	LoadRestRoutes(b.router, dic, b.serviceName)
 
+	if container.ConfigurationFrom(dic.Get).DeviceTwin.Enabled {
+		lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+		// the MessageBus is optional, the reported values of the device twins are then not kept but the service starts
+		if bootstrapContainer.MessagingClientFrom(dic.Get) == nil {
+			lc.Warn("MessageBus is disabled, the reported values of the device twins won't be kept from the events")
+		} else if err := messaging.SubscribeEvents(ctx, dic); err != nil {
+			lc.Errorf("Failed to subscribe events from MessageBus for the device twins, %v", err)
+			return false
+		}
+	}
+
 	return true
 }
@@ -16,6 +16,8 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 
 	metadataController "github.com/edgexfoundry/edgex-go/internal/core/metadata/controller/http"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 
 	"github.com/labstack/echo/v4"
 )
@@ -23,7 +25,8 @@ import (
 func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 	lc := container.LoggingClientFrom(dic.Get)
 	secretProvider := container.SecretProviderExtFrom(dic.Get)
-	authenticationHook := handlers.AutoConfigAuthenticationFunc(secretProvider, lc)
+	// The caller identity is recorded as the author of the device profile revisions
+	authenticationHook := utils.CallerIdentityAuthenticationFunc(handlers.AutoConfigAuthenticationFunc(secretProvider, lc))
 
 	// Common
 	_ = controller.NewCommonController(dic, r, serviceName, edgex.Version)
@@ -31,6 +34,8 @@ func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 	// Units of Measure
 	uc := metadataController.NewUnitOfMeasureController(dic)
 	r.GET(common.ApiUnitsOfMeasureRoute, uc.UnitsOfMeasure, authenticationHook)
+	r.GET(pkgCommon.ApiUnitsOfMeasureConvertRoute, uc.ConvertUnit, authenticationHook)
+	r.GET(pkgCommon.ApiUnitsOfMeasureConvertByProfileAndResourceEchoRoute, uc.ConvertToCanonicalUnit, authenticationHook)
 
 	// Device Profile
 	dc := metadataController.NewDeviceProfileController(dic)
@@ -46,6 +51,12 @@ func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 	r.GET(common.ApiDeviceProfileByManufacturerAndModelEchoRoute, dc.DeviceProfilesByManufacturerAndModel, authenticationHook)
 	r.PATCH(common.ApiDeviceProfileBasicInfoRoute, dc.PatchDeviceProfileBasicInfo, authenticationHook)
 
+	// Device Profile Revision
+	dpr := metadataController.NewDeviceProfileRevisionController(dic)
+	r.GET(pkgCommon.ApiDeviceProfileRevisionsByNameEchoRoute, dpr.DeviceProfileRevisionsByName, authenticationHook)
+	r.GET(pkgCommon.ApiDeviceProfileRevisionByNameAndNumberEchoRoute, dpr.DeviceProfileRevisionByNameAndNumber, authenticationHook)
+	r.POST(pkgCommon.ApiDeviceProfileRollbackByNameAndNumberEchoRoute, dpr.RollbackDeviceProfile, authenticationHook)
+
 	// Device Resource
 	dr := metadataController.NewDeviceResourceController(dic)
 	r.GET(common.ApiDeviceResourceByProfileAndResourceEchoRoute, dr.DeviceResourceByProfileNameAndResourceName, authenticationHook)
@@ -78,6 +89,24 @@ func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 	r.GET(common.ApiDeviceByNameEchoRoute, d.DeviceByName, authenticationHook)
 	r.GET(common.ApiDeviceByProfileNameEchoRoute, d.DevicesByProfileName, authenticationHook)
 
+	// Device Group
+	dg := metadataController.NewDeviceGroupController(dic)
+	r.POST(pkgCommon.ApiDeviceGroupRoute, dg.AddDeviceGroup, authenticationHook)
+	r.PATCH(pkgCommon.ApiDeviceGroupRoute, dg.PatchDeviceGroup, authenticationHook)
+	r.GET(pkgCommon.ApiAllDeviceGroupRoute, dg.AllDeviceGroups, authenticationHook)
+	r.GET(pkgCommon.ApiDeviceGroupByNameEchoRoute, dg.DeviceGroupByName, authenticationHook)
+	r.DELETE(pkgCommon.ApiDeviceGroupByNameEchoRoute, dg.DeleteDeviceGroupByName, authenticationHook)
+	r.GET(pkgCommon.ApiDeviceGroupDevicesByNameEchoRoute, dg.DevicesByDeviceGroupName, authenticationHook)
+
+	// Device Twin
+	dt := metadataController.NewDeviceTwinController(dic)
+	r.POST(pkgCommon.ApiDeviceTwinRoute, dt.AddDeviceTwin, authenticationHook)
+	r.PATCH(pkgCommon.ApiDeviceTwinRoute, dt.PatchDeviceTwin, authenticationHook)
+	r.GET(pkgCommon.ApiAllDeviceTwinRoute, dt.AllDeviceTwins, authenticationHook)
+	r.GET(pkgCommon.ApiDeviceTwinByDeviceNameEchoRoute, dt.DeviceTwinByDeviceName, authenticationHook)
+	r.GET(pkgCommon.ApiDeviceTwinDeltaByDeviceNameEchoRoute, dt.DeviceTwinDeltaByDeviceName, authenticationHook)
+	r.DELETE(pkgCommon.ApiDeviceTwinByDeviceNameEchoRoute, dt.DeleteDeviceTwinByDeviceName, authenticationHook)
+
 	// ProvisionWatcher
 	pwc := metadataController.NewProvisionWatcherController(dic)
 	r.POST(common.ApiProvisionWatcherRoute, pwc.AddProvisionWatcher, authenticationHook)
@@ -48,6 +48,10 @@ func BootstrapHandler(_ context.Context, _ *sync.WaitGroup, _ startup.Timer, dic
 		lc.Errorf("could not load unit of measure configuration file: %s", err.Error())
 		return false
 	}
+	if edgeXerr := uomImpl.ValidateConversions(); edgeXerr != nil {
+		lc.Errorf("invalid unit of measure configuration file: %s", edgeXerr.Error())
+		return false
+	}
 
 	dic.Update(di.ServiceConstructorMap{
 		container.UnitsOfMeasureInterfaceName: func(get di.Get) interface{} {
@@ -0,0 +1,132 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package uom
+
+// Dimensions of the built-in units, e.g. a unit of the pressure dimension converts to the pascal
+const (
+	Length          = "length"
+	Mass            = "mass"
+	Time            = "time"
+	Temperature     = "temperature"
+	Pressure        = "pressure"
+	Speed           = "speed"
+	Volume          = "volume"
+	Energy          = "energy"
+	Power           = "power"
+	ElectricCurrent = "electric current"
+	Voltage         = "voltage"
+	Frequency       = "frequency"
+	Ratio           = "ratio"
+)
+
+// Definition defines a unit by its dimension and its conversion to the SI unit of the dimension, a value in the unit
+// being value*Factor+Offset in the SI unit.  Offset is only set for the units whose zero is not the zero of the SI
+// unit, e.g. °C and °F.
+type Definition struct {
+	Dimension string
+	Factor    float64
+	Offset    float64
+}
+
+// ToSI converts the value in the unit to the SI unit of the dimension
+func (d Definition) ToSI(value float64) float64 {
+	return value*d.Factor + d.Offset
+}
+
+// FromSI converts the value in the SI unit of the dimension to the unit
+func (d Definition) FromSI(value float64) float64 {
+	return (value - d.Offset) / d.Factor
+}
+
+const (
+	fahrenheitFactor = 5.0 / 9.0
+	fahrenheitOffset = 273.15 - 32*fahrenheitFactor
+	poundFactor      = 0.45359237
+	ounceFactor      = poundFactor / 16
+)
+
+// builtinUnits are the definitions of the common units, by symbol, which are known without being configured
+var builtinUnits = map[string]Definition{
+	"m":  {Dimension: Length, Factor: 1},
+	"km": {Dimension: Length, Factor: 1e3},
+	"cm": {Dimension: Length, Factor: 1e-2},
+	"mm": {Dimension: Length, Factor: 1e-3},
+	"um": {Dimension: Length, Factor: 1e-6},
+	"in": {Dimension: Length, Factor: 0.0254},
+	"ft": {Dimension: Length, Factor: 0.3048},
+	"yd": {Dimension: Length, Factor: 0.9144},
+	"mi": {Dimension: Length, Factor: 1609.344},
+
+	"kg":     {Dimension: Mass, Factor: 1},
+	"kilos":  {Dimension: Mass, Factor: 1},
+	"g":      {Dimension: Mass, Factor: 1e-3},
+	"grams":  {Dimension: Mass, Factor: 1e-3},
+	"mg":     {Dimension: Mass, Factor: 1e-6},
+	"t":      {Dimension: Mass, Factor: 1e3},
+	"lb":     {Dimension: Mass, Factor: poundFactor},
+	"lbs":    {Dimension: Mass, Factor: poundFactor},
+	"oz":     {Dimension: Mass, Factor: ounceFactor},
+	"ounces": {Dimension: Mass, Factor: ounceFactor},
+
+	"s":   {Dimension: Time, Factor: 1},
+	"ms":  {Dimension: Time, Factor: 1e-3},
+	"us":  {Dimension: Time, Factor: 1e-6},
+	"min": {Dimension: Time, Factor: 60},
+	"h":   {Dimension: Time, Factor: 3600},
+	"d":   {Dimension: Time, Factor: 86400},
+
+	"K":  {Dimension: Temperature, Factor: 1},
+	"C":  {Dimension: Temperature, Factor: 1, Offset: 273.15},
+	"°C": {Dimension: Temperature, Factor: 1, Offset: 273.15},
+	"F":  {Dimension: Temperature, Factor: fahrenheitFactor, Offset: fahrenheitOffset},
+	"°F": {Dimension: Temperature, Factor: fahrenheitFactor, Offset: fahrenheitOffset},
+
+	"Pa":   {Dimension: Pressure, Factor: 1},
+	"hPa":  {Dimension: Pressure, Factor: 1e2},
+	"kPa":  {Dimension: Pressure, Factor: 1e3},
+	"MPa":  {Dimension: Pressure, Factor: 1e6},
+	"bar":  {Dimension: Pressure, Factor: 1e5},
+	"mbar": {Dimension: Pressure, Factor: 1e2},
+	"psi":  {Dimension: Pressure, Factor: 6894.757293168361},
+	"atm":  {Dimension: Pressure, Factor: 101325},
+	"mmHg": {Dimension: Pressure, Factor: 133.322387415},
+	"inHg": {Dimension: Pressure, Factor: 3386.389},
+
+	"m/s":  {Dimension: Speed, Factor: 1},
+	"km/h": {Dimension: Speed, Factor: 1 / 3.6},
+	"mph":  {Dimension: Speed, Factor: 0.44704},
+	"kn":   {Dimension: Speed, Factor: 1852.0 / 3600},
+
+	"m3":  {Dimension: Volume, Factor: 1},
+	"L":   {Dimension: Volume, Factor: 1e-3},
+	"mL":  {Dimension: Volume, Factor: 1e-6},
+	"gal": {Dimension: Volume, Factor: 3.785411784e-3},
+
+	"J":   {Dimension: Energy, Factor: 1},
+	"kJ":  {Dimension: Energy, Factor: 1e3},
+	"Wh":  {Dimension: Energy, Factor: 3600},
+	"kWh": {Dimension: Energy, Factor: 3.6e6},
+	"cal": {Dimension: Energy, Factor: 4.184},
+
+	"W":  {Dimension: Power, Factor: 1},
+	"kW": {Dimension: Power, Factor: 1e3},
+	"MW": {Dimension: Power, Factor: 1e6},
+	"hp": {Dimension: Power, Factor: 745.6998715822702},
+
+	"A":  {Dimension: ElectricCurrent, Factor: 1},
+	"mA": {Dimension: ElectricCurrent, Factor: 1e-3},
+
+	"V":  {Dimension: Voltage, Factor: 1},
+	"mV": {Dimension: Voltage, Factor: 1e-3},
+	"kV": {Dimension: Voltage, Factor: 1e3},
+
+	"Hz":  {Dimension: Frequency, Factor: 1},
+	"kHz": {Dimension: Frequency, Factor: 1e3},
+	"MHz": {Dimension: Frequency, Factor: 1e6},
+	"rpm": {Dimension: Frequency, Factor: 1.0 / 60},
+
+	"%":   {Dimension: Ratio, Factor: 1e-2},
+	"ppm": {Dimension: Ratio, Factor: 1e-6},
+	"ppb": {Dimension: Ratio, Factor: 1e-9},
+}
@@ -5,14 +5,48 @@
 
 package uom
 
+import (
+	"fmt"
+	"math"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
 type UnitsOfMeasureImpl struct {
 	Source string          `json:"source,omitempty" yaml:"Source,omitempty"`
 	Units  map[string]Unit `json:"units,omitempty" yaml:"Units,omitempty"`
 }
 
+// Unit defines a category of units.  Conversions defines, by unit, the conversion of the units to the SI unit of
+// the Dimension, which defaults to the category name, overriding the built-in definition of the unit if any.
 type Unit struct {
-	Source string   `json:"source,omitempty" yaml:"Source,omitempty"`
-	Values []string `json:"values,omitempty" yaml:"Values,omitempty"`
+	Source      string                `json:"source,omitempty" yaml:"Source,omitempty"`
+	Dimension   string                `json:"dimension,omitempty" yaml:"Dimension,omitempty"`
+	Values      []string              `json:"values,omitempty" yaml:"Values,omitempty"`
+	Conversions map[string]Conversion `json:"conversions,omitempty" yaml:"Conversions,omitempty"`
+}
+
+// Conversion defines the conversion of a unit to the SI unit of its dimension, a value in the unit being
+// value*Factor+Offset in the SI unit
+type Conversion struct {
+	Factor float64 `json:"factor" yaml:"Factor"`
+	Offset float64 `json:"offset,omitempty" yaml:"Offset,omitempty"`
+}
+
+// ValidateConversions checks that the configured conversions can convert the values both ways, i.e. that their factor
+// is a finite non-zero number and their offset a finite number
+func (u *UnitsOfMeasureImpl) ValidateConversions() errors.EdgeX {
+	for category, units := range u.Units {
+		for unit, c := range units.Conversions {
+			if c.Factor == 0 || math.IsNaN(c.Factor) || math.IsInf(c.Factor, 0) {
+				return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("conversion factor of unit '%s' of %s must be a finite non-zero number", unit, category), nil)
+			}
+			if math.IsNaN(c.Offset) || math.IsInf(c.Offset, 0) {
+				return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("conversion offset of unit '%s' of %s must be a finite number", unit, category), nil)
+			}
+		}
+	}
+	return nil
 }
 
 func (u *UnitsOfMeasureImpl) Validate(unit string) bool {
@@ -26,7 +60,51 @@ func (u *UnitsOfMeasureImpl) Validate(unit string) bool {
 				return true
 			}
 		}
+		if _, ok := units.Conversions[unit]; ok {
+			return true
+		}
 	}
 
 	return false
 }
+
+// Definition returns the definition of the unit, either configured or built-in
+func (u *UnitsOfMeasureImpl) Definition(unit string) (Definition, bool) {
+	for category, units := range u.Units {
+		if c, ok := units.Conversions[unit]; ok {
+			dimension := units.Dimension
+			if dimension == "" {
+				dimension = category
+			}
+			return Definition{Dimension: dimension, Factor: c.Factor, Offset: c.Offset}, true
+		}
+	}
+	d, ok := builtinUnits[unit]
+	return d, ok
+}
+
+// Dimension returns the dimension of the unit
+func (u *UnitsOfMeasureImpl) Dimension(unit string) (string, bool) {
+	d, ok := u.Definition(unit)
+	return d.Dimension, ok
+}
+
+// Convert converts the value from a unit to another unit of the same dimension
+func (u *UnitsOfMeasureImpl) Convert(value float64, from string, to string) (float64, errors.EdgeX) {
+	fromDefinition, ok := u.Definition(from)
+	if !ok {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unit '%s' is unknown", from), nil)
+	}
+	toDefinition, ok := u.Definition(to)
+	if !ok {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unit '%s' is unknown", to), nil)
+	}
+	if fromDefinition.Dimension != toDefinition.Dimension {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("unit '%s' of dimension %s can't be converted to unit '%s' of dimension %s", from, fromDefinition.Dimension, to, toDefinition.Dimension), nil)
+	}
+	if from == to {
+		return value, nil
+	}
+	return toDefinition.FromSI(fromDefinition.ToSI(value)), nil
+}
@@ -0,0 +1,104 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package uom
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvert(t *testing.T) {
+	u := &UnitsOfMeasureImpl{
+		Units: map[string]Unit{
+			"pressure": {
+				Values:      []string{"bar", "psi", "kPa"},
+				Conversions: map[string]Conversion{"mmH2O": {Factor: 9.80665}},
+			},
+			"temperature": {
+				Dimension:   Temperature,
+				Conversions: map[string]Conversion{"Ra": {Factor: fahrenheitFactor}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		value         float64
+		from          string
+		to            string
+		expected      float64
+		errorExpected bool
+	}{
+		{"bar to psi", 1, "bar", "psi", 14.503773773, false},
+		{"psi to kPa", 14.503773773, "psi", "kPa", 100, false},
+		{"C to F", 100, "C", "F", 212, false},
+		{"F to °C", -40, "F", "°C", -40, false},
+		{"C to K", 0, "°C", "K", 273.15, false},
+		{"configured unit", 1000, "mmH2O", "kPa", 9.80665, false},
+		{"configured unit overriding dimension", 491.67, "Ra", "C", 0, false},
+		{"same unit", 42, "kPa", "kPa", 42, false},
+		{"invalid - unknown unit", 1, "bar", "foo", 0, true},
+		{"invalid - different dimensions", 1, "bar", "C", 0, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := u.Convert(testCase.value, testCase.from, testCase.to)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.InDelta(t, testCase.expected, result, 1e-6)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	u := &UnitsOfMeasureImpl{
+		Units: map[string]Unit{
+			"pressure": {
+				Values:      []string{"bar"},
+				Conversions: map[string]Conversion{"mmH2O": {Factor: 9.80665}},
+			},
+		},
+	}
+
+	assert.True(t, u.Validate("bar"))
+	assert.True(t, u.Validate("mmH2O"))
+	assert.True(t, u.Validate(""))
+	assert.False(t, u.Validate("psi"))
+}
+
+func TestValidateConversions(t *testing.T) {
+	tests := []struct {
+		name          string
+		conversion    Conversion
+		errorExpected bool
+	}{
+		{"valid", Conversion{Factor: 9.80665}, false},
+		{"valid with offset", Conversion{Factor: fahrenheitFactor, Offset: fahrenheitOffset}, false},
+		{"invalid - zero factor", Conversion{}, true},
+		{"invalid - NaN factor", Conversion{Factor: math.NaN()}, true},
+		{"invalid - infinite factor", Conversion{Factor: math.Inf(1)}, true},
+		{"invalid - infinite offset", Conversion{Factor: 1, Offset: math.Inf(-1)}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			u := &UnitsOfMeasureImpl{
+				Units: map[string]Unit{
+					"pressure": {Conversions: map[string]Conversion{"mmH2O": testCase.conversion}},
+				},
+			}
+			err := u.ValidateConversions()
+			if testCase.errorExpected {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
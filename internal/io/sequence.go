@@ -0,0 +1,92 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package io
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/fxamacker/cbor/v2"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+// DtoSequenceReader splits a stream of encoded DTOs into the encoded DTOs, so that a large stream can be processed
+// one DTO at a time rather than being decoded at once
+type DtoSequenceReader interface {
+	// Next returns the next encoded DTO, or nil once the stream is exhausted.  The stream can't be read any further
+	// after an error.
+	Next() ([]byte, errors.EdgeX)
+	// ContentType returns the content type of each encoded DTO, to be decoded with the DtoReader of this content type
+	ContentType() string
+}
+
+// NewDtoSequenceReader returns the DtoSequenceReader of the stream content type, i.e. newline-delimited JSON or a
+// CBOR sequence (RFC 8742).  maxSize limits the size in bytes of each encoded DTO.
+func NewDtoSequenceReader(contentType string, reader io.Reader, maxSize int) (DtoSequenceReader, errors.EdgeX) {
+	switch strings.ToLower(contentType) {
+	case pkgCommon.ContentTypeNDJSON:
+		scanner := bufio.NewScanner(reader)
+		scanner.Buffer(make([]byte, 0, min(maxSize, bufio.MaxScanTokenSize)), maxSize)
+		return &ndjsonSequenceReader{scanner: scanner}, nil
+	case common.ContentTypeCBOR:
+		return &cborSequenceReader{decoder: cbor.NewDecoder(reader), maxSize: maxSize}, nil
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported content type %s, expecting %s or %s", contentType, pkgCommon.ContentTypeNDJSON, common.ContentTypeCBOR), nil)
+	}
+}
+
+type ndjsonSequenceReader struct {
+	scanner *bufio.Scanner
+}
+
+func (r *ndjsonSequenceReader) Next() ([]byte, errors.EdgeX) {
+	for r.scanner.Scan() {
+		line := bytes.TrimSpace(r.scanner.Bytes())
+		// blank lines, e.g. a trailing one, don't hold any DTO
+		if len(line) > 0 {
+			return bytes.Clone(line), nil
+		}
+	}
+	if err := r.scanner.Err(); err != nil {
+		if err == bufio.ErrTooLong {
+			return nil, errors.NewCommonEdgeX(errors.KindLimitExceeded, "JSON line size exceeds the limit", err)
+		}
+		return nil, errors.NewCommonEdgeX(errors.KindIOError, "JSON lines reading failed", err)
+	}
+	return nil, nil
+}
+
+func (r *ndjsonSequenceReader) ContentType() string {
+	return common.ContentTypeJSON
+}
+
+type cborSequenceReader struct {
+	decoder *cbor.Decoder
+	maxSize int
+}
+
+func (r *cborSequenceReader) Next() ([]byte, errors.EdgeX) {
+	var item cbor.RawMessage
+	err := r.decoder.Decode(&item)
+	if err == io.EOF {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "CBOR sequence decoding failed", err)
+	}
+	if len(item) > r.maxSize {
+		return nil, errors.NewCommonEdgeX(errors.KindLimitExceeded, fmt.Sprintf("CBOR item size %d exceeds the limit %d", len(item), r.maxSize), nil)
+	}
+	return item, nil
+}
+
+func (r *cborSequenceReader) ContentType() string {
+	return common.ContentTypeCBOR
+}
@@ -0,0 +1,94 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package io
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	dto "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+func readSequence(t *testing.T, reader DtoSequenceReader) []dto.AddEventRequest {
+	var requests []dto.AddEventRequest
+	for {
+		item, err := reader.Next()
+		require.NoError(t, err)
+		if item == nil {
+			return requests
+		}
+		var request dto.AddEventRequest
+		require.NoError(t, NewDtoReader(reader.ContentType()).Read(bytes.NewReader(item), &request))
+		requests = append(requests, request)
+	}
+}
+
+func TestNDJSONSequenceReader(t *testing.T) {
+	first := buildTestAddEvent()
+	second := buildTestAddEvent()
+	var stream bytes.Buffer
+	for _, request := range []dto.AddEventRequest{first, second} {
+		line, err := json.Marshal(request)
+		require.NoError(t, err)
+		stream.Write(line)
+		stream.WriteString("\n\n")
+	}
+
+	reader, err := NewDtoSequenceReader(pkgCommon.ContentTypeNDJSON, &stream, 1024*1024)
+	require.NoError(t, err)
+	assert.Equal(t, common.ContentTypeJSON, reader.ContentType())
+	requests := readSequence(t, reader)
+	require.Len(t, requests, 2)
+	assert.Equal(t, first.Event.Id, requests[0].Event.Id)
+	assert.Equal(t, second.Event.Id, requests[1].Event.Id)
+}
+
+func TestCBORSequenceReader(t *testing.T) {
+	first := buildTestAddEvent()
+	second := buildTestAddEvent()
+	var stream bytes.Buffer
+	encoder := cbor.NewEncoder(&stream)
+	require.NoError(t, encoder.Encode(first))
+	require.NoError(t, encoder.Encode(second))
+
+	reader, err := NewDtoSequenceReader(common.ContentTypeCBOR, &stream, 1024*1024)
+	require.NoError(t, err)
+	assert.Equal(t, common.ContentTypeCBOR, reader.ContentType())
+	requests := readSequence(t, reader)
+	require.Len(t, requests, 2)
+	assert.Equal(t, first.Event.Id, requests[0].Event.Id)
+	assert.Equal(t, second.Event.Id, requests[1].Event.Id)
+}
+
+func TestSequenceReaderSizeLimit(t *testing.T) {
+	line, err := json.Marshal(buildTestAddEvent())
+	require.NoError(t, err)
+	reader, err := NewDtoSequenceReader(pkgCommon.ContentTypeNDJSON, bytes.NewReader(line), 10)
+	require.NoError(t, err)
+	_, err = reader.Next()
+	require.Error(t, err)
+	assert.Equal(t, errors.KindLimitExceeded, errors.Kind(err))
+
+	item, err := cbor.Marshal(buildTestAddEvent())
+	require.NoError(t, err)
+	reader, err = NewDtoSequenceReader(common.ContentTypeCBOR, bytes.NewReader(item), 10)
+	require.NoError(t, err)
+	_, err = reader.Next()
+	require.Error(t, err)
+	assert.Equal(t, errors.KindLimitExceeded, errors.Kind(err))
+}
+
+func TestUnsupportedSequenceReader(t *testing.T) {
+	_, err := NewDtoSequenceReader(common.ContentTypeJSON, &bytes.Buffer{}, 10)
+	require.Error(t, err)
+}
@@ -8,8 +8,6 @@ package handlers
 
 import (
 	"context"
-	"os"
-	"strings"
 	"sync"
 	"time"
 
@@ -20,6 +18,7 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/pkg/interfaces"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	bootstrapModInterfaces "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/interfaces"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/secret"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/startup"
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v3/config"
@@ -48,37 +47,54 @@ func NewDatabase(httpServer httpServer, database bootstrapInterfaces.Database, d
 	}
 }
 
+// Constants related to the supported database types
+const (
+	redisDatabaseType  = "redisdb"
+	hybridDatabaseType = "hybrid"
+)
+
 // Return the dbClient interface
 func (d Database) newDBClient(
 	lc logger.LoggingClient,
-	credentials bootstrapConfig.Credentials) (interfaces.DBClient, error) {
+	credentials bootstrapConfig.Credentials,
+	timeSeriesCredentials bootstrapConfig.Credentials) (interfaces.DBClient, error) {
 	databaseInfo := d.database.GetDatabaseInfo()
+	config := db.Configuration{
+		Host:     databaseInfo.Host,
+		Port:     databaseInfo.Port,
+		Password: credentials.Password,
+		Timeout:  databaseInfo.Timeout,
+	}
 	switch databaseInfo.Type {
-	case "redisdb":
-		dbhybrid := os.Getenv("HYBRID")
-		if strings.ToUpper(dbhybrid) == "TRUE" {
-			return hybrid.NewHybridClient(
-				db.Configuration{
-					Host:     databaseInfo.Host,
-					Port:     databaseInfo.Port,
-					Password: credentials.Password,
-					Timeout:  databaseInfo.Timeout,
-				},
-				lc)
+	case redisDatabaseType:
+		return redis.NewClient(config, lc)
+	case hybridDatabaseType:
+		timeSeries, ok := d.database.(bootstrapInterfaces.TimeSeriesDatabase)
+		if !ok {
+			// the service doesn't keep any time series data, so all its data lives in Redis
+			return redis.NewClient(config, lc)
 		}
-		return redis.NewClient(
-			db.Configuration{
-				Host:     databaseInfo.Host,
-				Port:     databaseInfo.Port,
-				Password: credentials.Password,
-				Timeout:  databaseInfo.Timeout,
-			},
-			lc)
+		return hybrid.NewHybridClient(config, timeSeries.GetTimeSeriesInfo(), timeSeriesCredentials, lc)
 	default:
 		return nil, db.ErrUnsupportedDatabase
 	}
 }
 
+// secretNames returns the names of the secrets holding the database credentials and, for the hybrid database, the time
+// series store credentials
+func (d Database) secretNames() (string, string) {
+	databaseType := d.database.GetDatabaseInfo().Type
+	if databaseType != hybridDatabaseType {
+		return databaseType, ""
+	}
+	// the non time series data of the hybrid database is kept in Redis
+	timeSeries, ok := d.database.(bootstrapInterfaces.TimeSeriesDatabase)
+	if !ok {
+		return redisDatabaseType, ""
+	}
+	return redisDatabaseType, timeSeries.GetTimeSeriesInfo().SecretName
+}
+
 // BootstrapHandler fulfills the BootstrapHandler contract and initializes the database.
 func (d Database) BootstrapHandler(
 	ctx context.Context,
@@ -95,18 +111,17 @@ func (d Database) BootstrapHandler(
 		return false
 	}
 
-	var credentials bootstrapConfig.Credentials
+	var credentials, timeSeriesCredentials bootstrapConfig.Credentials
+	secretName, timeSeriesSecretName := d.secretNames()
 	dbCredsRetrieved := false
 	for startupTimer.HasNotElapsed() {
 		var err error
 
-		secrets, err := secretProvider.GetSecret(d.database.GetDatabaseInfo().Type)
+		credentials, err = getCredentials(secretProvider, secretName)
+		if err == nil && len(timeSeriesSecretName) > 0 {
+			timeSeriesCredentials, err = getCredentials(secretProvider, timeSeriesSecretName)
+		}
 		if err == nil {
-			credentials = bootstrapConfig.Credentials{
-				Username: secrets[secret.UsernameKey],
-				Password: secrets[secret.PasswordKey],
-			}
-
 			dbCredsRetrieved = true
 			break
 		}
@@ -128,7 +143,7 @@ func (d Database) BootstrapHandler(
 
 	for startupTimer.HasNotElapsed() {
 		var err error
-		dbClient, err = d.newDBClient(lc, credentials)
+		dbClient, err = d.newDBClient(lc, credentials, timeSeriesCredentials)
 		if err == nil {
 			break
 		}
@@ -166,3 +181,14 @@ func (d Database) BootstrapHandler(
 
 	return true
 }
+
+func getCredentials(secretProvider bootstrapModInterfaces.SecretProvider, secretName string) (bootstrapConfig.Credentials, error) {
+	secrets, err := secretProvider.GetSecret(secretName)
+	if err != nil {
+		return bootstrapConfig.Credentials{}, err
+	}
+	return bootstrapConfig.Credentials{
+		Username: secrets[secret.UsernameKey],
+		Password: secrets[secret.PasswordKey],
+	}, nil
+}
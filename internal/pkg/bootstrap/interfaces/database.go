@@ -14,10 +14,21 @@
 
 package interfaces
 
-import "github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/config"
+)
 
 // Database interface provides an abstraction for obtaining the database configuration information.
 type Database interface {
 	// GetDatabaseInfo returns a database information.
 	GetDatabaseInfo() config.Database
 }
+
+// TimeSeriesDatabase interface is implemented by the services able to keep their time series data in a dedicated store
+// when the database type is hybrid.
+type TimeSeriesDatabase interface {
+	// GetTimeSeriesInfo returns the time series store information.
+	GetTimeSeriesInfo() db.TimeSeriesInfo
+}
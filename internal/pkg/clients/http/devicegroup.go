@@ -0,0 +1,47 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/http/utils"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+// DeviceGroupClient queries the device groups through the REST API of core-metadata
+type DeviceGroupClient struct {
+	baseUrl               string
+	authInjector          interfaces.AuthenticationInjector
+	enableNameFieldEscape bool
+}
+
+// NewDeviceGroupClient creates an instance of DeviceGroupClient
+func NewDeviceGroupClient(baseUrl string, authInjector interfaces.AuthenticationInjector, enableNameFieldEscape bool) *DeviceGroupClient {
+	return &DeviceGroupClient{
+		baseUrl:               baseUrl,
+		authInjector:          authInjector,
+		enableNameFieldEscape: enableNameFieldEscape,
+	}
+}
+
+func (dgc DeviceGroupClient) DevicesByDeviceGroupName(ctx context.Context, name string, offset int, limit int) (res responses.MultiDevicesResponse, err errors.EdgeX) {
+	requestPath := common.NewPathBuilder().EnableNameFieldEscape(dgc.enableNameFieldEscape).
+		SetPath(pkgCommon.ApiDeviceGroupRoute).SetPath(common.Name).SetNameFieldPath(name).SetPath(common.Device).BuildPath()
+	requestParams := url.Values{}
+	requestParams.Set(common.Offset, strconv.Itoa(offset))
+	requestParams.Set(common.Limit, strconv.Itoa(limit))
+	err = utils.GetRequest(ctx, &res, dgc.baseUrl, requestPath, requestParams, dgc.authInjector)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
@@ -0,0 +1,43 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type emptyAuthenticationInjector struct{}
+
+var _ interfaces.AuthenticationInjector = emptyAuthenticationInjector{}
+
+func (emptyAuthenticationInjector) AddAuthenticationData(_ *http.Request) error {
+	return nil
+}
+
+func TestDevicesByDeviceGroupName(t *testing.T) {
+	expected := responses.NewMultiDevicesResponse("", "", http.StatusOK, 1, []dtos.Device{{Name: "device1"}})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v3/devicegroup/name/group%201/device", r.URL.EscapedPath())
+		assert.Equal(t, "0", r.URL.Query().Get("offset"))
+		assert.Equal(t, "-1", r.URL.Query().Get("limit"))
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(expected)
+	}))
+	defer ts.Close()
+
+	client := NewDeviceGroupClient(ts.URL, emptyAuthenticationInjector{}, true)
+	res, err := client.DevicesByDeviceGroupName(context.Background(), "group 1", 0, -1)
+	require.NoError(t, err)
+	assert.Equal(t, expected.Devices, res.Devices)
+}
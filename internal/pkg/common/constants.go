@@ -0,0 +1,141 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+)
+
+// Constants related to the service APIs which are not yet defined in go-mod-core-contracts
+const (
+	Aggregate = "aggregate"
+
+	Cursor = "cursor" //query string to specify the continuation token of a query paged by cursor
+
+	LabelSelector = "labelSelector" //query string to specify the Kubernetes style label selector, e.g. env=prod,line in (a,b)
+
+	Window    = "window"    //query string to specify the duration of each aggregation window, e.g. 1m
+	Functions = "functions" //query string to specify the comma-delimited aggregation functions to apply
+
+	Import  = "import"
+	Publish = "publish" //query string to specify whether the imported events are published to the message bus
+
+	Export = "export"
+	Format = "format" //query string to specify the format of the exported data, i.e. csv, ndjson or parquet
+
+	ExportFormatCSV     = "csv"
+	ExportFormatNDJSON  = "ndjson"
+	ExportFormatParquet = "parquet"
+
+	ContentTypeCSV     = "text/csv"
+	ContentTypeNDJSON  = "application/x-ndjson"
+	ContentTypeParquet = "application/vnd.apache.parquet"
+
+	Batch = "batch"
+	Audit = "audit"
+	Job   = "job"
+
+	DeviceTwin = "devicetwin"
+	Delta      = "delta"
+
+	DeviceGroup = "devicegroup"
+
+	Record = "record"
+
+	Revision = "revision"
+	Rollback = "rollback"
+
+	Convert = "convert"
+	Value   = "value" //query string to specify the value to convert
+	From    = "from"  //query string to specify the unit to convert the value from
+	To      = "to"    //query string to specify the unit to convert the value to
+
+	CanonicalUnit = "canonicalUnit" //optional property of a device resource to declare the unit its values are converted to
+)
+
+// Constants related to the routes of service APIs which are not yet defined in go-mod-core-contracts
+const (
+	ApiReadingAggregateRoute                                        = common.ApiReadingRoute + "/" + Aggregate
+	ApiReadingExportRoute                                           = common.ApiReadingRoute + "/" + Export
+	ApiEventImportRoute                                             = common.ApiEventRoute + "/" + Import
+	ApiCommandAuditRoute                                            = common.ApiBase + "/" + common.Command + "/" + Audit
+	ApiAllCommandAuditRoute                                         = ApiCommandAuditRoute + "/" + common.All
+	ApiCommandAuditByTimeRangeRoute                                 = ApiCommandAuditRoute + "/" + common.Start + "/{" + common.Start + "}/" + common.End + "/{" + common.End + "}"
+	ApiCommandAuditByDeviceNameRoute                                = ApiCommandAuditRoute + "/" + common.Device + "/" + common.Name + "/{" + common.Name + "}"
+	ApiCommandAuditByDeviceNameAndTimeRangeRoute                    = ApiCommandAuditByDeviceNameRoute + "/" + common.Start + "/{" + common.Start + "}/" + common.End + "/{" + common.End + "}"
+	ApiCommandJobRoute                                              = common.ApiBase + "/" + common.Command + "/" + Job
+	ApiAllCommandJobRoute                                           = ApiCommandJobRoute + "/" + common.All
+	ApiCommandJobByNameRoute                                        = ApiCommandJobRoute + "/" + common.Name + "/{" + common.Name + "}"
+	ApiIntervalActionRecordRoute                                    = common.ApiIntervalActionRoute + "/" + Record
+	ApiAllIntervalActionRecordRoute                                 = ApiIntervalActionRecordRoute + "/" + common.All
+	ApiIntervalActionRecordByTimeRangeRoute                         = ApiIntervalActionRecordRoute + "/" + common.Start + "/{" + common.Start + "}/" + common.End + "/{" + common.End + "}"
+	ApiIntervalActionRecordByActionNameRoute                        = ApiIntervalActionRecordRoute + "/" + common.Name + "/{" + common.Name + "}"
+	ApiIntervalActionRecordByActionNameAndTimeRangeRoute            = ApiIntervalActionRecordByActionNameRoute + "/" + common.Start + "/{" + common.Start + "}/" + common.End + "/{" + common.End + "}"
+	ApiDeviceTwinRoute                                              = common.ApiBase + "/" + DeviceTwin
+	ApiAllDeviceTwinRoute                                           = ApiDeviceTwinRoute + "/" + common.All
+	ApiDeviceTwinByDeviceNameRoute                                  = ApiDeviceTwinRoute + "/" + common.Device + "/" + common.Name + "/{" + common.Name + "}"
+	ApiDeviceTwinDeltaByDeviceNameRoute                             = ApiDeviceTwinByDeviceNameRoute + "/" + Delta
+	ApiDeviceGroupRoute                                             = common.ApiBase + "/" + DeviceGroup
+	ApiAllDeviceGroupRoute                                          = ApiDeviceGroupRoute + "/" + common.All
+	ApiDeviceGroupByNameRoute                                       = ApiDeviceGroupRoute + "/" + common.Name + "/{" + common.Name + "}"
+	ApiDeviceGroupDevicesByNameRoute                                = ApiDeviceGroupByNameRoute + "/" + common.Device
+	ApiDeviceProfileRevisionsByNameRoute                            = common.ApiDeviceProfileByNameRoute + "/" + Revision + "/" + common.All
+	ApiDeviceProfileRevisionByNameAndNumberRoute                    = common.ApiDeviceProfileByNameRoute + "/" + Revision + "/{" + Revision + "}"
+	ApiDeviceProfileRollbackByNameAndNumberRoute                    = ApiDeviceProfileRevisionByNameAndNumberRoute + "/" + Rollback
+	ApiUnitsOfMeasureConvertRoute                                   = common.ApiUnitsOfMeasureRoute + "/" + Convert
+	ApiUnitsOfMeasureConvertByProfileAndResourceRoute               = ApiUnitsOfMeasureConvertRoute + "/" + common.Profile + "/{" + common.ProfileName + "}/" + common.Resource + "/{" + common.ResourceName + "}"
+	ApiDeviceBatchCommandRoute                                      = common.ApiDeviceRoute + "/" + common.Command + "/" + Batch
+	ApiEventImportByServiceNameRoute                                = ApiEventImportRoute + "/{" + common.ServiceName + "}"
+	ApiReadingAggregateByDeviceNameAndResourceNameAndTimeRangeRoute = ApiReadingAggregateRoute + "/" + common.Device + "/" + common.Name + "/{" + common.Name + "}/" + common.ResourceName + "/{" + common.ResourceName + "}/" + common.Start + "/{" + common.Start + "}/" + common.End + "/{" + common.End + "}"
+)
+
+// Constants related to the echo routes of service APIs which are not yet defined in go-mod-core-contracts
+const (
+	ApiCommandAuditByTimeRangeEchoRoute                                 = ApiCommandAuditRoute + "/" + common.Start + "/:" + common.Start + "/" + common.End + "/:" + common.End
+	ApiCommandAuditByDeviceNameEchoRoute                                = ApiCommandAuditRoute + "/" + common.Device + "/" + common.Name + "/:" + common.Name
+	ApiCommandAuditByDeviceNameAndTimeRangeEchoRoute                    = ApiCommandAuditByDeviceNameEchoRoute + "/" + common.Start + "/:" + common.Start + "/" + common.End + "/:" + common.End
+	ApiCommandJobByNameEchoRoute                                        = ApiCommandJobRoute + "/" + common.Name + "/:" + common.Name
+	ApiIntervalActionRecordByTimeRangeEchoRoute                         = ApiIntervalActionRecordRoute + "/" + common.Start + "/:" + common.Start + "/" + common.End + "/:" + common.End
+	ApiIntervalActionRecordByActionNameEchoRoute                        = ApiIntervalActionRecordRoute + "/" + common.Name + "/:" + common.Name
+	ApiIntervalActionRecordByActionNameAndTimeRangeEchoRoute            = ApiIntervalActionRecordByActionNameEchoRoute + "/" + common.Start + "/:" + common.Start + "/" + common.End + "/:" + common.End
+	ApiDeviceTwinByDeviceNameEchoRoute                                  = ApiDeviceTwinRoute + "/" + common.Device + "/" + common.Name + "/:" + common.Name
+	ApiDeviceTwinDeltaByDeviceNameEchoRoute                             = ApiDeviceTwinByDeviceNameEchoRoute + "/" + Delta
+	ApiDeviceGroupByNameEchoRoute                                       = ApiDeviceGroupRoute + "/" + common.Name + "/:" + common.Name
+	ApiDeviceGroupDevicesByNameEchoRoute                                = ApiDeviceGroupByNameEchoRoute + "/" + common.Device
+	ApiDeviceProfileRevisionsByNameEchoRoute                            = common.ApiDeviceProfileByNameEchoRoute + "/" + Revision + "/" + common.All
+	ApiDeviceProfileRevisionByNameAndNumberEchoRoute                    = common.ApiDeviceProfileByNameEchoRoute + "/" + Revision + "/:" + Revision
+	ApiDeviceProfileRollbackByNameAndNumberEchoRoute                    = ApiDeviceProfileRevisionByNameAndNumberEchoRoute + "/" + Rollback
+	ApiUnitsOfMeasureConvertByProfileAndResourceEchoRoute               = ApiUnitsOfMeasureConvertRoute + "/" + common.Profile + "/:" + common.ProfileName + "/" + common.Resource + "/:" + common.ResourceName
+	ApiEventImportByServiceNameEchoRoute                                = ApiEventImportRoute + "/:" + common.ServiceName
+	ApiReadingAggregateByDeviceNameAndResourceNameAndTimeRangeEchoRoute = ApiReadingAggregateRoute + "/" + common.Device + "/" + common.Name + "/:" + common.Name + "/" + common.ResourceName + "/:" + common.ResourceName + "/" + common.Start + "/:" + common.Start + "/" + common.End + "/:" + common.End
+)
+
+// Constants related to the message bus topics of core-command which are not yet defined in go-mod-core-contracts
+const (
+	CoreCommandBatchRequestTopic = "core/commandbatch/request"
+
+	CommandBatchRequestTopicKey  = "CommandBatchRequestTopic"
+	CommandBatchResponseTopicKey = "CommandBatchResponseTopic"
+)
+
+// Constants related to the system events which are not yet defined in go-mod-core-contracts
+const (
+	DeviceTwinSystemEventType = "devicetwin"
+
+	SystemEventActionDiverge = "diverge"
+
+	// Tags of the device profile update system events identifying the recorded profile revision
+	SystemEventTagRevisionId = "revisionId"
+	SystemEventTagRevision   = "revision"
+)
+
+// Constants related to the address types of the interval actions which are not yet defined in go-mod-core-contracts
+const (
+	MESSAGEBUS    = "MESSAGEBUS"
+	DEVICECOMMAND = "DEVICECOMMAND"
+
+	// Methods of the device command interval actions
+	DeviceCommandGet = "GET"
+	DeviceCommandSet = "SET"
+)
@@ -0,0 +1,261 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package cron implements the cron expressions of the scheduled intervals, e.g. `0 6 * * MON-FRI` for every weekday at
+// 06:00.  An expression has the five standard fields minute, hour, day of month, month and day of week, optionally
+// preceded by a seconds field, or is one of the descriptors @yearly, @annually, @monthly, @weekly, @daily, @midnight
+// and @hourly.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// Schedule is a parsed cron expression.  Each field is the bit set of the values it matches.
+type Schedule struct {
+	second, minute, hour, dayOfMonth, month, dayOfWeek uint64
+}
+
+type bounds struct {
+	name     string
+	min, max uint
+	names    map[string]uint
+}
+
+var (
+	secondBounds     = bounds{name: "second", min: 0, max: 59}
+	minuteBounds     = bounds{name: "minute", min: 0, max: 59}
+	hourBounds       = bounds{name: "hour", min: 0, max: 23}
+	dayOfMonthBounds = bounds{name: "day of month", min: 1, max: 31}
+	monthBounds      = bounds{name: "month", min: 1, max: 12, names: map[string]uint{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// 7 is accepted as Sunday as well as 0
+	dayOfWeekBounds = bounds{name: "day of week", min: 0, max: 7, names: map[string]uint{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+// starBit marks a field given as `*` or `?`, which matters for the days, see Schedule.dayMatches
+const starBit = 1 << 63
+
+var descriptors = map[string]string{
+	"@yearly":   "0 0 0 1 1 *",
+	"@annually": "0 0 0 1 1 *",
+	"@monthly":  "0 0 0 1 * *",
+	"@weekly":   "0 0 0 * * 0",
+	"@daily":    "0 0 0 * * *",
+	"@midnight": "0 0 0 * * *",
+	"@hourly":   "0 0 * * * *",
+}
+
+// Parse parses the cron expression, whose fields are separated by spaces and are each a comma separated list of `*`,
+// `?`, values, ranges `a-b` and steps `*/n` or `a-b/n`.  Months and days of week may be given by their three letters
+// English names, e.g. JAN or MON.
+func Parse(spec string) (Schedule, errors.EdgeX) {
+	spec = strings.TrimSpace(spec)
+	if expanded, ok := descriptors[strings.ToLower(spec)]; ok {
+		spec = expanded
+	}
+	fields := strings.Fields(spec)
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return Schedule{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("cron expression '%s' must have 5 or 6 fields, found %d", spec, len(fields)), nil)
+	}
+
+	var s Schedule
+	var err errors.EdgeX
+	for i, f := range []struct {
+		bits   *uint64
+		bounds bounds
+	}{
+		{&s.second, secondBounds},
+		{&s.minute, minuteBounds},
+		{&s.hour, hourBounds},
+		{&s.dayOfMonth, dayOfMonthBounds},
+		{&s.month, monthBounds},
+		{&s.dayOfWeek, dayOfWeekBounds},
+	} {
+		*f.bits, err = parseField(fields[i], f.bounds)
+		if err != nil {
+			return Schedule{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid cron expression '%s'", spec), err)
+		}
+	}
+	// Sunday is 0 for time.Weekday
+	if s.dayOfWeek&(1<<7) != 0 {
+		s.dayOfWeek = s.dayOfWeek&^(1<<7) | 1
+	}
+	return s, nil
+}
+
+func parseField(field string, b bounds) (uint64, errors.EdgeX) {
+	var bits uint64
+	for _, item := range strings.Split(field, ",") {
+		itemBits, err := parseItem(item, b)
+		if err != nil {
+			return 0, err
+		}
+		bits |= itemBits
+	}
+	return bits, nil
+}
+
+func parseItem(item string, b bounds) (uint64, errors.EdgeX) {
+	rangePart, stepPart, hasStep := strings.Cut(item, "/")
+	start, end := b.min, b.max
+	var extra uint64
+	switch {
+	case rangePart == "*" || rangePart == "?":
+		if !hasStep {
+			extra = starBit
+		}
+	default:
+		first, last, isRange := strings.Cut(rangePart, "-")
+		var err errors.EdgeX
+		if start, err = parseValue(first, b); err != nil {
+			return 0, err
+		}
+		end = start
+		if isRange {
+			if end, err = parseValue(last, b); err != nil {
+				return 0, err
+			}
+		} else if hasStep {
+			// `a/n` means from a to the max with step n
+			end = b.max
+		}
+		if end < start {
+			return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s range '%s' ends before it starts", b.name, rangePart), nil)
+		}
+	}
+
+	step := uint(1)
+	if hasStep {
+		n, err := strconv.ParseUint(stepPart, 10, 8)
+		if err != nil || n == 0 {
+			return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s step '%s' is not a positive integer", b.name, stepPart), nil)
+		}
+		step = uint(n)
+	}
+
+	bits := extra
+	for v := start; v <= end; v += step {
+		bits |= 1 << v
+	}
+	return bits, nil
+}
+
+func parseValue(s string, b bounds) (uint, errors.EdgeX) {
+	if v, ok := b.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.ParseUint(s, 10, 8)
+	if err != nil || uint(v) < b.min || uint(v) > b.max {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s '%s' is not in the range %d-%d", b.name, s, b.min, b.max), nil)
+	}
+	return uint(v), nil
+}
+
+// maxSearchYears bounds the search of the next time, for expressions such as `0 0 30 2 *` which never match
+const maxSearchYears = 5
+
+// Next returns the first time strictly after t matching the schedule, in the location of t, or the zero time if the
+// schedule does not match within the next five years.  The times skipped by a daylight saving time transition do not
+// match, and the times repeated by a transition match only once.
+func (s Schedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	// Search from the next whole second
+	t = t.Add(time.Second - time.Duration(t.Nanosecond()))
+	yearLimit := t.Year() + maxSearchYears
+
+	added := false
+WRAP:
+	if t.Year() > yearLimit {
+		return time.Time{}
+	}
+
+	for 1<<uint(t.Month())&s.month == 0 {
+		if !added {
+			added = true
+			t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc)
+		}
+		t = t.AddDate(0, 1, 0)
+		if t.Month() == time.January {
+			goto WRAP
+		}
+	}
+
+	for !s.dayMatches(t) {
+		if !added {
+			added = true
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+		}
+		t = t.AddDate(0, 0, 1)
+		// A daylight saving time transition at midnight may shift the start of the day
+		if t.Hour() != 0 {
+			if t.Hour() > 12 {
+				t = t.Add(time.Duration(24-t.Hour()) * time.Hour)
+			} else {
+				t = t.Add(time.Duration(-t.Hour()) * time.Hour)
+			}
+		}
+		if t.Day() == 1 {
+			goto WRAP
+		}
+	}
+
+	for 1<<uint(t.Hour())&s.hour == 0 {
+		if !added {
+			added = true
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, loc)
+		}
+		t = t.Add(time.Hour)
+		if t.Hour() == 0 {
+			goto WRAP
+		}
+	}
+
+	for 1<<uint(t.Minute())&s.minute == 0 {
+		if !added {
+			added = true
+			t = t.Truncate(time.Minute)
+		}
+		t = t.Add(time.Minute)
+		if t.Minute() == 0 {
+			goto WRAP
+		}
+	}
+
+	for 1<<uint(t.Second())&s.second == 0 {
+		if !added {
+			added = true
+			t = t.Truncate(time.Second)
+		}
+		t = t.Add(time.Second)
+		if t.Second() == 0 {
+			goto WRAP
+		}
+	}
+
+	return t
+}
+
+// dayMatches checks whether the day of t matches the schedule.  As in the standard cron, a day matches either the day
+// of month or the day of week when both are restricted, and must match both otherwise.
+func (s Schedule) dayMatches(t time.Time) bool {
+	domMatch := 1<<uint(t.Day())&s.dayOfMonth != 0
+	dowMatch := 1<<uint(t.Weekday())&s.dayOfWeek != 0
+	if s.dayOfMonth&starBit != 0 || s.dayOfWeek&starBit != 0 {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
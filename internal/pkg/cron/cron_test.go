@@ -0,0 +1,80 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name          string
+		spec          string
+		errorExpected bool
+	}{
+		{"valid - five fields", "0 6 * * MON-FRI", false},
+		{"valid - six fields", "30 0 6 * * 1-5", false},
+		{"valid - lists and steps", "0,30 */2 1-15/3 JAN,jul ?", false},
+		{"valid - Sunday as 7", "0 0 * * 7", false},
+		{"valid - descriptor", "@daily", false},
+		{"invalid - too few fields", "0 6 * *", true},
+		{"invalid - too many fields", "0 0 6 * * * 2024", true},
+		{"invalid - out of range", "0 24 * * *", true},
+		{"invalid - unknown name", "0 6 * * MON-FRY", true},
+		{"invalid - reversed range", "0 6 * * 5-1", true},
+		{"invalid - zero step", "*/0 * * * *", true},
+		{"invalid - unknown descriptor", "@weekdays", true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := Parse(testCase.spec)
+			if testCase.errorExpected {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		spec     string
+		from     time.Time
+		expected time.Time
+	}{
+		{"weekday - same day", "0 6 * * MON-FRI", time.Date(2024, 3, 6, 5, 59, 59, 0, time.UTC), time.Date(2024, 3, 6, 6, 0, 0, 0, time.UTC)},
+		{"weekday - strictly after", "0 6 * * MON-FRI", time.Date(2024, 3, 6, 6, 0, 0, 0, time.UTC), time.Date(2024, 3, 7, 6, 0, 0, 0, time.UTC)},
+		{"weekday - over the weekend", "0 6 * * MON-FRI", time.Date(2024, 3, 8, 7, 0, 0, 0, time.UTC), time.Date(2024, 3, 11, 6, 0, 0, 0, time.UTC)},
+		{"seconds", "*/15 * * * * *", time.Date(2024, 3, 6, 5, 0, 16, 500, time.UTC), time.Date(2024, 3, 6, 5, 0, 30, 0, time.UTC)},
+		{"steps over the hour", "*/20 * * * *", time.Date(2024, 3, 6, 5, 45, 0, 0, time.UTC), time.Date(2024, 3, 6, 6, 0, 0, 0, time.UTC)},
+		{"over the year", "@yearly", time.Date(2024, 3, 6, 5, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"leap day", "0 0 29 2 *", time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"day of month or day of week", "0 0 13 * FRI", time.Date(2024, 3, 9, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 13, 0, 0, 0, 0, time.UTC)},
+		{"in location", "0 6 * * *", time.Date(2024, 3, 6, 12, 0, 0, 0, newYork), time.Date(2024, 3, 7, 6, 0, 0, 0, newYork)},
+		{"over daylight saving time", "0 6 * * *", time.Date(2024, 3, 9, 12, 0, 0, 0, newYork), time.Date(2024, 3, 10, 6, 0, 0, 0, newYork)},
+		{"skipped by daylight saving time", "30 2 * * *", time.Date(2024, 3, 9, 12, 0, 0, 0, newYork), time.Date(2024, 3, 11, 2, 30, 0, 0, newYork)},
+		{"never", "0 0 30 2 *", time.Date(2024, 3, 6, 5, 0, 0, 0, time.UTC), time.Time{}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			schedule, err := Parse(testCase.spec)
+			require.NoError(t, err)
+
+			next := schedule.Next(testCase.from)
+			assert.True(t, testCase.expected.Equal(next), "expected %v, got %v", testCase.expected, next)
+			if !next.IsZero() {
+				assert.Equal(t, testCase.from.Location(), next.Location())
+			}
+		})
+	}
+}
@@ -38,3 +38,40 @@ type Configuration struct {
 	Password     string
 	BatchSize    int
 }
+
+// TimeSeriesInfo defines the time series store (InfluxDB) which keeps the events and readings when the database type
+// is hybrid, the remaining data being kept in Redis.
+type TimeSeriesInfo struct {
+	Host     string
+	Port     int
+	Protocol string
+	Org      string
+	Bucket   string
+	// SecretName is the name of the secret holding the username and password used to connect to the time series store
+	SecretName string
+	// BatchSize is the number of points written at once, 0 uses the default of 5000
+	BatchSize uint
+	// FlushInterval is the maximum time the points are buffered before being written, empty uses the default of 1s
+	FlushInterval string
+	// WriteBufferSize is the maximum number of points waiting to be written, 0 uses the default of 10000
+	WriteBufferSize uint
+	// WriteTimeout is how long a write waits for room in a full write buffer before failing, empty uses the default of 5s
+	WriteTimeout string
+	// Retention is how long the data is kept in the bucket, empty or 0s keeps the data forever
+	Retention    string
+	Downsampling DownsamplingInfo
+}
+
+// DownsamplingInfo defines the rollup of the numeric readings into a long-term bucket, so that the raw data can be
+// kept for a short time only.
+type DownsamplingInfo struct {
+	Enabled bool
+	// Bucket receives the rollups, one point per window and aggregation function
+	Bucket string
+	// Retention is how long the rollups are kept, empty or 0s keeps them forever
+	Retention string
+	// Interval is both the rollup window and how often the rollup runs
+	Interval string
+	// Functions lists the aggregation functions computed for each window, e.g. mean, min, max
+	Functions []string
+}
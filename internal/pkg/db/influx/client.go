@@ -8,109 +8,171 @@ package influx
 import (
 	"context"
 	"fmt"
-	"os"
-	"strconv"
 	"time"
 
 	"crypto/sha512"
 	b64 "encoding/base64"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v3/config"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
 	"github.com/influxdata/influxdb-client-go/v2/api"
+	"github.com/influxdata/influxdb-client-go/v2/api/write"
+	"github.com/influxdata/influxdb-client-go/v2/domain"
+	gometrics "github.com/rcrowley/go-metrics"
 )
 
+// minRetention is the shortest retention InfluxDB accepts for a bucket
+const minRetention = time.Hour
+
 type Client struct {
 	influxClient influxdb2.Client
 	influxOrg    string
 	influxBucket string
-	batchSize    uint64
+	retention    time.Duration
+	writer       *pointWriter
 }
 
-func NewClient(config db.Configuration, lc logger.LoggingClient) (*Client, errors.EdgeX) {
-
-	influxUrl := os.Getenv("INFLUXDB_URL")
-	if influxUrl == "" {
-		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "INFLUXDB_URL must be set", nil)
+// NewClient connects to the InfluxDB server described by the time series configuration.  A server which isn't set up
+// yet is initialized with the configured organization, bucket and credentials.
+func NewClient(config db.TimeSeriesInfo, credentials bootstrapConfig.Credentials, lc logger.LoggingClient) (*Client, errors.EdgeX) {
+	if len(config.Host) == 0 || config.Port == 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "TimeSeries Host and Port must be set", nil)
 	}
-	influxOrg := os.Getenv("INFLUXDB_ORG")
-	if influxOrg == "" {
-		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "INFLUXDB_ORG must be set", nil)
+	if len(config.Org) == 0 || len(config.Bucket) == 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "TimeSeries Org and Bucket must be set", nil)
 	}
-	influxBucket := os.Getenv("INFLUXDB_BUCKET")
-	if influxBucket == "" {
-		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "INFLUXDB_BUCKET must be set", nil)
+	if len(credentials.Username) == 0 || len(credentials.Password) == 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("username and password must be set in the %s secret", config.SecretName), nil)
 	}
+	protocol := config.Protocol
+	if len(protocol) == 0 {
+		protocol = "http"
+	}
+	influxUrl := fmt.Sprintf("%s://%s:%d", protocol, config.Host, config.Port)
 
-	var user, pass string
-	if os.Getenv("EDGEX_SECURITY_SECRET_STORE") != "false" {
-		user = "admin"
-		pass = config.Password
-
-	} else {
-		user = "admin"
-		pass = "admin1234"
+	bufferSize := int(config.WriteBufferSize)
+	if bufferSize == 0 {
+		bufferSize = defaultWriteBufferSize
+	}
+	batchSize := int(config.BatchSize)
+	if batchSize == 0 {
+		batchSize = defaultBatchSize
+	}
+	flushInterval := defaultFlushInterval
+	if len(config.FlushInterval) > 0 {
+		var err error
+		flushInterval, err = time.ParseDuration(config.FlushInterval)
+		if err != nil || flushInterval <= 0 {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse TimeSeries FlushInterval", err)
+		}
+	}
+	retention, edgeXerr := ParseRetention("TimeSeries Retention", config.Retention)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
+
 	//Create influx token
 	h := sha512.New()
-	h.Write([]byte(user + pass))
+	h.Write([]byte(credentials.Username + credentials.Password))
 	bs := h.Sum(nil)
 	influxToken := b64.URLEncoding.EncodeToString(bs)
-	var influxClient influxdb2.Client
-	influxClient = influxdb2.NewClient(influxUrl, influxToken)
+	influxClient := influxdb2.NewClient(influxUrl, influxToken)
 	//See if organization exists
-	_, err := influxClient.OrganizationsAPI().FindOrganizationByName(context.Background(), influxOrg)
+	_, err := influxClient.OrganizationsAPI().FindOrganizationByName(context.Background(), config.Org)
 	if err != nil {
 		//Try to do the setup of Influx
-		influxClient = influxdb2.NewClient(influxUrl, "")
-		_, err = influxClient.SetupWithToken(context.Background(), user, pass, influxOrg, "Edgex", 0, influxToken)
+		lc.Infof("InfluxDB organization %s not found, setting up InfluxDB", config.Org)
+		setupClient := influxdb2.NewClient(influxUrl, "")
+		_, err = setupClient.SetupWithToken(context.Background(), credentials.Username, credentials.Password, config.Org, config.Bucket, int(retention.Hours()), influxToken)
+		setupClient.Close()
 		if err != nil {
-			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "INFLUXDB setup failed", nil)
+			influxClient.Close()
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "INFLUXDB setup failed", err)
 		}
 	}
-	var batch uint64
-	influxBatch := os.Getenv("INFLUXDB_BATCH")
-	if influxBatch == "" {
-		influxClient = influxdb2.NewClient(influxUrl, influxToken)
-	} else {
-		batch, _ = strconv.ParseUint(influxBatch, 10, 32)
-		influxClient = influxdb2.NewClientWithOptions(influxUrl, influxToken,
-			influxdb2.DefaultOptions().SetBatchSize(uint(batch)))
-	}
 	// validate client connection health
 	_, err = influxClient.Health(context.Background())
 	if err != nil {
-		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "INFLUXDB cannot be reached", nil)
+		influxClient.Close()
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "INFLUXDB cannot be reached", err)
+	}
+
+	writeTimeout := defaultWriteTimeout
+	if len(config.WriteTimeout) > 0 {
+		writeTimeout, err = time.ParseDuration(config.WriteTimeout)
+		if err != nil {
+			influxClient.Close()
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse TimeSeries WriteTimeout", err)
+		}
 	}
 
 	influx := &Client{influxClient: influxClient,
-		influxOrg: influxOrg, influxBucket: influxBucket, batchSize: batch}
+		influxOrg: config.Org, influxBucket: config.Bucket, retention: retention,
+		writer: newPointWriter(influxClient.WriteAPIBlocking(config.Org, config.Bucket), bufferSize, writeTimeout, batchSize, flushInterval, lc)}
 
 	return influx, nil
 }
 
-// CreateBucket creates a bucket in InfluxDB if it does not already exist.
-// An existing bucket will be reused
+// ParseRetention parses the retention of a bucket, empty or 0s keeping the data forever.  InfluxDB rejects the
+// retentions shorter than an hour, so they are rejected here already instead of when the bucket is created.
+func ParseRetention(name string, value string) (time.Duration, errors.EdgeX) {
+	if len(value) == 0 {
+		return 0, nil
+	}
+	retention, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse %s", name), err)
+	}
+	if retention < 0 || (retention > 0 && retention < minRetention) {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("%s %s must be 0s or at least %s", name, value, minRetention), nil)
+	}
+	return retention, nil
+}
+
+// CreateBucket creates the configured bucket in InfluxDB if it does not already exist.
+// An existing bucket will be reused, its retention being reconciled with the configured one
 func (c *Client) CreateBucket() errors.EdgeX {
+	return c.EnsureBucket(c.influxBucket, c.retention)
+}
+
+// EnsureBucket creates the bucket if it does not already exist, otherwise updates its retention when it differs
+// from the given one.  A zero retention keeps the data forever.
+func (c *Client) EnsureBucket(name string, retention time.Duration) errors.EdgeX {
 
 	ctx := context.Background()
 	bucketsAPI := c.influxClient.BucketsAPI()
+	rules := []domain.RetentionRule{{EverySeconds: int64(retention.Seconds())}}
 
 	//Find the bucket with ord id
-	domainOrg, _ := c.influxClient.OrganizationsAPI().FindOrganizationByName(ctx, c.influxOrg)
+	domainOrg, err := c.influxClient.OrganizationsAPI().FindOrganizationByName(ctx, c.influxOrg)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("organization %s cannot be retrieved", c.influxOrg), err)
+	}
 	bucketList, err := bucketsAPI.FindBucketsByOrgID(ctx, *domainOrg.Id)
 	if err != nil {
 		return errors.NewCommonEdgeX(errors.KindInvalidId, "Bucket list cannot be retrieved", err)
 	}
 	for _, bucket := range *bucketList {
-		if bucket.Name == c.influxBucket {
+		if bucket.Name != name {
+			continue
+		}
+		if bucketRetention(bucket) == rules[0].EverySeconds {
 			return nil
 		}
+		bucket.RetentionRules = rules
+		_, err = bucketsAPI.UpdateBucket(ctx, &bucket)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("Bucket %s retention update failed", name), err)
+		}
+		return nil
 	}
 	// create new empty bucket
-	_, err = c.influxClient.BucketsAPI().CreateBucketWithNameWithID(ctx, *domainOrg.Id, c.influxBucket)
+	_, err = bucketsAPI.CreateBucketWithNameWithID(ctx, *domainOrg.Id, name, rules...)
 
 	if err != nil {
 		return errors.NewCommonEdgeX(errors.KindInvalidId, "Bucket creation failed", err)
@@ -118,19 +180,93 @@ func (c *Client) CreateBucket() errors.EdgeX {
 	return nil
 }
 
-func (c *Client) WritePoint(measurement string, tags map[string]string, values map[string]interface{}, time time.Time) {
-	writeAPI := c.influxClient.WriteAPI(c.influxOrg, c.influxBucket)
-	p := influxdb2.NewPoint(measurement, tags, values, time)
-	writeAPI.WritePoint(p)
+// bucketRetention returns the retention of the bucket in seconds, 0 meaning the data is kept forever
+func bucketRetention(bucket domain.Bucket) int64 {
+	for _, rule := range bucket.RetentionRules {
+		if rule.Type == nil || *rule.Type == domain.RetentionRuleTypeExpire {
+			return rule.EverySeconds
+		}
+	}
+	return 0
+}
+
+// EnsureTask creates the task described by the Flux script, which must declare the task option with the given name.
+// An existing task with the same name is replaced when its script differs.
+func (c *Client) EnsureTask(name string, flux string) errors.EdgeX {
+	ctx := context.Background()
+	tasksAPI := c.influxClient.TasksAPI()
+	tasks, err := tasksAPI.FindTasks(ctx, &api.TaskFilter{Name: name, OrgName: c.influxOrg})
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("task %s cannot be retrieved", name), err)
+	}
+	for _, task := range tasks {
+		if task.Flux == flux {
+			return nil
+		}
+		err = tasksAPI.DeleteTaskWithID(ctx, task.Id)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("outdated task %s cannot be deleted", name), err)
+		}
+	}
+
+	domainOrg, err := c.influxClient.OrganizationsAPI().FindOrganizationByName(ctx, c.influxOrg)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("organization %s cannot be retrieved", c.influxOrg), err)
+	}
+	_, err = tasksAPI.CreateTaskByFlux(ctx, flux, *domainOrg.Id)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("task %s creation failed", name), err)
+	}
+	return nil
+}
+
+// RemoveTask deletes the tasks with the given name, if any
+func (c *Client) RemoveTask(name string) errors.EdgeX {
+	ctx := context.Background()
+	tasksAPI := c.influxClient.TasksAPI()
+	tasks, err := tasksAPI.FindTasks(ctx, &api.TaskFilter{Name: name, OrgName: c.influxOrg})
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("task %s cannot be retrieved", name), err)
+	}
+	for _, task := range tasks {
+		err = tasksAPI.DeleteTaskWithID(ctx, task.Id)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("task %s cannot be deleted", name), err)
+		}
+	}
+	return nil
+}
+
+// Org returns the name of the organization owning the buckets
+func (c *Client) Org() string {
+	return c.influxOrg
+}
+
+// Bucket returns the name of the bucket the data is written to and queried from
+func (c *Client) Bucket() string {
+	return c.influxBucket
+}
+
+// WritePoints queues the points to be written asynchronously and all or nothing.  An error is returned when the points
+// cannot be queued within the write timeout, the write failures happening afterward being logged and counted by
+// WriteErrors.
+func (c *Client) WritePoints(points ...*write.Point) errors.EdgeX {
+	return c.writer.write(points...)
+}
+
+// WriteErrors returns the counter of the points which failed to be written
+func (c *Client) WriteErrors() gometrics.Counter {
+	return c.writer.writeErrors
 }
 
-func (c *Client) QueryData(query string) (*api.QueryTableResult, error) {
+// QueryData runs the query against the configured bucket
+func (c *Client) QueryData(query *Query) (*api.QueryTableResult, error) {
 
 	//Add the bucket information in query
-	query = fmt.Sprintf(`from(bucket: "%v")%v`, c.influxBucket, query)
+	fluxQuery := From(c.influxBucket, query)
 	// Get query client
 	queryAPI := c.influxClient.QueryAPI(c.influxOrg)
-	result, err := queryAPI.Query(context.Background(), query)
+	result, err := queryAPI.Query(context.Background(), fluxQuery)
 	if err != nil {
 		return nil, err
 	}
@@ -144,6 +280,8 @@ func (c *Client) DeleteData(deletecondition string, starttime time.Time, endtime
 	return deleteAPI.DeleteWithName(context.Background(), c.influxOrg, c.influxBucket, starttime, endtime, deletecondition)
 }
 
+// CloseSession writes the pending points before closing the connection
 func (c *Client) CloseSession() {
+	c.writer.close()
 	c.influxClient.Close()
 }
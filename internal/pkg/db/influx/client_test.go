@@ -0,0 +1,40 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package influx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRetention(t *testing.T) {
+	tests := []struct {
+		name              string
+		value             string
+		expectedRetention time.Duration
+		errorExpected     bool
+	}{
+		{"empty keeps forever", "", 0, false},
+		{"zero keeps forever", "0s", 0, false},
+		{"minimum", "1h", time.Hour, false},
+		{"week", "168h", 168 * time.Hour, false},
+		{"shorter than the minimum", "30m", 0, true},
+		{"negative", "-1h", 0, true},
+		{"invalid", "1d", 0, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			retention, err := ParseRetention("TimeSeries Retention", testCase.value)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedRetention, retention)
+		})
+	}
+}
@@ -0,0 +1,258 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Typed Flux query builder. Every value coming from the caller is emitted as an escaped Flux literal, so device
+// names, resource names or ids can never change the structure of the generated query.
+
+package influx
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// fluxStringEscaper escapes the characters which have a special meaning inside a Flux string literal.
+// See https://docs.influxdata.com/flux/v0/spec/lexical-elements/#string-literals
+var fluxStringEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`"`, `\"`,
+	`$`, `\$`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\t", `\t`,
+)
+
+// deletePredicateEscaper escapes the characters which have a special meaning inside a delete predicate string value
+var deletePredicateEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`"`, `\"`,
+)
+
+// aggregateFunctions lists the Flux functions which are accepted by AggregateWindow
+var aggregateFunctions = map[string]bool{
+	"min": true, "max": true, "mean": true, "sum": true, "count": true, "first": true, "last": true,
+}
+
+// StringLiteral returns the value as a quoted and escaped Flux string literal
+func StringLiteral(value string) string {
+	return `"` + fluxStringEscaper.Replace(value) + `"`
+}
+
+// Condition is a boolean Flux predicate on the record r, used by Query.Filter
+type Condition struct {
+	expr string
+}
+
+func column(name string) string {
+	return "r[" + StringLiteral(name) + "]"
+}
+
+// Eq matches records whose column equals the value
+func Eq(columnName string, value string) Condition {
+	return Condition{expr: column(columnName) + " == " + StringLiteral(value)}
+}
+
+// Regex matches records whose column matches the regular expression.  The pattern is not escaped and therefore
+// must be a constant, never a value coming from a request.
+func Regex(columnName string, pattern string) Condition {
+	return Condition{expr: column(columnName) + " =~ /" + strings.ReplaceAll(pattern, "/", `\/`) + "/"}
+}
+
+// Gt matches records whose string column sorts after the value
+func Gt(columnName string, value string) Condition {
+	return Condition{expr: column(columnName) + " > " + StringLiteral(value)}
+}
+
+// Lt matches records whose string column sorts before the value
+func Lt(columnName string, value string) Condition {
+	return Condition{expr: column(columnName) + " < " + StringLiteral(value)}
+}
+
+// TimeAt matches records whose _time equals the Unix timestamp in nanoseconds
+func TimeAt(timestamp int64) Condition {
+	return Condition{expr: fmt.Sprintf("%s == time(v: %d)", column("_time"), timestamp)}
+}
+
+// TimeAfter matches records whose _time is after the Unix timestamp in nanoseconds
+func TimeAfter(timestamp int64) Condition {
+	return Condition{expr: fmt.Sprintf("%s > time(v: %d)", column("_time"), timestamp)}
+}
+
+// TimeBefore matches records whose _time is before the Unix timestamp in nanoseconds
+func TimeBefore(timestamp int64) Condition {
+	return Condition{expr: fmt.Sprintf("%s < time(v: %d)", column("_time"), timestamp)}
+}
+
+// And matches records satisfying all the conditions
+func And(conditions ...Condition) Condition {
+	return join(" and ", conditions)
+}
+
+// Or matches records satisfying any of the conditions
+func Or(conditions ...Condition) Condition {
+	return join(" or ", conditions)
+}
+
+// In matches records whose column equals any of the values
+func In(columnName string, values ...string) Condition {
+	conditions := make([]Condition, len(values))
+	for i, v := range values {
+		conditions[i] = Eq(columnName, v)
+	}
+	return Or(conditions...)
+}
+
+func join(operator string, conditions []Condition) Condition {
+	exprs := make([]string, len(conditions))
+	for i, c := range conditions {
+		exprs[i] = "(" + c.expr + ")"
+	}
+	return Condition{expr: strings.Join(exprs, operator)}
+}
+
+// Query builds the Flux pipeline which is piped after the bucket source, e.g. |>range(...) |>filter(...)
+type Query struct {
+	stages []string
+}
+
+// NewQuery creates an empty Flux pipeline
+func NewQuery() *Query {
+	return &Query{}
+}
+
+func (q *Query) pipe(stage string) *Query {
+	q.stages = append(q.stages, stage)
+	return q
+}
+
+// RangeAll restricts the query to every record stored up to now
+func (q *Query) RangeAll() *Query {
+	return q.pipe("range(start: 0, stop: now())")
+}
+
+// RangeSince restricts the query to the records stored within the last duration
+func (q *Query) RangeSince(duration time.Duration) *Query {
+	return q.pipe("range(start: -" + DurationLiteral(duration) + ")")
+}
+
+// Range restricts the query to the records within start (inclusive) and stop (exclusive), both in Unix nanoseconds
+func (q *Query) Range(start int64, stop int64) *Query {
+	return q.pipe(fmt.Sprintf("range(start: time(v: %d), stop: time(v: %d))", start, stop))
+}
+
+// Filter keeps the records satisfying all the conditions
+func (q *Query) Filter(conditions ...Condition) *Query {
+	return q.pipe("filter(fn: (r) => " + And(conditions...).expr + ")")
+}
+
+// Group merges all the tables into a single one
+func (q *Query) Group() *Query {
+	return q.pipe("group()")
+}
+
+// GroupBy regroups the records into one table per distinct value of the columns
+func (q *Query) GroupBy(columns ...string) *Query {
+	return q.pipe("group(columns: " + stringArray(columns) + ")")
+}
+
+// Sort sorts the records by the columns in ascending order
+func (q *Query) Sort(columns ...string) *Query {
+	return q.pipe("sort(columns: " + stringArray(columns) + ")")
+}
+
+// SortDescending sorts the records by the columns in descending order
+func (q *Query) SortDescending(columns ...string) *Query {
+	return q.pipe("sort(columns: " + stringArray(columns) + ", desc: true)")
+}
+
+// Pivot turns the values of columnKey into columns, grouping the records by rowKey
+func (q *Query) Pivot(rowKey []string, columnKey string, valueColumn string) *Query {
+	return q.pipe(fmt.Sprintf("pivot(rowKey: %s, columnKey: [%s], valueColumn: %s)", stringArray(rowKey), StringLiteral(columnKey), StringLiteral(valueColumn)))
+}
+
+// Limit returns at most n records, skipping the first offset records
+func (q *Query) Limit(n int, offset int) *Query {
+	return q.pipe(fmt.Sprintf("limit(n: %d, offset: %d)", n, offset))
+}
+
+// Distinct keeps the unique values of the _value column
+func (q *Query) Distinct() *Query {
+	return q.pipe("distinct()")
+}
+
+// Count counts the records
+func (q *Query) Count() *Query {
+	return q.pipe("count()")
+}
+
+// ValueToFloat replaces the _value column of every record with the given column converted to a float
+func (q *Query) ValueToFloat(columnName string) *Query {
+	return q.pipe("map(fn: (r) => ({r with _value: float(v: " + column(columnName) + ")}))")
+}
+
+// AggregateWindow downsamples the _value column into windows of every nanoseconds aligned to the Unix epoch.  The
+// _time of each result is set to the start of its window and empty windows are omitted.
+func (q *Query) AggregateWindow(every int64, function string) *Query {
+	if !aggregateFunctions[function] {
+		// keep the query well-formed; an unknown function is rejected by the Flux engine
+		function = StringLiteral(function)
+	}
+	return q.pipe(fmt.Sprintf(`aggregateWindow(every: %dns, fn: %s, createEmpty: false, timeSrc: "_start")`, every, function))
+}
+
+// Set assigns the value to the column of every record
+func (q *Query) Set(columnName string, value string) *Query {
+	return q.pipe(fmt.Sprintf("set(key: %s, value: %s)", StringLiteral(columnName), StringLiteral(value)))
+}
+
+// To writes the records to the bucket of the organization
+func (q *Query) To(bucket string, org string) *Query {
+	return q.pipe(fmt.Sprintf("to(bucket: %s, org: %s)", StringLiteral(bucket), StringLiteral(org)))
+}
+
+// String returns the Flux pipeline, each stage prefixed with the pipe-forward operator
+func (q *Query) String() string {
+	var sb strings.Builder
+	for _, stage := range q.stages {
+		sb.WriteString("\n|>")
+		sb.WriteString(stage)
+	}
+	return sb.String()
+}
+
+// From returns the Flux script running the query against the bucket
+func From(bucket string, query *Query) string {
+	return "from(bucket: " + StringLiteral(bucket) + ")" + query.String()
+}
+
+// TaskOption returns the Flux task option declaring a task with the name which runs at every interval
+func TaskOption(name string, every time.Duration) string {
+	return fmt.Sprintf("option task = {name: %s, every: %s}", StringLiteral(name), DurationLiteral(every))
+}
+
+// DurationLiteral returns the duration as a Flux duration literal
+func DurationLiteral(duration time.Duration) string {
+	if duration%time.Second == 0 {
+		return fmt.Sprintf("%ds", duration/time.Second)
+	}
+	return fmt.Sprintf("%dns", duration)
+}
+
+func stringArray(values []string) string {
+	literals := make([]string, len(values))
+	for i, v := range values {
+		literals[i] = StringLiteral(v)
+	}
+	return "[" + strings.Join(literals, ", ") + "]"
+}
+
+// DeletePredicate builds a delete predicate matching every tag with its value, e.g. devicename="a" AND resourcename="b".
+// The tags are matched in the given order, as pairs of tag name and value.
+func DeletePredicate(tagValuePairs ...string) string {
+	conditions := make([]string, 0, len(tagValuePairs)/2)
+	for i := 0; i+1 < len(tagValuePairs); i += 2 {
+		conditions = append(conditions, fmt.Sprintf(`%s="%s"`, tagValuePairs[i], deletePredicateEscaper.Replace(tagValuePairs[i+1])))
+	}
+	return strings.Join(conditions, " AND ")
+}
@@ -0,0 +1,85 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package influx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringLiteral(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{"plain", "device-1", `"device-1"`},
+		{"quote", `dev"ice`, `"dev\"ice"`},
+		{"backslash", `dev\ice`, `"dev\\ice"`},
+		{"interpolation", "dev${x}", `"dev\${x}"`},
+		{"control characters", "a\nb\tc\r", `"a\nb\tc\r"`},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, StringLiteral(testCase.value))
+		})
+	}
+}
+
+func TestQueryString(t *testing.T) {
+	query := NewQuery().
+		Range(1, 2).
+		Filter(Eq("devicename", "d"), In("resourcename", "r1", "r2")).
+		Group().
+		Sort("_time").
+		Pivot([]string{"_time", "counter"}, "_field", "_value").
+		Limit(10, 5)
+
+	expected := "\n|>range(start: time(v: 1), stop: time(v: 2))" +
+		"\n|>filter(fn: (r) => (r[\"devicename\"] == \"d\") and ((r[\"resourcename\"] == \"r1\") or (r[\"resourcename\"] == \"r2\")))" +
+		"\n|>group()" +
+		"\n|>sort(columns: [\"_time\"])" +
+		"\n|>pivot(rowKey: [\"_time\", \"counter\"], columnKey: [\"_field\"], valueColumn: \"_value\")" +
+		"\n|>limit(n: 10, offset: 5)"
+	assert.Equal(t, expected, query.String())
+}
+
+func TestQueryFilterInjection(t *testing.T) {
+	query := NewQuery().RangeAll().Filter(Eq("eventid", `x") or r["eventid"] != ("x`))
+
+	expected := "\n|>range(start: 0, stop: now())" +
+		"\n|>filter(fn: (r) => (r[\"eventid\"] == \"x\\\") or r[\\\"eventid\\\"] != (\\\"x\"))"
+	assert.Equal(t, expected, query.String())
+}
+
+func TestAggregateWindow(t *testing.T) {
+	assert.Equal(t, "\n|>aggregateWindow(every: 60000000000ns, fn: mean, createEmpty: false, timeSrc: \"_start\")",
+		NewQuery().AggregateWindow(60000000000, "mean").String())
+	assert.Equal(t, "\n|>aggregateWindow(every: 1ns, fn: \"drop()\", createEmpty: false, timeSrc: \"_start\")",
+		NewQuery().AggregateWindow(1, "drop()").String())
+}
+
+func TestDeletePredicate(t *testing.T) {
+	assert.Equal(t, `_measurement="profile" AND devicename="dev\"ice"`,
+		DeletePredicate("_measurement", "profile", "devicename", `dev"ice`))
+}
+
+func TestDurationLiteral(t *testing.T) {
+	assert.Equal(t, "3600s", DurationLiteral(time.Hour))
+	assert.Equal(t, "1500000000ns", DurationLiteral(1500*time.Millisecond))
+}
+
+func TestFrom(t *testing.T) {
+	assert.Equal(t, "from(bucket: \"edgex\")\n|>range(start: -60s)\n|>group(columns: [\"devicename\"])\n|>set(key: \"_field\", value: \"mean\")\n|>to(bucket: \"rollup\", org: \"edgex\")",
+		From("edgex", NewQuery().RangeSince(time.Minute).GroupBy("devicename").Set("_field", "mean").To("rollup", "edgex")))
+}
+
+func TestKeysetConditions(t *testing.T) {
+	assert.Equal(t, "\n|>filter(fn: (r) => ((r[\"_time\"] > time(v: 5)) or ((r[\"_time\"] == time(v: 5)) and (r[\"readingid\"] > \"id\"))))",
+		NewQuery().Filter(Or(TimeAfter(5), And(TimeAt(5), Gt("readingid", "id")))).String())
+	assert.Equal(t, "\n|>filter(fn: (r) => ((r[\"_time\"] < time(v: 5)) or ((r[\"_time\"] == time(v: 5)) and (r[\"eventid\"] < \"id\"))))",
+		NewQuery().Filter(Or(TimeBefore(5), And(TimeAt(5), Lt("eventid", "id")))).String())
+}
@@ -0,0 +1,203 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Asynchronous write path buffering the points in a bounded queue in front of a single long-lived write API
+
+package influx
+
+import (
+	"context"
+	goErrors "errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/influxdata/influxdb-client-go/v2/api"
+	influxHttp "github.com/influxdata/influxdb-client-go/v2/api/http"
+	"github.com/influxdata/influxdb-client-go/v2/api/write"
+	gometrics "github.com/rcrowley/go-metrics"
+)
+
+// Constants related to the defaults of the write path
+const (
+	defaultWriteBufferSize = 10000
+	defaultWriteTimeout    = 5 * time.Second
+	defaultBatchSize       = 5000
+	defaultFlushInterval   = time.Second
+	defaultMaxWriteRetries = 3
+	defaultRetryInterval   = time.Second
+)
+
+// pointWriter forwards the points queued by write to InfluxDB in batches, from a single background goroutine.
+// The points queued together, i.e. the points of an event, are never split across batches and each batch is written
+// in a single request, so that they are written all or nothing.
+// When the queue is full, write waits up to writeTimeout for room before rejecting the points, so that a slow or
+// unreachable InfluxDB pushes back on the callers instead of growing the memory usage.
+type pointWriter struct {
+	writeAPI      api.WriteAPIBlocking
+	bufferSize    int
+	writeTimeout  time.Duration
+	batchSize     int
+	flushInterval time.Duration
+	maxRetries    int
+	retryInterval time.Duration
+	// writeErrors counts the points which were rejected or failed to be written
+	writeErrors gometrics.Counter
+	lc          logger.LoggingClient
+
+	mutex  sync.Mutex
+	closed bool
+	// queued is the number of points queued or being written, which is bounded by bufferSize
+	queued int
+	points chan []*write.Point
+	// freed is closed, and replaced, whenever queued points are written, waking up the writes waiting for room
+	freed chan struct{}
+	done  chan struct{}
+}
+
+func newPointWriter(writeAPI api.WriteAPIBlocking, bufferSize int, writeTimeout time.Duration, batchSize int, flushInterval time.Duration, lc logger.LoggingClient) *pointWriter {
+	w := &pointWriter{
+		writeAPI:      writeAPI,
+		bufferSize:    bufferSize,
+		writeTimeout:  writeTimeout,
+		batchSize:     batchSize,
+		flushInterval: flushInterval,
+		maxRetries:    defaultMaxWriteRetries,
+		retryInterval: defaultRetryInterval,
+		writeErrors:   gometrics.NewCounter(),
+		lc:            lc,
+		// every queued write holds at least a point, so the queue never holds more writes than bufferSize
+		points: make(chan []*write.Point, bufferSize),
+		freed:  make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	go w.forward()
+	return w
+}
+
+// forward gathers the queued points into batches, which are written once they reach the batch size or at every flush
+// interval, until the writer is closed
+func (w *pointWriter) forward() {
+	defer close(w.done)
+	ticker := time.NewTicker(w.flushInterval)
+	defer ticker.Stop()
+	var batch []*write.Point
+	for {
+		select {
+		case points, ok := <-w.points:
+			if !ok {
+				w.writeBatch(batch)
+				return
+			}
+			if len(batch) > 0 && len(batch)+len(points) > w.batchSize {
+				w.writeBatch(batch)
+				batch = nil
+			}
+			batch = append(batch, points...)
+			if len(batch) >= w.batchSize {
+				w.writeBatch(batch)
+				batch = nil
+			}
+		case <-ticker.C:
+			w.writeBatch(batch)
+			batch = nil
+		}
+	}
+}
+
+// writeBatch writes the batch in a single request, retrying the requests which failed because InfluxDB was
+// unreachable or overloaded.  All the points of a batch which cannot be written are counted as write errors.
+func (w *pointWriter) writeBatch(batch []*write.Point) {
+	if len(batch) == 0 {
+		return
+	}
+	defer w.release(len(batch))
+
+	retryInterval := w.retryInterval
+	for attempt := 0; ; attempt++ {
+		err := w.writeAPI.WritePoint(context.Background(), batch...)
+		if err == nil {
+			return
+		}
+		if attempt == w.maxRetries || !isRetryableWriteError(err) {
+			w.writeErrors.Inc(int64(len(batch)))
+			w.lc.Errorf("failed to write %d points to InfluxDB: %v", len(batch), err)
+			return
+		}
+		w.lc.Warnf("failed to write %d points to InfluxDB, retrying in %s: %v", len(batch), retryInterval, err)
+		time.Sleep(retryInterval)
+		retryInterval *= 2
+	}
+}
+
+// isRetryableWriteError checks whether the write failed because InfluxDB was unreachable or overloaded, as opposed
+// to the points being rejected
+func isRetryableWriteError(err error) bool {
+	var httpErr *influxHttp.Error
+	if !goErrors.As(err, &httpErr) {
+		return false
+	}
+	return httpErr.StatusCode == 0 || httpErr.StatusCode >= http.StatusTooManyRequests
+}
+
+// release frees the room held by the points once they are written or discarded
+func (w *pointWriter) release(count int) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.queued -= count
+	close(w.freed)
+	w.freed = make(chan struct{})
+}
+
+// write queues the points to be written together, failing when no room is available for all of them in the queue
+// within the write timeout.  More points than the buffer size are only queued when nothing else is queued.
+func (w *pointWriter) write(points ...*write.Point) errors.EdgeX {
+	if len(points) == 0 {
+		return nil
+	}
+
+	var timeout <-chan time.Time
+	for {
+		w.mutex.Lock()
+		if w.closed {
+			w.mutex.Unlock()
+			return errors.NewCommonEdgeX(errors.KindServiceUnavailable, "InfluxDB write path is closed", nil)
+		}
+		if w.queued == 0 || w.queued+len(points) <= w.bufferSize {
+			w.queued += len(points)
+			w.points <- points
+			w.mutex.Unlock()
+			return nil
+		}
+		freed := w.freed
+		w.mutex.Unlock()
+
+		if timeout == nil {
+			timer := time.NewTimer(w.writeTimeout)
+			defer timer.Stop()
+			timeout = timer.C
+		}
+		select {
+		case <-freed:
+		case <-timeout:
+			w.writeErrors.Inc(int64(len(points)))
+			return errors.NewCommonEdgeX(errors.KindServiceUnavailable, "InfluxDB write buffer is full, the points are rejected", nil)
+		}
+	}
+}
+
+// close stops accepting points and writes all the pending points to InfluxDB
+func (w *pointWriter) close() {
+	w.mutex.Lock()
+	if w.closed {
+		w.mutex.Unlock()
+		return
+	}
+	w.closed = true
+	close(w.points)
+	w.mutex.Unlock()
+
+	<-w.done
+}
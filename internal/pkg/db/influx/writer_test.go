@@ -0,0 +1,170 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package influx
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	influxHttp "github.com/influxdata/influxdb-client-go/v2/api/http"
+	"github.com/influxdata/influxdb-client-go/v2/api/write"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeWriteAPI records the batches written in a single request, blocking every write until unblock is closed and
+// failing the writes while errs holds errors
+type fakeWriteAPI struct {
+	mutex   sync.Mutex
+	batches [][]*write.Point
+	errs    []error
+	unblock chan struct{}
+}
+
+func newFakeWriteAPI(blocked bool, errs ...error) *fakeWriteAPI {
+	f := &fakeWriteAPI{errs: errs, unblock: make(chan struct{})}
+	if !blocked {
+		close(f.unblock)
+	}
+	return f
+}
+
+func (f *fakeWriteAPI) WriteRecord(context.Context, ...string) error { return nil }
+
+func (f *fakeWriteAPI) WritePoint(_ context.Context, points ...*write.Point) error {
+	<-f.unblock
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if len(f.errs) > 0 {
+		err := f.errs[0]
+		f.errs = f.errs[1:]
+		return err
+	}
+	f.batches = append(f.batches, points)
+	return nil
+}
+
+func (f *fakeWriteAPI) EnableBatching() {}
+
+func (f *fakeWriteAPI) Flush(context.Context) error { return nil }
+
+func (f *fakeWriteAPI) writtenBatches() [][]*write.Point {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.batches
+}
+
+func testPoints(count int) []*write.Point {
+	points := make([]*write.Point, count)
+	for i := range points {
+		points[i] = write.NewPoint("profile", map[string]string{"devicename": "device"}, map[string]interface{}{"value": "1"}, time.Now())
+	}
+	return points
+}
+
+func newTestPointWriter(writeAPI *fakeWriteAPI, bufferSize int, writeTimeout time.Duration, batchSize int) *pointWriter {
+	writer := newPointWriter(writeAPI, bufferSize, writeTimeout, batchSize, time.Hour, logger.NewMockClient())
+	writer.retryInterval = time.Millisecond
+	return writer
+}
+
+func TestPointWriterFlushesOnClose(t *testing.T) {
+	writeAPI := newFakeWriteAPI(false)
+	writer := newTestPointWriter(writeAPI, 10, time.Second, 100)
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, writer.write(testPoints(1)...))
+	}
+	writer.close()
+
+	require.Len(t, writeAPI.writtenBatches(), 1)
+	assert.Len(t, writeAPI.writtenBatches()[0], 5)
+	assert.Error(t, writer.write(testPoints(1)...), "writing after close should fail")
+}
+
+func TestPointWriterKeepsEventsInOneBatch(t *testing.T) {
+	writeAPI := newFakeWriteAPI(false)
+	writer := newTestPointWriter(writeAPI, 100, time.Second, 5)
+
+	// the second event doesn't fit in the batch of the first one, the third one is larger than a batch
+	require.NoError(t, writer.write(testPoints(3)...))
+	require.NoError(t, writer.write(testPoints(3)...))
+	require.NoError(t, writer.write(testPoints(7)...))
+	writer.close()
+
+	batches := writeAPI.writtenBatches()
+	require.Len(t, batches, 3)
+	assert.Len(t, batches[0], 3)
+	assert.Len(t, batches[1], 3)
+	assert.Len(t, batches[2], 7)
+}
+
+func TestPointWriterRejectsWhenBufferFull(t *testing.T) {
+	writeAPI := newFakeWriteAPI(true)
+	writer := newTestPointWriter(writeAPI, 3, 10*time.Millisecond, 1)
+
+	// the first event is held by the blocked write, the second one fills the buffer
+	require.NoError(t, writer.write(testPoints(1)...))
+	require.NoError(t, writer.write(testPoints(2)...))
+
+	// none of the points of an event are queued when they don't all fit
+	err := writer.write(testPoints(2)...)
+	require.Error(t, err)
+	assert.Equal(t, int64(2), writer.writeErrors.Count())
+
+	close(writeAPI.unblock)
+	writer.close()
+	assert.Len(t, writeAPI.writtenBatches(), 2)
+}
+
+func TestPointWriterWaitsForRoom(t *testing.T) {
+	writeAPI := newFakeWriteAPI(true)
+	writer := newTestPointWriter(writeAPI, 2, time.Second, 1)
+
+	require.NoError(t, writer.write(testPoints(2)...))
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		close(writeAPI.unblock)
+	}()
+	require.NoError(t, writer.write(testPoints(2)...))
+	writer.close()
+
+	assert.Len(t, writeAPI.writtenBatches(), 2)
+	assert.Zero(t, writer.writeErrors.Count())
+}
+
+func TestPointWriterCountsFailedPoints(t *testing.T) {
+	unavailable := &influxHttp.Error{StatusCode: http.StatusServiceUnavailable, Message: "unavailable"}
+	badRequest := &influxHttp.Error{StatusCode: http.StatusBadRequest, Message: "unable to parse"}
+
+	tests := []struct {
+		name             string
+		errs             []error
+		expectedBatches  int
+		expectedFailures int64
+	}{
+		{"written", nil, 1, 0},
+		{"written after retries", []error{unavailable, unavailable}, 1, 0},
+		{"retries exhausted", []error{unavailable, unavailable, unavailable, unavailable}, 0, 3},
+		{"rejected points not retried", []error{badRequest}, 0, 3},
+		{"encoding error not retried", []error{errors.New("encoding failed")}, 0, 3},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			writeAPI := newFakeWriteAPI(false, testCase.errs...)
+			writer := newTestPointWriter(writeAPI, 10, time.Second, 10)
+
+			require.NoError(t, writer.write(testPoints(3)...))
+			writer.close()
+
+			assert.Len(t, writeAPI.writtenBatches(), testCase.expectedBatches)
+			assert.Equal(t, testCase.expectedFailures, writer.writeErrors.Count())
+		})
+	}
+}
@@ -0,0 +1,93 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+const (
+	BatchCommandMethodGet = "get"
+	BatchCommandMethodSet = "set"
+)
+
+// BatchCommandRequest defines the Request Content for issuing the same command to many devices at once.  The devices
+// are selected by exactly one of DeviceNames, ProfileName, Labels or DeviceGroupName.  QueryParams are passed to the
+// device services as the query parameters of each command, and Settings are the values written by a set command.
+type BatchCommandRequest struct {
+	DeviceNames     []string          `json:"deviceNames,omitempty"`
+	ProfileName     string            `json:"profileName,omitempty"`
+	Labels          []string          `json:"labels,omitempty"`
+	DeviceGroupName string            `json:"deviceGroupName,omitempty"`
+	CommandName     string            `json:"commandName"`
+	Method          string            `json:"method"`
+	QueryParams     map[string]string `json:"queryParams,omitempty"`
+	Settings        map[string]any    `json:"settings,omitempty"`
+}
+
+// Validate checks that the request selects its devices by a single selector and describes a valid command.  The
+// method is normalized to lower case.
+func (r *BatchCommandRequest) Validate() errors.EdgeX {
+	selectors := 0
+	if len(r.DeviceNames) > 0 {
+		selectors++
+	}
+	if r.ProfileName != "" {
+		selectors++
+	}
+	if len(r.Labels) > 0 {
+		selectors++
+	}
+	if r.DeviceGroupName != "" {
+		selectors++
+	}
+	if selectors != 1 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "exactly one of deviceNames, profileName, labels or deviceGroupName must be specified", nil)
+	}
+	for _, name := range r.DeviceNames {
+		if strings.TrimSpace(name) == "" {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "device name cannot be empty", nil)
+		}
+	}
+	if strings.TrimSpace(r.CommandName) == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "command name cannot be empty", nil)
+	}
+
+	r.Method = strings.ToLower(r.Method)
+	return validateCommandMethod(r.Method, r.QueryParams, r.Settings)
+}
+
+// validateCommandMethod checks that the method is get or set, the query parameters of a get command being valid and a
+// set command having settings
+func validateCommandMethod(method string, queryParams map[string]string, settings map[string]any) errors.EdgeX {
+	switch method {
+	case BatchCommandMethodGet:
+		for _, param := range []string{common.ReturnEvent, common.PushEvent} {
+			if value, ok := queryParams[param]; ok && value != common.ValueTrue && value != common.ValueFalse {
+				return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid query parameter, %s has to be '%s' or '%s'", param, common.ValueTrue, common.ValueFalse), nil)
+			}
+		}
+	case BatchCommandMethodSet:
+		if len(settings) == 0 {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "settings cannot be empty for a set command", nil)
+		}
+	default:
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unknown command method %s, only 'get' or 'set' is allowed", method), nil)
+	}
+	return nil
+}
+
+// BatchCommandResult reports the outcome of a batch command for one of its devices.  Event is the event read by a get
+// command, unless the device service was asked not to return it.
+type BatchCommandResult struct {
+	DeviceName string      `json:"deviceName"`
+	StatusCode int         `json:"statusCode"`
+	Message    string      `json:"message,omitempty"`
+	Event      *dtos.Event `json:"event,omitempty"`
+}
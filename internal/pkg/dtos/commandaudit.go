@@ -0,0 +1,45 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// CommandAuditRecord records a command issued to a device through core-command.  Created is the Unix timestamp in
+// milliseconds the command was received at, and Latency the milliseconds it took to complete.
+type CommandAuditRecord struct {
+	Id            string            `json:"id"`
+	Created       int64             `json:"created"`
+	DeviceName    string            `json:"deviceName"`
+	CommandName   string            `json:"commandName"`
+	Method        string            `json:"method"`
+	QueryParams   map[string]string `json:"queryParams,omitempty"`
+	Settings      map[string]any    `json:"settings,omitempty"`
+	CorrelationId string            `json:"correlationId,omitempty"`
+	Caller        string            `json:"caller,omitempty"`
+	Transport     string            `json:"transport"`
+	StatusCode    int               `json:"statusCode"`
+	Message       string            `json:"message,omitempty"`
+	Latency       int64             `json:"latency"`
+}
+
+// FromCommandAuditRecordModelToDTO transforms the CommandAuditRecord Model to the CommandAuditRecord DTO
+func FromCommandAuditRecordModelToDTO(r models.CommandAuditRecord) CommandAuditRecord {
+	return CommandAuditRecord{
+		Id:            r.Id,
+		Created:       r.Created,
+		DeviceName:    r.DeviceName,
+		CommandName:   r.CommandName,
+		Method:        r.Method,
+		QueryParams:   r.QueryParams,
+		Settings:      r.Settings,
+		CorrelationId: r.CorrelationId,
+		Caller:        r.Caller,
+		Transport:     r.Transport,
+		StatusCode:    r.StatusCode,
+		Message:       r.Message,
+		Latency:       r.Latency,
+	}
+}
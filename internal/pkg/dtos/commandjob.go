@@ -0,0 +1,114 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// CommandJob defines a device command issued by core-command at a later time, once or repeatedly.  Start and End are
+// Unix timestamps in milliseconds, the job starting immediately when Start is 0 and repeating until it is deleted when
+// End is 0.  A job without Interval is only issued once.  Status, NextRun, RunCount and LastResult are maintained by
+// core-command and ignored when the job is added.
+type CommandJob struct {
+	Created     int64             `json:"created,omitempty"`
+	Modified    int64             `json:"modified,omitempty"`
+	Id          string            `json:"id,omitempty" validate:"omitempty,uuid"`
+	Name        string            `json:"name" validate:"required,edgex-dto-none-empty-string"`
+	DeviceName  string            `json:"deviceName" validate:"required,edgex-dto-none-empty-string"`
+	CommandName string            `json:"commandName" validate:"required,edgex-dto-none-empty-string"`
+	Method      string            `json:"method" validate:"required"`
+	QueryParams map[string]string `json:"queryParams,omitempty"`
+	Settings    map[string]any    `json:"settings,omitempty"`
+	Start       int64             `json:"start,omitempty" validate:"gte=0"`
+	End         int64             `json:"end,omitempty" validate:"gte=0"`
+	Interval    string            `json:"interval,omitempty" validate:"omitempty,edgex-dto-duration"`
+	Status      string            `json:"status,omitempty"`
+	NextRun     int64             `json:"nextRun,omitempty"`
+	RunCount    int64             `json:"runCount"`
+	LastResult  *CommandJobResult `json:"lastResult,omitempty"`
+}
+
+// CommandJobResult defines the outcome of the last run of a command job.  EventId and Readings are the id of the event
+// read by a get command and the values of its readings by resource name, when the event is returned.
+type CommandJobResult struct {
+	Timestamp  int64             `json:"timestamp"`
+	StatusCode int               `json:"statusCode"`
+	Message    string            `json:"message,omitempty"`
+	EventId    string            `json:"eventId,omitempty"`
+	Readings   map[string]string `json:"readings,omitempty"`
+}
+
+// Validate satisfies the Validator interface.  The method is normalized to lower case.
+func (j *CommandJob) Validate() error {
+	if err := common.Validate(j); err != nil {
+		return err
+	}
+	j.Method = strings.ToLower(j.Method)
+	if err := validateCommandMethod(j.Method, j.QueryParams, j.Settings); err != nil {
+		return err
+	}
+	if j.Interval != "" {
+		if interval, _ := time.ParseDuration(j.Interval); interval <= 0 {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "interval must be a positive duration", nil)
+		}
+	}
+	if j.End != 0 && j.End < j.Start {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "end cannot be earlier than start", nil)
+	}
+	return nil
+}
+
+// ToCommandJobModel transforms the CommandJob DTO to the CommandJob model
+func ToCommandJobModel(dto CommandJob) models.CommandJob {
+	return models.CommandJob{
+		Id:          dto.Id,
+		Name:        dto.Name,
+		DeviceName:  dto.DeviceName,
+		CommandName: dto.CommandName,
+		Method:      dto.Method,
+		QueryParams: dto.QueryParams,
+		Settings:    dto.Settings,
+		Start:       dto.Start,
+		End:         dto.End,
+		Interval:    dto.Interval,
+	}
+}
+
+// FromCommandJobModelToDTO transforms the CommandJob model to the CommandJob DTO
+func FromCommandJobModelToDTO(job models.CommandJob) CommandJob {
+	dto := CommandJob{
+		Created:     job.Created,
+		Modified:    job.Modified,
+		Id:          job.Id,
+		Name:        job.Name,
+		DeviceName:  job.DeviceName,
+		CommandName: job.CommandName,
+		Method:      job.Method,
+		QueryParams: job.QueryParams,
+		Settings:    job.Settings,
+		Start:       job.Start,
+		End:         job.End,
+		Interval:    job.Interval,
+		Status:      job.Status,
+		NextRun:     job.NextRun,
+		RunCount:    job.RunCount,
+	}
+	if job.LastResult != nil {
+		dto.LastResult = &CommandJobResult{
+			Timestamp:  job.LastResult.Timestamp,
+			StatusCode: job.LastResult.StatusCode,
+			Message:    job.LastResult.Message,
+			EventId:    job.LastResult.EventId,
+			Readings:   job.LastResult.Readings,
+		}
+	}
+	return dto
+}
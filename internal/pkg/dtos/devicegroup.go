@@ -0,0 +1,113 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/labelselector"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// DeviceGroup defines a named group of devices.  The members of the group are the devices listed by name in
+// DeviceNames and the devices whose labels satisfy the Kubernetes style LabelSelector, e.g. `env=prod,line in (a,b)`.
+type DeviceGroup struct {
+	Created       int64    `json:"created,omitempty"`
+	Modified      int64    `json:"modified,omitempty"`
+	Id            string   `json:"id,omitempty" validate:"omitempty,uuid"`
+	Name          string   `json:"name" validate:"required,edgex-dto-none-empty-string"`
+	Description   string   `json:"description,omitempty"`
+	DeviceNames   []string `json:"deviceNames,omitempty" validate:"dive,edgex-dto-none-empty-string"`
+	LabelSelector string   `json:"labelSelector,omitempty"`
+}
+
+// UpdateDeviceGroup defines the fields of a device group to update, the device group being identified by Id or Name
+type UpdateDeviceGroup struct {
+	Id            *string  `json:"id" validate:"required_without=Name,edgex-dto-uuid"`
+	Name          *string  `json:"name" validate:"required_without=Id,edgex-dto-none-empty-string"`
+	Description   *string  `json:"description"`
+	DeviceNames   []string `json:"deviceNames" validate:"dive,edgex-dto-none-empty-string"`
+	LabelSelector *string  `json:"labelSelector"`
+}
+
+// Validate satisfies the Validator interface
+func (g DeviceGroup) Validate() error {
+	if err := common.Validate(g); err != nil {
+		return err
+	}
+	return validateDeviceGroupMembers(g.DeviceNames, g.LabelSelector)
+}
+
+// Validate satisfies the Validator interface
+func (g UpdateDeviceGroup) Validate() error {
+	if err := common.Validate(g); err != nil {
+		return err
+	}
+	if g.LabelSelector != nil && *g.LabelSelector != "" {
+		if _, err := labelselector.Parse(*g.LabelSelector); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateDeviceGroupMembers validates that the group has members and that its label selector is valid
+func validateDeviceGroupMembers(deviceNames []string, selector string) error {
+	if len(deviceNames) == 0 && selector == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "either deviceNames or labelSelector is required", nil)
+	}
+	if selector != "" {
+		if _, err := labelselector.Parse(selector); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ToDeviceGroupModel transforms the DeviceGroup DTO to the DeviceGroup model
+func ToDeviceGroupModel(dto DeviceGroup) models.DeviceGroup {
+	return models.DeviceGroup{
+		Id:            dto.Id,
+		Name:          dto.Name,
+		Description:   dto.Description,
+		DeviceNames:   dto.DeviceNames,
+		LabelSelector: dto.LabelSelector,
+	}
+}
+
+// FromDeviceGroupModelToDTO transforms the DeviceGroup model to the DeviceGroup DTO
+func FromDeviceGroupModelToDTO(g models.DeviceGroup) DeviceGroup {
+	return DeviceGroup{
+		Created:       g.Created,
+		Modified:      g.Modified,
+		Id:            g.Id,
+		Name:          g.Name,
+		Description:   g.Description,
+		DeviceNames:   g.DeviceNames,
+		LabelSelector: g.LabelSelector,
+	}
+}
+
+// ReplaceDeviceGroupModelFieldsWithDTO replaces the fields of the DeviceGroup model with the fields set in the
+// UpdateDeviceGroup DTO
+func ReplaceDeviceGroupModelFieldsWithDTO(g *models.DeviceGroup, patch UpdateDeviceGroup) {
+	if patch.Description != nil {
+		g.Description = *patch.Description
+	}
+	if patch.DeviceNames != nil {
+		g.DeviceNames = patch.DeviceNames
+	}
+	if patch.LabelSelector != nil {
+		g.LabelSelector = *patch.LabelSelector
+	}
+}
+
+// ValidateDeviceGroupMembers validates the members of the device group once patched
+func ValidateDeviceGroupMembers(g models.DeviceGroup) errors.EdgeX {
+	if err := validateDeviceGroupMembers(g.DeviceNames, g.LabelSelector); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid device group", err)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// DeviceProfileRevision defines an immutable revision of a device profile, i.e. the profile as it was added, updated or
+// rolled back to by the author along with the changes from the previous revision
+type DeviceProfileRevision struct {
+	Created      int64                 `json:"created,omitempty"`
+	Id           string                `json:"id"`
+	ProfileName  string                `json:"profileName"`
+	Revision     int64                 `json:"revision"`
+	Author       string                `json:"author,omitempty"`
+	Action       string                `json:"action"`
+	RolledBackTo int64                 `json:"rolledBackTo,omitempty"`
+	Profile      dtos.DeviceProfile    `json:"profile"`
+	Changes      []DeviceProfileChange `json:"changes,omitempty"`
+}
+
+// DeviceProfileChange defines the change of a value of a device profile, identified by its JSON path in the profile
+type DeviceProfileChange struct {
+	Path     string `json:"path"`
+	Previous any    `json:"previous,omitempty"`
+	Current  any    `json:"current,omitempty"`
+}
+
+// FromDeviceProfileRevisionModelToDTO transforms the DeviceProfileRevision model to the DeviceProfileRevision DTO
+func FromDeviceProfileRevisionModelToDTO(r models.DeviceProfileRevision) DeviceProfileRevision {
+	var changes []DeviceProfileChange
+	for _, c := range r.Changes {
+		changes = append(changes, DeviceProfileChange(c))
+	}
+	return DeviceProfileRevision{
+		Created:      r.Created,
+		Id:           r.Id,
+		ProfileName:  r.ProfileName,
+		Revision:     r.Revision,
+		Author:       r.Author,
+		Action:       r.Action,
+		RolledBackTo: r.RolledBackTo,
+		Profile:      dtos.FromDeviceProfileModelToDTO(r.Profile),
+		Changes:      changes,
+	}
+}
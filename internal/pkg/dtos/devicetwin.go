@@ -0,0 +1,73 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// DeviceTwin defines the desired and the reported state of a device, by device resource name.  The reported values are
+// kept by core-metadata from the events of the device.
+type DeviceTwin struct {
+	Created    int64                   `json:"created,omitempty"`
+	Modified   int64                   `json:"modified,omitempty"`
+	Id         string                  `json:"id,omitempty"`
+	DeviceName string                  `json:"deviceName"`
+	Properties map[string]TwinProperty `json:"properties"`
+}
+
+// TwinProperty defines the desired and the reported value of a device resource.  DesiredModified and ReportedOrigin are
+// the Unix timestamps in milliseconds the desired value was set at and the reported value was read at, a value being
+// unset when its timestamp is omitted.
+type TwinProperty struct {
+	Desired         string `json:"desired,omitempty"`
+	DesiredModified int64  `json:"desiredModified,omitempty"`
+	Reported        string `json:"reported,omitempty"`
+	ReportedOrigin  int64  `json:"reportedOrigin,omitempty"`
+}
+
+// DeviceTwinDelta defines the properties of a device twin whose desired value has not been reported, i.e. has not been
+// reported yet or differs from the reported value
+type DeviceTwinDelta struct {
+	DeviceName  string                  `json:"deviceName"`
+	ProfileName string                  `json:"profileName"`
+	Properties  map[string]TwinProperty `json:"properties"`
+}
+
+// FromDeviceTwinModelToDTO transforms the DeviceTwin model to the DeviceTwin DTO
+func FromDeviceTwinModelToDTO(twin models.DeviceTwin) DeviceTwin {
+	properties := make(map[string]TwinProperty, len(twin.Properties))
+	for name, p := range twin.Properties {
+		properties[name] = fromTwinPropertyModelToDTO(p)
+	}
+	return DeviceTwin{
+		Created:    twin.Created,
+		Modified:   twin.Modified,
+		Id:         twin.Id,
+		DeviceName: twin.DeviceName,
+		Properties: properties,
+	}
+}
+
+// FromDeviceTwinModelToDeltaDTO transforms the diverged properties of the DeviceTwin model to the DeviceTwinDelta DTO
+func FromDeviceTwinModelToDeltaDTO(twin models.DeviceTwin, profileName string) DeviceTwinDelta {
+	delta := DeviceTwinDelta{
+		DeviceName:  twin.DeviceName,
+		ProfileName: profileName,
+		Properties:  make(map[string]TwinProperty),
+	}
+	for _, name := range twin.DivergedProperties() {
+		delta.Properties[name] = fromTwinPropertyModelToDTO(twin.Properties[name])
+	}
+	return delta
+}
+
+func fromTwinPropertyModelToDTO(p models.TwinProperty) TwinProperty {
+	return TwinProperty{
+		Desired:         p.Desired,
+		DesiredModified: p.DesiredModified,
+		Reported:        p.Reported,
+		ReportedOrigin:  p.ReportedOrigin,
+	}
+}
@@ -0,0 +1,13 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+// EventImportFailure reports an event of an import stream which could not be imported.  Index is the position of the
+// event in the stream, starting at 0.
+type EventImportFailure struct {
+	Index      int    `json:"index"`
+	EventId    string `json:"eventId,omitempty"`
+	StatusCode int    `json:"statusCode"`
+	Message    string `json:"message"`
+}
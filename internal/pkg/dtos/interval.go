@@ -0,0 +1,157 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	contractsModels "github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/cron"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// Interval extends the Interval DTO of go-mod-core-contracts with the cron and run-once schedules, with the timezone of
+// the schedule and with the policy applied to the missed runs.  NextTime, in milliseconds since the epoch, is the time the scheduler will next run the
+// interval, and is only set in the responses when the interval is scheduled.
+type Interval struct {
+	dtos.DBTimestamp `json:",inline"`
+	Id               string `json:"id,omitempty" validate:"omitempty,uuid"`
+	Name             string `json:"name" validate:"edgex-dto-none-empty-string"`
+	Start            string `json:"start,omitempty" validate:"omitempty,edgex-dto-interval-datetime"`
+	End              string `json:"end,omitempty" validate:"omitempty,edgex-dto-interval-datetime"`
+	Interval         string `json:"interval,omitempty" validate:"omitempty,edgex-dto-duration"`
+	Cron             string `json:"cron,omitempty"`
+	RunOnce          bool   `json:"runOnce,omitempty"`
+	Timezone         string `json:"timezone,omitempty"`
+	MisfirePolicy    string `json:"misfirePolicy,omitempty" validate:"omitempty,oneof='SKIP' 'FIRE_ONCE' 'CATCH_UP_ALL'"`
+	NextTime         int64  `json:"nextTime,omitempty"`
+}
+
+// UpdateInterval defines the fields of an interval to update, the interval being identified by Id or Name
+type UpdateInterval struct {
+	Id            *string `json:"id" validate:"required_without=Name,edgex-dto-uuid"`
+	Name          *string `json:"name" validate:"required_without=Id,edgex-dto-none-empty-string"`
+	Start         *string `json:"start" validate:"omitempty,edgex-dto-interval-datetime"`
+	End           *string `json:"end" validate:"omitempty,edgex-dto-interval-datetime"`
+	Interval      *string `json:"interval" validate:"omitempty,edgex-dto-duration"`
+	Cron          *string `json:"cron"`
+	RunOnce       *bool   `json:"runOnce"`
+	Timezone      *string `json:"timezone"`
+	MisfirePolicy *string `json:"misfirePolicy" validate:"omitempty,oneof='SKIP' 'FIRE_ONCE' 'CATCH_UP_ALL'"`
+}
+
+// Validate satisfies the Validator interface
+func (i Interval) Validate() error {
+	if err := common.Validate(i); err != nil {
+		return err
+	}
+	return ValidateIntervalSchedule(ToIntervalModel(i))
+}
+
+// Validate satisfies the Validator interface
+func (i UpdateInterval) Validate() error {
+	if err := common.Validate(i); err != nil {
+		return err
+	}
+	if i.Cron != nil && *i.Cron != "" {
+		if _, err := cron.Parse(*i.Cron); err != nil {
+			return err
+		}
+	}
+	if i.Timezone != nil {
+		if _, err := time.LoadLocation(*i.Timezone); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unknown timezone '%s'", *i.Timezone), err)
+		}
+	}
+	return nil
+}
+
+// ValidateIntervalSchedule validates that the interval is scheduled by exactly one of a frequency, a cron expression
+// and a single run, and that its cron expression and timezone are valid
+func ValidateIntervalSchedule(i models.Interval) errors.EdgeX {
+	schedules := 0
+	for _, set := range []bool{i.Interval.Interval != "", i.Cron != "", i.RunOnce} {
+		if set {
+			schedules++
+		}
+	}
+	if schedules != 1 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "exactly one of interval, cron and runOnce is required", nil)
+	}
+	if i.Cron != "" {
+		if _, err := cron.Parse(i.Cron); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	if i.RunOnce && i.Start == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "start is required to run the interval once", nil)
+	}
+	if _, err := time.LoadLocation(i.Timezone); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unknown timezone '%s'", i.Timezone), err)
+	}
+	return nil
+}
+
+// ToIntervalModel transforms the Interval DTO to the Interval model
+func ToIntervalModel(dto Interval) models.Interval {
+	return models.Interval{
+		Interval: contractsModels.Interval{
+			Id:       dto.Id,
+			Name:     dto.Name,
+			Start:    dto.Start,
+			End:      dto.End,
+			Interval: dto.Interval,
+		},
+		Cron:          dto.Cron,
+		RunOnce:       dto.RunOnce,
+		Timezone:      dto.Timezone,
+		MisfirePolicy: dto.MisfirePolicy,
+	}
+}
+
+// FromIntervalModelToDTO transforms the Interval model to the Interval DTO
+func FromIntervalModelToDTO(model models.Interval) Interval {
+	return Interval{
+		DBTimestamp:   dtos.DBTimestamp(model.DBTimestamp),
+		Id:            model.Id,
+		Name:          model.Name,
+		Start:         model.Start,
+		End:           model.End,
+		Interval:      model.Interval.Interval,
+		Cron:          model.Cron,
+		RunOnce:       model.RunOnce,
+		Timezone:      model.Timezone,
+		MisfirePolicy: model.MisfirePolicy,
+	}
+}
+
+// ReplaceIntervalModelFieldsWithDTO replace existing Interval's fields with DTO patch
+func ReplaceIntervalModelFieldsWithDTO(interval *models.Interval, patch UpdateInterval) {
+	if patch.Start != nil {
+		interval.Start = *patch.Start
+	}
+	if patch.End != nil {
+		interval.End = *patch.End
+	}
+	if patch.Interval != nil {
+		interval.Interval.Interval = *patch.Interval
+	}
+	if patch.Cron != nil {
+		interval.Cron = *patch.Cron
+	}
+	if patch.RunOnce != nil {
+		interval.RunOnce = *patch.RunOnce
+	}
+	if patch.Timezone != nil {
+		interval.Timezone = *patch.Timezone
+	}
+	if patch.MisfirePolicy != nil {
+		interval.MisfirePolicy = *patch.MisfirePolicy
+	}
+}
@@ -0,0 +1,275 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	contractsModels "github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// IntervalAction is the IntervalAction DTO of go-mod-core-contracts whose Address may also be a message bus or a device
+// command address, and whose execution may be retried with an exponential backoff.  ExpectedStatusCodes are the status
+// codes of a successful REST or device command action, any status code below 400 if empty.
+type IntervalAction struct {
+	dtos.DBTimestamp    `json:",inline"`
+	Id                  string  `json:"id,omitempty" validate:"omitempty,uuid"`
+	Name                string  `json:"name" validate:"edgex-dto-none-empty-string"`
+	IntervalName        string  `json:"intervalName" validate:"edgex-dto-none-empty-string"`
+	Address             Address `json:"address" validate:"required"`
+	Content             string  `json:"content,omitempty"`
+	ContentType         string  `json:"contentType,omitempty"`
+	AdminState          string  `json:"adminState" validate:"oneof='LOCKED' 'UNLOCKED'"`
+	AuthMethod          string  `json:"authMethod" validate:"oneof='' 'NONE' 'JWT'"`
+	RetryCount          int     `json:"retryCount,omitempty" validate:"gte=0,lte=10"`
+	RetryBackoff        string  `json:"retryBackoff,omitempty" validate:"omitempty,edgex-dto-duration"`
+	Timeout             string  `json:"timeout,omitempty" validate:"omitempty,edgex-dto-duration"`
+	ExpectedStatusCodes []int   `json:"expectedStatusCodes,omitempty" validate:"omitempty,dive,gte=100,lte=599"`
+}
+
+// UpdateIntervalAction defines the fields of an interval action to update, the action being identified by Id or Name.
+// ExpectedStatusCodes replaces the expected status codes when not nil, an empty list accepting any status code below 400.
+type UpdateIntervalAction struct {
+	Id                  *string  `json:"id" validate:"required_without=Name,edgex-dto-uuid"`
+	Name                *string  `json:"name" validate:"required_without=Id,edgex-dto-none-empty-string"`
+	IntervalName        *string  `json:"intervalName" validate:"omitempty,edgex-dto-none-empty-string"`
+	Content             *string  `json:"content"`
+	ContentType         *string  `json:"contentType"`
+	Address             *Address `json:"address"`
+	AdminState          *string  `json:"adminState" validate:"omitempty,oneof='LOCKED' 'UNLOCKED'"`
+	AuthMethod          *string  `json:"authMethod" validate:"omitempty,oneof='' 'NONE' 'JWT'"`
+	RetryCount          *int     `json:"retryCount" validate:"omitempty,gte=0,lte=10"`
+	RetryBackoff        *string  `json:"retryBackoff" validate:"omitempty,edgex-dto-duration"`
+	Timeout             *string  `json:"timeout" validate:"omitempty,edgex-dto-duration"`
+	ExpectedStatusCodes []int    `json:"expectedStatusCodes" validate:"omitempty,dive,gte=100,lte=599"`
+}
+
+// Address is the Address DTO of go-mod-core-contracts extended with the MESSAGEBUS and DEVICECOMMAND types.  The
+// message bus address only has the topic of the MQTTPubAddress, relative to the base topic prefix of the service.  The
+// MQTT and EMAIL actions are accepted, but their executions fail as the scheduler doesn't support them.
+type Address struct {
+	Type string `json:"type" validate:"oneof='REST' 'MQTT' 'EMAIL' 'MESSAGEBUS' 'DEVICECOMMAND'"`
+
+	Host string `json:"host,omitempty"`
+	Port int    `json:"port,omitempty"`
+
+	dtos.RESTAddress     `json:",inline" validate:"-"`
+	dtos.MQTTPubAddress  `json:",inline" validate:"-"`
+	dtos.EmailAddress    `json:",inline" validate:"-"`
+	DeviceCommandAddress `json:",inline" validate:"-"`
+}
+
+// DeviceCommandAddress defines the core-command command issued by a DEVICECOMMAND interval action
+type DeviceCommandAddress struct {
+	DeviceName  string `json:"deviceName,omitempty" validate:"edgex-dto-none-empty-string"`
+	CommandName string `json:"commandName,omitempty" validate:"edgex-dto-none-empty-string"`
+	Method      string `json:"method,omitempty" validate:"oneof='GET' 'SET'"`
+	PushEvent   bool   `json:"pushEvent,omitempty"`
+}
+
+// Validate satisfies the Validator interface
+func (a *Address) Validate() error {
+	err := common.Validate(a)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid Address.", err)
+	}
+	switch a.Type {
+	case pkgCommon.MESSAGEBUS:
+		if a.Topic == "" {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid message bus address, the topic is required.", nil)
+		}
+	case pkgCommon.DEVICECOMMAND:
+		err = common.Validate(a.DeviceCommandAddress)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid DeviceCommandAddress.", err)
+		}
+	default:
+		contractsAddress := a.toContractsAddress()
+		return contractsAddress.Validate()
+	}
+	return nil
+}
+
+// Validate satisfies the Validator interface
+func (a IntervalAction) Validate() error {
+	err := common.Validate(a)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = a.Address.Validate()
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return ValidateIntervalActionContent(ToIntervalActionModel(a))
+}
+
+// Validate satisfies the Validator interface
+func (a UpdateIntervalAction) Validate() error {
+	err := common.Validate(a)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if a.Address != nil {
+		err = a.Address.Validate()
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	return nil
+}
+
+// ValidateIntervalActionContent validates that the content of a device command SET action is a JSON object of the
+// values to set by device resource name
+func ValidateIntervalActionContent(action models.IntervalAction) errors.EdgeX {
+	address, ok := action.Address.(models.DeviceCommandAddress)
+	if !ok || address.Method != pkgCommon.DeviceCommandSet {
+		return nil
+	}
+	var settings map[string]any
+	if err := json.Unmarshal([]byte(action.Content), &settings); err != nil || len(settings) == 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("the content of the interval action %s must be a JSON object of the values to set", action.Name), err)
+	}
+	return nil
+}
+
+func (a Address) toContractsAddress() dtos.Address {
+	return dtos.Address{
+		Type:           a.Type,
+		Host:           a.Host,
+		Port:           a.Port,
+		RESTAddress:    a.RESTAddress,
+		MQTTPubAddress: a.MQTTPubAddress,
+		EmailAddress:   a.EmailAddress,
+	}
+}
+
+// ToAddressModel transforms the Address DTO to the Address model
+func ToAddressModel(a Address) contractsModels.Address {
+	switch a.Type {
+	case pkgCommon.MESSAGEBUS:
+		return models.MessageBusAddress{
+			BaseAddress: contractsModels.BaseAddress{Type: a.Type},
+			Topic:       a.Topic,
+		}
+	case pkgCommon.DEVICECOMMAND:
+		return models.DeviceCommandAddress{
+			BaseAddress: contractsModels.BaseAddress{Type: a.Type},
+			DeviceName:  a.DeviceName,
+			CommandName: a.CommandName,
+			Method:      a.Method,
+			PushEvent:   a.PushEvent,
+		}
+	default:
+		return dtos.ToAddressModel(a.toContractsAddress())
+	}
+}
+
+// FromAddressModelToDTO transforms the Address model to the Address DTO
+func FromAddressModelToDTO(address contractsModels.Address) Address {
+	switch a := address.(type) {
+	case models.MessageBusAddress:
+		dto := Address{Type: a.Type}
+		dto.Topic = a.Topic
+		return dto
+	case models.DeviceCommandAddress:
+		return Address{
+			Type: a.Type,
+			DeviceCommandAddress: DeviceCommandAddress{
+				DeviceName:  a.DeviceName,
+				CommandName: a.CommandName,
+				Method:      a.Method,
+				PushEvent:   a.PushEvent,
+			},
+		}
+	default:
+		dto := dtos.FromAddressModelToDTO(address)
+		return Address{
+			Type:           dto.Type,
+			Host:           dto.Host,
+			Port:           dto.Port,
+			RESTAddress:    dto.RESTAddress,
+			MQTTPubAddress: dto.MQTTPubAddress,
+			EmailAddress:   dto.EmailAddress,
+		}
+	}
+}
+
+// ToIntervalActionModel transforms the IntervalAction DTO to the IntervalAction model
+func ToIntervalActionModel(dto IntervalAction) models.IntervalAction {
+	return models.IntervalAction{
+		Id:                  dto.Id,
+		Name:                dto.Name,
+		IntervalName:        dto.IntervalName,
+		Content:             dto.Content,
+		ContentType:         dto.ContentType,
+		Address:             ToAddressModel(dto.Address),
+		AdminState:          contractsModels.AdminState(dto.AdminState),
+		AuthMethod:          contractsModels.AuthMethod(dto.AuthMethod),
+		RetryCount:          dto.RetryCount,
+		RetryBackoff:        dto.RetryBackoff,
+		Timeout:             dto.Timeout,
+		ExpectedStatusCodes: dto.ExpectedStatusCodes,
+	}
+}
+
+// FromIntervalActionModelToDTO transforms the IntervalAction model to the IntervalAction DTO
+func FromIntervalActionModelToDTO(model models.IntervalAction) IntervalAction {
+	return IntervalAction{
+		DBTimestamp:         dtos.DBTimestamp(model.DBTimestamp),
+		Id:                  model.Id,
+		Name:                model.Name,
+		IntervalName:        model.IntervalName,
+		Content:             model.Content,
+		ContentType:         model.ContentType,
+		Address:             FromAddressModelToDTO(model.Address),
+		AdminState:          string(model.AdminState),
+		AuthMethod:          string(model.AuthMethod),
+		RetryCount:          model.RetryCount,
+		RetryBackoff:        model.RetryBackoff,
+		Timeout:             model.Timeout,
+		ExpectedStatusCodes: model.ExpectedStatusCodes,
+	}
+}
+
+// ReplaceIntervalActionModelFieldsWithDTO replace existing IntervalAction's fields with DTO patch
+func ReplaceIntervalActionModelFieldsWithDTO(action *models.IntervalAction, patch UpdateIntervalAction) {
+	if patch.IntervalName != nil {
+		action.IntervalName = *patch.IntervalName
+	}
+	if patch.Address != nil {
+		action.Address = ToAddressModel(*patch.Address)
+	}
+	if patch.Content != nil {
+		action.Content = *patch.Content
+	}
+	if patch.ContentType != nil {
+		action.ContentType = *patch.ContentType
+	}
+	if patch.AdminState != nil {
+		action.AdminState = contractsModels.AdminState(*patch.AdminState)
+	}
+	if patch.AuthMethod != nil {
+		action.AuthMethod = contractsModels.AuthMethod(*patch.AuthMethod)
+	}
+	if patch.RetryCount != nil {
+		action.RetryCount = *patch.RetryCount
+	}
+	if patch.RetryBackoff != nil {
+		action.RetryBackoff = *patch.RetryBackoff
+	}
+	if patch.Timeout != nil {
+		action.Timeout = *patch.Timeout
+	}
+	if patch.ExpectedStatusCodes != nil {
+		action.ExpectedStatusCodes = patch.ExpectedStatusCodes
+	}
+}
@@ -0,0 +1,55 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// IntervalActionRecord records an execution of an interval action by the scheduler.  ScheduledTime is the Unix
+// timestamp in milliseconds the interval was due to run at, and Start and End the timestamps the execution started and
+// ended at.  Attempts are the outcomes of each attempt to execute the action.
+type IntervalActionRecord struct {
+	Id            string                  `json:"id"`
+	ActionName    string                  `json:"actionName"`
+	IntervalName  string                  `json:"intervalName"`
+	ScheduledTime int64                   `json:"scheduledTime"`
+	Start         int64                   `json:"start"`
+	End           int64                   `json:"end"`
+	Status        string                  `json:"status"`
+	StatusCode    int                     `json:"statusCode,omitempty"`
+	Message       string                  `json:"message,omitempty"`
+	Attempts      []IntervalActionAttempt `json:"attempts,omitempty"`
+}
+
+// IntervalActionAttempt records the outcome of an attempt to execute an interval action
+type IntervalActionAttempt struct {
+	Start      int64  `json:"start"`
+	End        int64  `json:"end"`
+	StatusCode int    `json:"statusCode,omitempty"`
+	Message    string `json:"message,omitempty"`
+}
+
+// FromIntervalActionRecordModelToDTO transforms the IntervalActionRecord Model to the IntervalActionRecord DTO
+func FromIntervalActionRecordModelToDTO(r models.IntervalActionRecord) IntervalActionRecord {
+	var attempts []IntervalActionAttempt
+	if len(r.Attempts) > 0 {
+		attempts = make([]IntervalActionAttempt, len(r.Attempts))
+		for i, a := range r.Attempts {
+			attempts[i] = IntervalActionAttempt(a)
+		}
+	}
+	return IntervalActionRecord{
+		Id:            r.Id,
+		ActionName:    r.ActionName,
+		IntervalName:  r.IntervalName,
+		ScheduledTime: r.ScheduledTime,
+		Start:         r.Start,
+		End:           r.End,
+		Status:        r.Status,
+		StatusCode:    r.StatusCode,
+		Message:       r.Message,
+		Attempts:      attempts,
+	}
+}
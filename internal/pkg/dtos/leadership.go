@@ -0,0 +1,24 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// Leadership is the state of a scheduler instance in the election of a leader among the instances sharing the database
+type Leadership struct {
+	State      string `json:"state"`
+	InstanceId string `json:"instanceId"`
+	LeaderId   string `json:"leaderId,omitempty"`
+}
+
+// FromLeadershipModelToDTO transforms the Leadership Model to the Leadership DTO
+func FromLeadershipModelToDTO(l models.Leadership) Leadership {
+	return Leadership{
+		State:      l.State,
+		InstanceId: l.InstanceId,
+		LeaderId:   l.LeaderId,
+	}
+}
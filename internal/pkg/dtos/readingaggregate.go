@@ -0,0 +1,28 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// ReadingAggregate is the DTO of a downsampled reading window returned by the core-data aggregation API
+type ReadingAggregate struct {
+	DeviceName   string             `json:"deviceName"`
+	ResourceName string             `json:"resourceName"`
+	Start        int64              `json:"start"`
+	End          int64              `json:"end"`
+	Values       map[string]float64 `json:"values"`
+}
+
+// FromReadingAggregateModelToDTO transforms the ReadingAggregate Model to the ReadingAggregate DTO
+func FromReadingAggregateModelToDTO(aggregate models.ReadingAggregate) ReadingAggregate {
+	return ReadingAggregate{
+		DeviceName:   aggregate.DeviceName,
+		ResourceName: aggregate.ResourceName,
+		Start:        aggregate.Start,
+		End:          aggregate.End,
+		Values:       aggregate.Values,
+	}
+}
@@ -0,0 +1,52 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// AddCommandJobRequest defines the Request Content for POST CommandJob DTO.
+type AddCommandJobRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	Job                   dtos.CommandJob `json:"job"`
+}
+
+// Validate satisfies the Validator interface
+func (request *AddCommandJobRequest) Validate() error {
+	return request.Job.Validate()
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the AddCommandJobRequest type
+func (request *AddCommandJobRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		Job dtos.CommandJob
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*request = AddCommandJobRequest(alias)
+
+	// validate AddCommandJobRequest DTO
+	if err := request.Validate(); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid AddCommandJobRequest", err)
+	}
+	return nil
+}
+
+// AddCommandJobReqToCommandJobModels transforms the AddCommandJobRequest DTO array to the CommandJob model array
+func AddCommandJobReqToCommandJobModels(addRequests []AddCommandJobRequest) (jobs []models.CommandJob) {
+	for _, req := range addRequests {
+		jobs = append(jobs, dtos.ToCommandJobModel(req.Job))
+	}
+	return jobs
+}
@@ -0,0 +1,82 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// AddDeviceGroupRequest defines the Request Content for POST DeviceGroup DTO.
+type AddDeviceGroupRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	DeviceGroup           dtos.DeviceGroup `json:"deviceGroup"`
+}
+
+// Validate satisfies the Validator interface
+func (request AddDeviceGroupRequest) Validate() error {
+	return request.DeviceGroup.Validate()
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the AddDeviceGroupRequest type
+func (request *AddDeviceGroupRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		DeviceGroup dtos.DeviceGroup
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*request = AddDeviceGroupRequest(alias)
+
+	// validate AddDeviceGroupRequest DTO
+	if err := request.Validate(); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid AddDeviceGroupRequest", err)
+	}
+	return nil
+}
+
+// AddDeviceGroupReqToDeviceGroupModels transforms the AddDeviceGroupRequest DTO array to the DeviceGroup model array
+func AddDeviceGroupReqToDeviceGroupModels(addRequests []AddDeviceGroupRequest) (groups []models.DeviceGroup) {
+	for _, req := range addRequests {
+		groups = append(groups, dtos.ToDeviceGroupModel(req.DeviceGroup))
+	}
+	return groups
+}
+
+// UpdateDeviceGroupRequest defines the Request Content for PATCH DeviceGroup DTO.
+type UpdateDeviceGroupRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	DeviceGroup           dtos.UpdateDeviceGroup `json:"deviceGroup"`
+}
+
+// Validate satisfies the Validator interface
+func (request UpdateDeviceGroupRequest) Validate() error {
+	return request.DeviceGroup.Validate()
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the UpdateDeviceGroupRequest type
+func (request *UpdateDeviceGroupRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		DeviceGroup dtos.UpdateDeviceGroup
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*request = UpdateDeviceGroupRequest(alias)
+
+	// validate UpdateDeviceGroupRequest DTO
+	if err := request.Validate(); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid UpdateDeviceGroupRequest", err)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// AddDeviceTwinRequest defines the Request Content for POST DeviceTwin DTO.  Desired are the desired values of the
+// device resources, by device resource name.
+type AddDeviceTwinRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	DeviceName            string            `json:"deviceName" validate:"required,edgex-dto-none-empty-string"`
+	Desired               map[string]string `json:"desired"`
+}
+
+// Validate satisfies the Validator interface
+func (request AddDeviceTwinRequest) Validate() error {
+	return common.Validate(request)
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the AddDeviceTwinRequest type
+func (request *AddDeviceTwinRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		DeviceName string
+		Desired    map[string]string
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*request = AddDeviceTwinRequest(alias)
+
+	// validate AddDeviceTwinRequest DTO
+	if err := request.Validate(); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid AddDeviceTwinRequest", err)
+	}
+	return nil
+}
+
+// UpdateDeviceTwinRequest defines the Request Content for PATCH DeviceTwin DTO.  Desired are the desired values to set,
+// by device resource name, a null value unsetting the desired value of the device resource.  The desired values of the
+// device resources left out are kept.
+type UpdateDeviceTwinRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	DeviceName            string             `json:"deviceName" validate:"required,edgex-dto-none-empty-string"`
+	Desired               map[string]*string `json:"desired" validate:"required"`
+}
+
+// Validate satisfies the Validator interface
+func (request UpdateDeviceTwinRequest) Validate() error {
+	return common.Validate(request)
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the UpdateDeviceTwinRequest type
+func (request *UpdateDeviceTwinRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		DeviceName string
+		Desired    map[string]*string
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*request = UpdateDeviceTwinRequest(alias)
+
+	// validate UpdateDeviceTwinRequest DTO
+	if err := request.Validate(); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid UpdateDeviceTwinRequest", err)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// AddIntervalRequest defines the Request Content for POST Interval DTO.
+type AddIntervalRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	Interval              dtos.Interval `json:"interval"`
+}
+
+// Validate satisfies the Validator interface
+func (request AddIntervalRequest) Validate() error {
+	return request.Interval.Validate()
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the AddIntervalRequest type
+func (request *AddIntervalRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		Interval dtos.Interval
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*request = AddIntervalRequest(alias)
+
+	// validate AddIntervalRequest DTO
+	if err := request.Validate(); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid AddIntervalRequest", err)
+	}
+	return nil
+}
+
+// AddIntervalReqToIntervalModels transforms the AddIntervalRequest DTO array to the Interval model array
+func AddIntervalReqToIntervalModels(addRequests []AddIntervalRequest) (intervals []models.Interval) {
+	for _, req := range addRequests {
+		intervals = append(intervals, dtos.ToIntervalModel(req.Interval))
+	}
+	return intervals
+}
+
+// UpdateIntervalRequest defines the Request Content for PATCH Interval DTO.
+type UpdateIntervalRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	Interval              dtos.UpdateInterval `json:"interval"`
+}
+
+// Validate satisfies the Validator interface
+func (request UpdateIntervalRequest) Validate() error {
+	return request.Interval.Validate()
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the UpdateIntervalRequest type
+func (request *UpdateIntervalRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		Interval dtos.UpdateInterval
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*request = UpdateIntervalRequest(alias)
+
+	// validate UpdateIntervalRequest DTO
+	if err := request.Validate(); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid UpdateIntervalRequest", err)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+// AddIntervalActionRequest defines the Request Content for POST IntervalAction DTO.
+type AddIntervalActionRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	Action                dtos.IntervalAction `json:"action"`
+}
+
+// Validate satisfies the Validator interface
+func (request AddIntervalActionRequest) Validate() error {
+	err := common.Validate(request)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = request.Action.Validate()
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the AddIntervalActionRequest type
+func (request *AddIntervalActionRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		Action dtos.IntervalAction
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*request = AddIntervalActionRequest(alias)
+
+	// validate AddIntervalActionRequest DTO
+	if err := request.Validate(); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// AddIntervalActionReqToIntervalActionModels transforms the AddIntervalActionRequest DTO array to the IntervalAction model array
+func AddIntervalActionReqToIntervalActionModels(addRequests []AddIntervalActionRequest) (actions []models.IntervalAction) {
+	for _, req := range addRequests {
+		actions = append(actions, dtos.ToIntervalActionModel(req.Action))
+	}
+	return actions
+}
+
+// UpdateIntervalActionRequest defines the Request Content for PATCH IntervalAction DTO.
+type UpdateIntervalActionRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	Action                dtos.UpdateIntervalAction `json:"action"`
+}
+
+// Validate satisfies the Validator interface
+func (request UpdateIntervalActionRequest) Validate() error {
+	err := common.Validate(request)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = request.Action.Validate()
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the UpdateIntervalActionRequest type
+func (request *UpdateIntervalActionRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		Action dtos.UpdateIntervalAction
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*request = UpdateIntervalActionRequest(alias)
+
+	// validate UpdateIntervalActionRequest DTO
+	if err := request.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+)
+
+// BatchCommandResponse defines the Response Content for a command issued to many devices, reporting the result of the
+// command for each device.
+type BatchCommandResponse struct {
+	common.BaseResponse `json:",inline"`
+	Results             []dtos.BatchCommandResult `json:"results"`
+}
+
+func NewBatchCommandResponse(requestId string, message string, statusCode int, results []dtos.BatchCommandResult) BatchCommandResponse {
+	return BatchCommandResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Results:      results,
+	}
+}
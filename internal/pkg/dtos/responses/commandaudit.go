@@ -0,0 +1,23 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+)
+
+// MultiCommandAuditRecordsResponse defines the Response Content for GET multiple CommandAuditRecord DTOs.
+type MultiCommandAuditRecordsResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	Records                           []dtos.CommandAuditRecord `json:"records"`
+}
+
+func NewMultiCommandAuditRecordsResponse(requestId string, message string, statusCode int, totalCount uint32, records []dtos.CommandAuditRecord) MultiCommandAuditRecordsResponse {
+	return MultiCommandAuditRecordsResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		Records:                    records,
+	}
+}
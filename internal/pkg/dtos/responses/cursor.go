@@ -0,0 +1,79 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+)
+
+// The responses of the queries paged by cursor extend the responses of the queries paged by offset with the
+// continuation token of the next page, which is empty once the last page has been returned.
+
+// MultiEventsWithCursorResponse defines the Response Content for GET multiple Event DTOs paged by cursor.
+type MultiEventsWithCursorResponse struct {
+	responses.MultiEventsResponse `json:",inline"`
+	NextCursor                    string `json:"nextCursor,omitempty"`
+}
+
+func NewMultiEventsWithCursorResponse(requestId string, message string, statusCode int, totalCount uint32, events []dtos.Event, nextCursor string) MultiEventsWithCursorResponse {
+	return MultiEventsWithCursorResponse{
+		MultiEventsResponse: responses.NewMultiEventsResponse(requestId, message, statusCode, totalCount, events),
+		NextCursor:          nextCursor,
+	}
+}
+
+// MultiReadingsWithCursorResponse defines the Response Content for GET multiple Reading DTOs paged by cursor.
+type MultiReadingsWithCursorResponse struct {
+	responses.MultiReadingsResponse `json:",inline"`
+	NextCursor                      string `json:"nextCursor,omitempty"`
+}
+
+func NewMultiReadingsWithCursorResponse(requestId string, message string, statusCode int, totalCount uint32, readings []dtos.BaseReading, nextCursor string) MultiReadingsWithCursorResponse {
+	return MultiReadingsWithCursorResponse{
+		MultiReadingsResponse: responses.NewMultiReadingsResponse(requestId, message, statusCode, totalCount, readings),
+		NextCursor:            nextCursor,
+	}
+}
+
+// MultiDevicesWithCursorResponse defines the Response Content for GET multiple Device DTOs paged by cursor.
+type MultiDevicesWithCursorResponse struct {
+	responses.MultiDevicesResponse `json:",inline"`
+	NextCursor                     string `json:"nextCursor,omitempty"`
+}
+
+func NewMultiDevicesWithCursorResponse(requestId string, message string, statusCode int, totalCount uint32, devices []dtos.Device, nextCursor string) MultiDevicesWithCursorResponse {
+	return MultiDevicesWithCursorResponse{
+		MultiDevicesResponse: responses.NewMultiDevicesResponse(requestId, message, statusCode, totalCount, devices),
+		NextCursor:           nextCursor,
+	}
+}
+
+// MultiDeviceProfilesWithCursorResponse defines the Response Content for GET multiple DeviceProfile DTOs paged by
+// cursor.
+type MultiDeviceProfilesWithCursorResponse struct {
+	responses.MultiDeviceProfilesResponse `json:",inline"`
+	NextCursor                            string `json:"nextCursor,omitempty"`
+}
+
+func NewMultiDeviceProfilesWithCursorResponse(requestId string, message string, statusCode int, totalCount uint32, profiles []dtos.DeviceProfile, nextCursor string) MultiDeviceProfilesWithCursorResponse {
+	return MultiDeviceProfilesWithCursorResponse{
+		MultiDeviceProfilesResponse: responses.NewMultiDeviceProfilesResponse(requestId, message, statusCode, totalCount, profiles),
+		NextCursor:                  nextCursor,
+	}
+}
+
+// MultiDeviceServicesWithCursorResponse defines the Response Content for GET multiple DeviceService DTOs paged by
+// cursor.
+type MultiDeviceServicesWithCursorResponse struct {
+	responses.MultiDeviceServicesResponse `json:",inline"`
+	NextCursor                            string `json:"nextCursor,omitempty"`
+}
+
+func NewMultiDeviceServicesWithCursorResponse(requestId string, message string, statusCode int, totalCount uint32, services []dtos.DeviceService, nextCursor string) MultiDeviceServicesWithCursorResponse {
+	return MultiDeviceServicesWithCursorResponse{
+		MultiDeviceServicesResponse: responses.NewMultiDeviceServicesResponse(requestId, message, statusCode, totalCount, services),
+		NextCursor:                  nextCursor,
+	}
+}
@@ -0,0 +1,36 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+)
+
+// DeviceGroupResponse defines the Response Content for GET DeviceGroup DTO.
+type DeviceGroupResponse struct {
+	common.BaseResponse `json:",inline"`
+	DeviceGroup         dtos.DeviceGroup `json:"deviceGroup"`
+}
+
+func NewDeviceGroupResponse(requestId string, message string, statusCode int, group dtos.DeviceGroup) DeviceGroupResponse {
+	return DeviceGroupResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		DeviceGroup:  group,
+	}
+}
+
+// MultiDeviceGroupsResponse defines the Response Content for GET multiple DeviceGroup DTOs.
+type MultiDeviceGroupsResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	DeviceGroups                      []dtos.DeviceGroup `json:"deviceGroups"`
+}
+
+func NewMultiDeviceGroupsResponse(requestId string, message string, statusCode int, totalCount uint32, groups []dtos.DeviceGroup) MultiDeviceGroupsResponse {
+	return MultiDeviceGroupsResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		DeviceGroups:               groups,
+	}
+}
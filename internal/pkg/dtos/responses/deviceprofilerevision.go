@@ -0,0 +1,36 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+)
+
+// DeviceProfileRevisionResponse defines the Response Content for GET DeviceProfileRevision DTO.
+type DeviceProfileRevisionResponse struct {
+	common.BaseResponse `json:",inline"`
+	Revision            dtos.DeviceProfileRevision `json:"revision"`
+}
+
+func NewDeviceProfileRevisionResponse(requestId string, message string, statusCode int, revision dtos.DeviceProfileRevision) DeviceProfileRevisionResponse {
+	return DeviceProfileRevisionResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Revision:     revision,
+	}
+}
+
+// MultiDeviceProfileRevisionsResponse defines the Response Content for GET multiple DeviceProfileRevision DTOs.
+type MultiDeviceProfileRevisionsResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	Revisions                         []dtos.DeviceProfileRevision `json:"revisions"`
+}
+
+func NewMultiDeviceProfileRevisionsResponse(requestId string, message string, statusCode int, totalCount uint32, revisions []dtos.DeviceProfileRevision) MultiDeviceProfileRevisionsResponse {
+	return MultiDeviceProfileRevisionsResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		Revisions:                  revisions,
+	}
+}
@@ -0,0 +1,49 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+)
+
+// DeviceTwinResponse defines the Response Content for GET DeviceTwin DTO.
+type DeviceTwinResponse struct {
+	common.BaseResponse `json:",inline"`
+	Twin                dtos.DeviceTwin `json:"twin"`
+}
+
+func NewDeviceTwinResponse(requestId string, message string, statusCode int, twin dtos.DeviceTwin) DeviceTwinResponse {
+	return DeviceTwinResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Twin:         twin,
+	}
+}
+
+// MultiDeviceTwinsResponse defines the Response Content for GET multiple DeviceTwin DTOs.
+type MultiDeviceTwinsResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	Twins                             []dtos.DeviceTwin `json:"twins"`
+}
+
+func NewMultiDeviceTwinsResponse(requestId string, message string, statusCode int, totalCount uint32, twins []dtos.DeviceTwin) MultiDeviceTwinsResponse {
+	return MultiDeviceTwinsResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		Twins:                      twins,
+	}
+}
+
+// DeviceTwinDeltaResponse defines the Response Content for GET DeviceTwinDelta DTO.
+type DeviceTwinDeltaResponse struct {
+	common.BaseResponse `json:",inline"`
+	Delta               dtos.DeviceTwinDelta `json:"delta"`
+}
+
+func NewDeviceTwinDeltaResponse(requestId string, message string, statusCode int, delta dtos.DeviceTwinDelta) DeviceTwinDeltaResponse {
+	return DeviceTwinDeltaResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Delta:        delta,
+	}
+}
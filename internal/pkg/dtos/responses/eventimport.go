@@ -0,0 +1,26 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+)
+
+// EventImportResponse defines the Response Content for POST event import, reporting the events which failed to be
+// imported.
+type EventImportResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	ImportedCount                     uint32                    `json:"importedCount"`
+	Failures                          []dtos.EventImportFailure `json:"failures,omitempty"`
+}
+
+func NewEventImportResponse(requestId string, message string, statusCode int, totalCount uint32, importedCount uint32, failures []dtos.EventImportFailure) EventImportResponse {
+	return EventImportResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		ImportedCount:              importedCount,
+		Failures:                   failures,
+	}
+}
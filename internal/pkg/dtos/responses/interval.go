@@ -0,0 +1,36 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+)
+
+// IntervalResponse defines the Response Content for GET Interval DTO.
+type IntervalResponse struct {
+	common.BaseResponse `json:",inline"`
+	Interval            dtos.Interval `json:"interval"`
+}
+
+func NewIntervalResponse(requestId string, message string, statusCode int, interval dtos.Interval) IntervalResponse {
+	return IntervalResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Interval:     interval,
+	}
+}
+
+// MultiIntervalsResponse defines the Response Content for GET multiple Interval DTOs.
+type MultiIntervalsResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	Intervals                         []dtos.Interval `json:"intervals"`
+}
+
+func NewMultiIntervalsResponse(requestId string, message string, statusCode int, totalCount uint32, intervals []dtos.Interval) MultiIntervalsResponse {
+	return MultiIntervalsResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		Intervals:                  intervals,
+	}
+}
@@ -0,0 +1,23 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+)
+
+// MultiIntervalActionRecordsResponse defines the Response Content for GET multiple IntervalActionRecord DTOs.
+type MultiIntervalActionRecordsResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	Records                           []dtos.IntervalActionRecord `json:"records"`
+}
+
+func NewMultiIntervalActionRecordsResponse(requestId string, message string, statusCode int, totalCount uint32, records []dtos.IntervalActionRecord) MultiIntervalActionRecordsResponse {
+	return MultiIntervalActionRecordsResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		Records:                    records,
+	}
+}
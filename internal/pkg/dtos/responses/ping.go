@@ -0,0 +1,24 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+)
+
+// PingResponse extends the PingResponse of go-mod-core-contracts with the leadership of the scheduler instance, which
+// is only set when the leader election is enabled
+type PingResponse struct {
+	common.PingResponse `json:",inline"`
+	Leadership          *dtos.Leadership `json:"leadership,omitempty"`
+}
+
+func NewPingResponse(serviceName string, leadership *dtos.Leadership) PingResponse {
+	return PingResponse{
+		PingResponse: common.NewPingResponse(serviceName),
+		Leadership:   leadership,
+	}
+}
@@ -0,0 +1,23 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+)
+
+// MultiReadingAggregatesResponse defines the Response Content for GET multiple reading aggregate DTOs.
+type MultiReadingAggregatesResponse struct {
+	common.BaseWithTotalCountResponse `json:",inline"`
+	Aggregates                        []dtos.ReadingAggregate `json:"aggregates"`
+}
+
+func NewMultiReadingAggregatesResponse(requestId string, message string, statusCode int, totalCount uint32, aggregates []dtos.ReadingAggregate) MultiReadingAggregatesResponse {
+	return MultiReadingAggregatesResponse{
+		BaseWithTotalCountResponse: common.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		Aggregates:                 aggregates,
+	}
+}
@@ -0,0 +1,26 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+)
+
+// SettingsValidationResponse defines the Response Content for a set command rejected because its settings don't
+// conform to the device profile, reporting the error of each invalid setting.
+type SettingsValidationResponse struct {
+	common.BaseResponse `json:",inline"`
+	Errors              []dtos.SettingValidationError `json:"errors"`
+}
+
+func NewSettingsValidationResponse(requestId string, message string, errs []dtos.SettingValidationError) SettingsValidationResponse {
+	return SettingsValidationResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, http.StatusBadRequest),
+		Errors:       errs,
+	}
+}
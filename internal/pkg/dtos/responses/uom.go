@@ -0,0 +1,28 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+)
+
+// UnitConversionResponse defines the Response Content for GET unit conversion, Result being Value converted from the
+// From unit to the To unit.
+type UnitConversionResponse struct {
+	common.BaseResponse `json:",inline"`
+	Value               float64 `json:"value"`
+	From                string  `json:"from"`
+	To                  string  `json:"to"`
+	Result              float64 `json:"result"`
+}
+
+func NewUnitConversionResponse(requestId string, message string, statusCode int, value float64, from string, to string, result float64) UnitConversionResponse {
+	return UnitConversionResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Value:        value,
+		From:         from,
+		To:           to,
+		Result:       result,
+	}
+}
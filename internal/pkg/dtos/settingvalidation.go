@@ -0,0 +1,11 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+// SettingValidationError reports why the value of a set command setting doesn't conform to the device profile.  Field
+// is the name of the device resource the setting is for.
+type SettingValidationError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
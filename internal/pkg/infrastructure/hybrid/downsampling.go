@@ -0,0 +1,85 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Downsampling of the raw readings into a long-term bucket through an InfluxDB task
+
+package hybrid
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db/influx"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// downsamplingTaskName returns the name of the task rolling up the readings of the bucket
+func downsamplingTaskName(bucket string) string {
+	return "edgex-downsampling-" + bucket
+}
+
+// downsamplingTask returns the Flux script of the task which, at every interval, aggregates the numeric readings of
+// the last interval and writes one point per device resource and function into the rollup bucket.  The rollups keep
+// the profile name as measurement and the devicename and resourcename tags, the field being the function name.
+func downsamplingTask(org string, bucket string, rollupBucket string, interval time.Duration, functions []string) string {
+	var sb strings.Builder
+	sb.WriteString(influx.TaskOption(downsamplingTaskName(bucket), interval))
+	for _, function := range functions {
+		sb.WriteString("\n\n")
+		sb.WriteString(influx.From(bucket,
+			numericReadingsQuery(influx.NewQuery().RangeSince(interval)).
+				GroupBy("_measurement", "devicename", "resourcename").
+				AggregateWindow(interval.Nanoseconds(), function).
+				Set("_field", function).
+				To(rollupBucket, org)))
+	}
+	return sb.String()
+}
+
+// reconcileDownsampling creates or updates the rollup bucket and the downsampling task according to the
+// configuration, and removes the task when the downsampling is disabled
+func reconcileDownsampling(client *influx.Client, config db.DownsamplingInfo, lc logger.LoggingClient) errors.EdgeX {
+	taskName := downsamplingTaskName(client.Bucket())
+	if !config.Enabled {
+		return client.RemoveTask(taskName)
+	}
+
+	if len(config.Bucket) == 0 || config.Bucket == client.Bucket() {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Downsampling Bucket must be set and differ from the TimeSeries Bucket", nil)
+	}
+	interval, err := time.ParseDuration(config.Interval)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse Downsampling Interval", err)
+	}
+	if interval < time.Minute || interval%time.Second != 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Downsampling Interval must be a whole number of seconds of at least 1m", nil)
+	}
+	retention, edgeXerr := influx.ParseRetention("Downsampling Retention", config.Retention)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if len(config.Functions) == 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Downsampling Functions must be set", nil)
+	}
+	for _, function := range config.Functions {
+		if !pkgModels.IsValidAggregateFunction(function) {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("invalid Downsampling function %s, must be one of %v", function, pkgModels.AggregateFunctions), nil)
+		}
+	}
+
+	edgeXerr = client.EnsureBucket(config.Bucket, retention)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	edgeXerr = client.EnsureTask(taskName, downsamplingTask(client.Org(), client.Bucket(), config.Bucket, interval, config.Functions))
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	lc.Infof("readings of bucket %s are downsampled every %s into bucket %s", client.Bucket(), interval, config.Bucket)
+	return nil
+}
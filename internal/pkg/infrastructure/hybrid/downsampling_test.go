@@ -0,0 +1,26 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hybrid
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDownsamplingTask(t *testing.T) {
+	task := downsamplingTask("edgex", "raw", "rollup", time.Hour, []string{"mean", "max"})
+
+	assert.True(t, strings.HasPrefix(task, `option task = {name: "edgex-downsampling-raw", every: 3600s}`))
+	assert.Equal(t, 2, strings.Count(task, `from(bucket: "raw")`))
+	assert.Equal(t, 2, strings.Count(task, `|>range(start: -3600s)`))
+	assert.Equal(t, 2, strings.Count(task, `|>group(columns: ["_measurement", "devicename", "resourcename"])`))
+	assert.Equal(t, 2, strings.Count(task, `|>to(bucket: "rollup", org: "edgex")`))
+	for _, function := range []string{"mean", "max"} {
+		assert.Contains(t, task, "|>aggregateWindow(every: 3600000000000ns, fn: "+function+", createEmpty: false, timeSrc: \"_start\")\n"+
+			`|>set(key: "_field", value: "`+function+`")`)
+	}
+}
@@ -8,17 +8,21 @@ package hybrid
 import (
 	"fmt"
 	"os"
+	"slices"
+	"sort"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db/influx"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/infrastructure/redis"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v3/config"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 	model "github.com/edgexfoundry/go-mod-core-contracts/v3/models"
 	"github.com/google/uuid"
+	gometrics "github.com/rcrowley/go-metrics"
 )
 
 type HybridClient struct {
@@ -27,17 +31,24 @@ type HybridClient struct {
 	loggingClient logger.LoggingClient
 }
 
-func NewHybridClient(config db.Configuration, logger logger.LoggingClient) (*HybridClient, errors.EdgeX) {
+func NewHybridClient(config db.Configuration, timeSeries db.TimeSeriesInfo, timeSeriesCredentials bootstrapConfig.Credentials, logger logger.LoggingClient) (*HybridClient, errors.EdgeX) {
 	//Create Influx client
-	influxClient, err := influx.NewClient(config, logger) // create the client
+	influxClient, err := influx.NewClient(timeSeries, timeSeriesCredentials, logger) // create the client
 	if err != nil {
 		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "influx client creation failed", err)
 	}
 	err = influxClient.CreateBucket()
 	if err != nil {
+		influxClient.CloseSession()
 		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "bucket creation failed", err)
 	}
 
+	err = reconcileDownsampling(influxClient, timeSeries.Downsampling, logger)
+	if err != nil {
+		influxClient.CloseSession()
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "downsampling setup failed", err)
+	}
+
 	//Check the environment variables for redis client
 	dbhost := os.Getenv("STAGEGATE_DATABASE_HOST")
 	if dbhost != "" {
@@ -50,6 +61,7 @@ func NewHybridClient(config db.Configuration, logger logger.LoggingClient) (*Hyb
 	//Create Redis client
 	redis, err := redis.NewClient(config, logger)
 	if err != nil {
+		influxClient.CloseSession()
 		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "redis client creation failed", err)
 	}
 
@@ -58,12 +70,55 @@ func NewHybridClient(config db.Configuration, logger logger.LoggingClient) (*Hyb
 	return hybridClient, nil
 }
 
-// CloseSession
+// TimeSeriesWriteErrors returns the counter of the events and readings which failed to be written to InfluxDB
+func (c *HybridClient) TimeSeriesWriteErrors() gometrics.Counter {
+	return c.influxClient.WriteErrors()
+}
+
+// CloseSession writes the pending events and readings before closing the connections
 func (c *HybridClient) CloseSession() {
 	c.influxClient.CloseSession()
 	c.redisClient.CloseSession()
 }
 
+// eventsQuery completes the query with the stages shaping the records into events
+func eventsQuery(q *influx.Query) *influx.Query {
+	return q.Group().
+		Sort("_time").
+		Pivot([]string{"_time", "counter", "devicename", "_measurement", "resourcename"}, "_field", "_value")
+}
+
+// readingFields lists the fields needed to rebuild a reading
+var readingFields = []string{"eventtype", "readingid", "units", "readingorigin", "valuetype", "mediatype", "value", readingTagsField}
+
+// readingsQuery completes the query with the stages shaping the records into readings
+func readingsQuery(q *influx.Query, offset int, limit int) *influx.Query {
+	return q.Filter(influx.In("_field", readingFields...)).
+		Group().
+		Sort("_time").
+		Pivot([]string{"_time", "counter", "devicename", "_measurement", "resourcename"}, "_field", "_value").
+		Limit(limit, offset)
+}
+
+// eventCountQuery completes the query with the stages counting the distinct events
+func eventCountQuery(q *influx.Query) *influx.Query {
+	return q.Group().Filter(influx.Eq("_field", "eventid")).Distinct().Count()
+}
+
+// readingCountQuery completes the query with the stages counting the readings
+func readingCountQuery(q *influx.Query) *influx.Query {
+	return q.Group().Filter(influx.Eq("_field", "readingid")).Count()
+}
+
+// numericReadingsQuery completes the query with the stages keeping the numeric readings, their _value column holding
+// the reading value converted to a float
+func numericReadingsQuery(q *influx.Query) *influx.Query {
+	return q.Filter(influx.In("_field", "value", "valuetype")).
+		Pivot([]string{"_time", "counter"}, "_field", "_value").
+		Filter(influx.Regex("valuetype", "^(Int|Uint|Float)")).
+		ValueToFloat("value")
+}
+
 // AddEvent adds a new event
 func (c *HybridClient) AddEvent(e model.Event) (model.Event, errors.EdgeX) {
 	if e.Id != "" {
@@ -75,15 +130,29 @@ func (c *HybridClient) AddEvent(e model.Event) (model.Event, errors.EdgeX) {
 	return AddEvent(c, e)
 }
 
+// AddEvents adds the events, their points being written in batches by the asynchronous writer.  The events which can't
+// be added are skipped and their errors are returned at their index.
+func (c *HybridClient) AddEvents(events []model.Event) ([]errors.EdgeX, errors.EdgeX) {
+	failures := make([]errors.EdgeX, len(events))
+	for i, e := range events {
+		if e.Id != "" {
+			if _, err := uuid.Parse(e.Id); err != nil {
+				failures[i] = errors.NewCommonEdgeX(errors.KindInvalidId, "uuid parsing failed", err)
+				continue
+			}
+		}
+		if _, err := AddEvent(c, e); err != nil {
+			failures[i] = err
+		}
+	}
+	return failures, nil
+}
+
 // EventById gets an event by id
 func (c *HybridClient) EventById(id string) (event model.Event, edgeXerr errors.EdgeX) {
 	events1, err := AllEvents(c, 0, 0,
-		fmt.Sprintf(`
-		|>range(start: 0, stop: now())
-		|>group()
-		|>sort(columns: ["_time"])
-		|>pivot(rowKey: ["_time","counter","devicename","_measurement","resourcename"], columnKey: ["_field"], valueColumn: "_value")
-		|>filter(fn: (r) => r["eventid"] == "%v")`, id))
+		eventsQuery(influx.NewQuery().RangeAll()).
+			Filter(influx.Eq("eventid", id)))
 	if err != nil {
 		return model.Event{}, err
 	}
@@ -102,7 +171,7 @@ func (c *HybridClient) DeleteEventById(id string) (edgeXerr errors.EdgeX) {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 	//Create the delete string
-	deletestring := fmt.Sprintf(`_measurement="%v" AND devicename="%v"`, event.ProfileName, event.DeviceName)
+	deletestring := influx.DeletePredicate("_measurement", event.ProfileName, "devicename", event.DeviceName)
 
 	err1 := c.influxClient.DeleteData(deletestring, time.Unix(0, int64(event.Origin)), time.Unix(0, int64(event.Origin)))
 	if err1 != nil {
@@ -112,13 +181,7 @@ func (c *HybridClient) DeleteEventById(id string) (edgeXerr errors.EdgeX) {
 }
 func (c *HybridClient) EventTotalCount() (uint32, errors.EdgeX) {
 	//Influx count
-	count, err := TotalCountInflux(c,
-		`
-		|>range(start: 0, stop: now())
-		|>group()
-		|>filter(fn: (r) => r._field == "eventid")
-		|>distinct()
-		|>count()`)
+	count, err := TotalCountInflux(c, eventCountQuery(influx.NewQuery().RangeAll()))
 	if err != nil {
 		return 0, err
 	}
@@ -130,13 +193,8 @@ func (c *HybridClient) EventTotalCount() (uint32, errors.EdgeX) {
 func (c *HybridClient) EventCountByDeviceName(deviceName string) (uint32, errors.EdgeX) {
 	//Influx count
 	count, err := TotalCountInflux(c,
-		fmt.Sprintf(`
-		|>range(start: 0, stop: now())
-		|>filter(fn: (r) => r["devicename"] == "%v")
-		|>group()
-		|>filter(fn: (r) => r._field == "eventid")
-		|>distinct()
-		|>count()`, deviceName))
+		eventCountQuery(influx.NewQuery().RangeAll().
+			Filter(influx.Eq("devicename", deviceName))))
 	if err != nil {
 		return 0, err
 	}
@@ -146,33 +204,42 @@ func (c *HybridClient) EventCountByDeviceName(deviceName string) (uint32, errors
 
 // LatestReadingByOffset returns a latest reading by offset
 func (c *HybridClient) LatestReadingByOffset(offset uint32) (model.Reading, errors.EdgeX) {
+	return latestReadingByOffset(c, influx.NewQuery().RangeAll(), offset)
+}
+
+// LatestReadingByOffsetAndDeviceName returns the specified device's latest reading by offset
+func (c *HybridClient) LatestReadingByOffsetAndDeviceName(offset uint32, deviceName string) (model.Reading, errors.EdgeX) {
+	return latestReadingByOffset(c, influx.NewQuery().RangeAll().Filter(influx.Eq("devicename", deviceName)), offset)
+}
+
+// LatestReadingByOffsetAndProfileName returns the specified device profile's latest reading by offset
+func (c *HybridClient) LatestReadingByOffsetAndProfileName(offset uint32, profileName string) (model.Reading, errors.EdgeX) {
+	return latestReadingByOffset(c, influx.NewQuery().RangeAll().Filter(influx.Eq("_measurement", profileName)), offset)
+}
+
+// latestReadingByOffset returns the reading at the offset of the readings selected by the query, sorted from the
+// latest to the oldest
+func latestReadingByOffset(c *HybridClient, q *influx.Query, offset uint32) (model.Reading, errors.EdgeX) {
 	readings, err := allInfluxReadings(c,
-		fmt.Sprintf(`
-		|>range(start: 0, stop: now())
-		|>filter(fn: (r) => r["_field"] == "eventtype" or r["_field"] == "readingid" or r["_field"] == "units" or r["_field"] == "readingorigin" or r["_field"] == "valuetype" or r["_field"] == "value")
-		|>group()
-		|>sort(columns: ["_time"])
-		|>pivot(rowKey: ["_time","devicename","_measurement","resourcename"], columnKey: ["_field"], valueColumn: "_value")
-		|>limit(n: %v, offset: %v)`, offset-1, offset))
+		q.Filter(influx.In("_field", readingFields...)).
+			Group().
+			Pivot([]string{"_time", "counter", "devicename", "_measurement", "resourcename"}, "_field", "_value").
+			SortDescending("_time").
+			Limit(1, int(offset)))
 	if err != nil {
-		return nil, err
+		return nil, errors.NewCommonEdgeXWrapper(err)
 	}
-	if len(readings) > 0 {
-		return readings[0], nil
+	if len(readings) == 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("reading not found from the offset %d", offset), nil)
 	}
-	return nil, nil
+	return readings[0], nil
 }
 
 // EventCountByTimeRange returns the count of Event by time range
 func (c *HybridClient) EventCountByTimeRange(startTime int, endTime int) (uint32, errors.EdgeX) {
 	//Influx count
 	count, err := TotalCountInflux(c,
-		fmt.Sprintf(`
-			|>range(start:time(v:%v), stop: time(v:%v))
-			|>group()
-			|>filter(fn: (r) => r._field == "eventid")
-			|>distinct()
-			|>count()`, startTime, endTime))
+		eventCountQuery(influx.NewQuery().Range(int64(startTime), int64(endTime))))
 	if err != nil {
 		return 0, err
 	}
@@ -182,12 +249,7 @@ func (c *HybridClient) EventCountByTimeRange(startTime int, endTime int) (uint32
 
 // AllEvents query events by offset and limit
 func (c *HybridClient) AllEvents(offset int, limit int) ([]model.Event, errors.EdgeX) {
-	events1, err := AllEvents(c, offset, limit,
-		`
-		|>range(start: 0, stop: now())
-		|>group()
-		|>sort(columns: ["_time"])
-		|>pivot(rowKey: ["_time","counter","devicename","_measurement","resourcename"], columnKey: ["_field"], valueColumn: "_value")`)
+	events1, err := AllEvents(c, offset, limit, eventsQuery(influx.NewQuery().RangeAll()))
 	if err != nil {
 		return nil, err
 	}
@@ -197,7 +259,7 @@ func (c *HybridClient) AllEvents(offset int, limit int) ([]model.Event, errors.E
 // DeleteEventsByDeviceName deletes specific device's events and corresponding readings.  This function is implemented to starts up
 // two goroutines to delete readings and events in the background to achieve better performance.
 func (c *HybridClient) DeleteEventsByDeviceName(deviceName string) (edgeXerr errors.EdgeX) {
-	err := c.influxClient.DeleteData(fmt.Sprintf(`devicename="%v"`, deviceName), time.Now().AddDate(-10, 0, 0) /*10 years back*/, time.Now())
+	err := c.influxClient.DeleteData(influx.DeletePredicate("devicename", deviceName), time.Now().AddDate(-10, 0, 0) /*10 years back*/, time.Now())
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
@@ -206,11 +268,27 @@ func (c *HybridClient) DeleteEventsByDeviceName(deviceName string) (edgeXerr err
 
 // DeleteEventsByAge deletes events and their corresponding readings that are older than age.  This function is implemented to starts up
 func (c *HybridClient) DeleteEventsByAge(age int64) (edgeXerr errors.EdgeX) {
+	return c.deleteEventsByAge("", age)
+}
+
+// DeleteEventsByAgeAndDeviceName deletes the specified device's events and their readings that are older than age
+func (c *HybridClient) DeleteEventsByAgeAndDeviceName(age int64, deviceName string) (edgeXerr errors.EdgeX) {
+	return c.deleteEventsByAge(influx.DeletePredicate("devicename", deviceName), age)
+}
+
+// DeleteEventsByAgeAndProfileName deletes the specified device profile's events and their readings that are older
+// than age
+func (c *HybridClient) DeleteEventsByAgeAndProfileName(age int64, profileName string) (edgeXerr errors.EdgeX) {
+	return c.deleteEventsByAge(influx.DeletePredicate("_measurement", profileName), age)
+}
+
+// deleteEventsByAge deletes the events matching the delete predicate that are older than age
+func (c *HybridClient) deleteEventsByAge(predicate string, age int64) errors.EdgeX {
 	// note that the origin time is in  Epoch timestamp/nanoseconds format
 	expireTimestamp := time.Now().UnixNano() - age
-	err := c.influxClient.DeleteData("", time.Unix(0, expireTimestamp), time.Now())
+	err := c.influxClient.DeleteData(predicate, time.Unix(0, 0), time.Unix(0, expireTimestamp))
 	if err != nil {
-		return errors.NewCommonEdgeXWrapper(err)
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to delete events by age", err)
 	}
 	return nil
 }
@@ -218,12 +296,8 @@ func (c *HybridClient) DeleteEventsByAge(age int64) (edgeXerr errors.EdgeX) {
 // EventsByDeviceName query events by offset, limit and device name
 func (c *HybridClient) EventsByDeviceName(offset int, limit int, name string) (events []model.Event, edgeXerr errors.EdgeX) {
 	events1, err := AllEvents(c, offset, limit,
-		fmt.Sprintf(`
-		|>range(start: 0, stop: now())
-		|>filter(fn: (r) => r["_measurement"] == "%v")
-		|>group()
-		|>sort(columns: ["_time"])
-		|>pivot(rowKey: ["_time","counter","devicename","_measurement","resourcename"], columnKey: ["_field"], valueColumn: "_value")`, name))
+		eventsQuery(influx.NewQuery().RangeAll().
+			Filter(influx.Eq("_measurement", name))))
 	if err != nil {
 		return nil, err
 	}
@@ -233,11 +307,7 @@ func (c *HybridClient) EventsByDeviceName(offset int, limit int, name string) (e
 // EventsByTimeRange query events by time range, offset, and limit
 func (c *HybridClient) EventsByTimeRange(startTime int, endTime int, offset int, limit int) (events []model.Event, edgeXerr errors.EdgeX) {
 	events1, err := AllEvents(c, offset, limit,
-		fmt.Sprintf(`
-			|> range(start:time(v:%v), stop: time(v:%v))
-			|>group()
-			|>sort(columns: ["_time"])
-			|>pivot(rowKey: ["_time","counter","devicename","_measurement","resourcename"], columnKey: ["_field"], valueColumn: "_value")`, startTime, endTime))
+		eventsQuery(influx.NewQuery().Range(int64(startTime), int64(endTime))))
 	if err != nil {
 		return nil, err
 	}
@@ -247,12 +317,7 @@ func (c *HybridClient) EventsByTimeRange(startTime int, endTime int, offset int,
 // ReadingTotalCount returns the total count of Event from the database
 func (c *HybridClient) ReadingTotalCount() (uint32, errors.EdgeX) {
 	//Influx count
-	count, err := TotalCountInflux(c,
-		`
-		|>range(start: 0, stop: now())
-		|>group()
-		|>filter(fn: (r) => r._field == "readingid")
-		|>count()`)
+	count, err := TotalCountInflux(c, readingCountQuery(influx.NewQuery().RangeAll()))
 	if err != nil {
 		return 0, err
 	}
@@ -262,14 +327,7 @@ func (c *HybridClient) ReadingTotalCount() (uint32, errors.EdgeX) {
 
 // AllReadings query events by offset, limit, and labels
 func (c *HybridClient) AllReadings(offset int, limit int) ([]model.Reading, errors.EdgeX) {
-	readings, err := allInfluxReadings(c,
-		fmt.Sprintf(`
-		|>range(start: 0, stop: now())
-		|>filter(fn: (r) => r["_field"] == "eventtype" or r["_field"] == "readingid" or r["_field"] == "units" or r["_field"] == "readingorigin" or r["_field"] == "valuetype" or r["_field"] == "value")
-		|>group()
-		|>sort(columns: ["_time"])
-		|>pivot(rowKey: ["_time","counter","devicename","_measurement","resourcename"], columnKey: ["_field"], valueColumn: "_value")
-		|>limit(n: %v, offset: %v)`, limit, offset))
+	readings, err := allInfluxReadings(c, readingsQuery(influx.NewQuery().RangeAll(), offset, limit))
 	if err != nil {
 		return nil, err
 	}
@@ -279,13 +337,7 @@ func (c *HybridClient) AllReadings(offset int, limit int) ([]model.Reading, erro
 // ReadingsByTimeRange query readings by time range, offset, and limit
 func (c *HybridClient) ReadingsByTimeRange(start int, end int, offset int, limit int) (readings []model.Reading, edgeXerr errors.EdgeX) {
 	readings, err := allInfluxReadings(c,
-		fmt.Sprintf(`
-		|>range(start:time(v:%v), stop: time(v:%v))
-		|>filter(fn: (r) => r["_field"] == "eventtype" or r["_field"] == "readingid" or r["_field"] == "units" or r["_field"] == "readingorigin" or r["_field"] == "valuetype" or r["_field"] == "value")
-		|>group()
-		|>sort(columns: ["_time"])
-		|>pivot(rowKey: ["_time","counter","devicename","_measurement","resourcename"], columnKey: ["_field"], valueColumn: "_value")
-		|> limit(n: %v, offset: %v)`, start, end, limit, offset))
+		readingsQuery(influx.NewQuery().Range(int64(start), int64(end)), offset, limit))
 	if err != nil {
 		return nil, err
 	}
@@ -295,14 +347,8 @@ func (c *HybridClient) ReadingsByTimeRange(start int, end int, offset int, limit
 // ReadingsByResourceName query readings by offset, limit and resource name
 func (c *HybridClient) ReadingsByResourceName(offset int, limit int, resourceName string) (readings []model.Reading, edgeXerr errors.EdgeX) {
 	readings, err := allInfluxReadings(c,
-		fmt.Sprintf(`
-		|>range(start: 0, stop: now())
-		|>filter(fn: (r) => r["resourcename"] == "%v")
-		|>filter(fn: (r) => r["_field"] == "eventtype" or r["_field"] == "readingid" or r["_field"] == "units" or r["_field"] == "readingorigin" or r["_field"] == "valuetype" or r["_field"] == "value")
-		|>group()
-		|>sort(columns: ["_time"])
-		|>pivot(rowKey: ["_time","counter","devicename","_measurement","resourcename"], columnKey: ["_field"], valueColumn: "_value")
-		|>limit(n: %v, offset: %v)`, resourceName, limit, offset))
+		readingsQuery(influx.NewQuery().RangeAll().
+			Filter(influx.Eq("resourcename", resourceName)), offset, limit))
 	if err != nil {
 		return nil, err
 	}
@@ -312,14 +358,8 @@ func (c *HybridClient) ReadingsByResourceName(offset int, limit int, resourceNam
 // ReadingsByDeviceName query readings by offset, limit and device name
 func (c *HybridClient) ReadingsByDeviceName(offset int, limit int, name string) (readings []model.Reading, edgeXerr errors.EdgeX) {
 	readings, err := allInfluxReadings(c,
-		fmt.Sprintf(`
-		|>range(start: 0, stop: now())
-		|>filter(fn: (r) => r["devicename"] == "%v")
-		|>filter(fn: (r) => r["_field"] == "eventtype" or r["_field"] == "readingid" or r["_field"] == "units" or r["_field"] == "readingorigin" or r["_field"] == "valuetype" or r["_field"] == "value")
-		|>group()
-		|>sort(columns: ["_time"])
-		|>pivot(rowKey: ["_time","counter","devicename","_measurement","resourcename"], columnKey: ["_field"], valueColumn: "_value")
-		|>limit(n: %v, offset: %v)`, name, limit, offset))
+		readingsQuery(influx.NewQuery().RangeAll().
+			Filter(influx.Eq("devicename", name)), offset, limit))
 	if err != nil {
 		return nil, err
 	}
@@ -330,12 +370,21 @@ func (c *HybridClient) ReadingsByDeviceName(offset int, limit int, name string)
 func (c *HybridClient) ReadingCountByDeviceName(deviceName string) (uint32, errors.EdgeX) {
 	//Influx count
 	count, err := TotalCountInflux(c,
-		fmt.Sprintf(`
-		|>range(start: 0, stop: now())
-		|>filter(fn: (r) => r["devicename"] == "%v")
-		|>group()
-		|>filter(fn: (r) => r._field == "readingid")
-		|>count()`, deviceName))
+		readingCountQuery(influx.NewQuery().RangeAll().
+			Filter(influx.Eq("devicename", deviceName))))
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// ReadingCountByProfileName returns the count of Readings associated a specific Device Profile from the database
+func (c *HybridClient) ReadingCountByProfileName(profileName string) (uint32, errors.EdgeX) {
+	//Influx count
+	count, err := TotalCountInflux(c,
+		readingCountQuery(influx.NewQuery().RangeAll().
+			Filter(influx.Eq("_measurement", profileName))))
 	if err != nil {
 		return 0, err
 	}
@@ -347,12 +396,8 @@ func (c *HybridClient) ReadingCountByDeviceName(deviceName string) (uint32, erro
 func (c *HybridClient) ReadingCountByResourceName(resourceName string) (uint32, errors.EdgeX) {
 	//Influx count
 	count, err := TotalCountInflux(c,
-		fmt.Sprintf(`
-		|>range(start: 0, stop: now())
-		|>filter(fn: (r) => r["resourcename"] == "%v")
-		|>group()
-		|>filter(fn: (r) => r._field == "readingid")
-		|>count()`, resourceName))
+		readingCountQuery(influx.NewQuery().RangeAll().
+			Filter(influx.Eq("resourcename", resourceName))))
 	if err != nil {
 		return 0, err
 	}
@@ -363,12 +408,8 @@ func (c *HybridClient) ReadingCountByResourceName(resourceName string) (uint32,
 func (c *HybridClient) ReadingCountByResourceNameAndTimeRange(resourceName string, startTime int, endTime int) (uint32, errors.EdgeX) {
 	//Influx count
 	count, err := TotalCountInflux(c,
-		fmt.Sprintf(`
-			|>range(start:time(v:%v), stop: time(v:%v))
-			|>filter(fn: (r) => r["resourcename"] == "%v")
-			|>group()
-			|>filter(fn: (r) => r._field == "readingid")
-			|>count()`, startTime, endTime, resourceName))
+		readingCountQuery(influx.NewQuery().Range(int64(startTime), int64(endTime)).
+			Filter(influx.Eq("resourcename", resourceName))))
 	if err != nil {
 		return 0, err
 	}
@@ -379,12 +420,8 @@ func (c *HybridClient) ReadingCountByResourceNameAndTimeRange(resourceName strin
 func (c *HybridClient) ReadingCountByDeviceNameAndResourceName(deviceName string, resourceName string) (uint32, errors.EdgeX) {
 	//Influx count
 	count, err := TotalCountInflux(c,
-		fmt.Sprintf(`
-			|>range(start: 0, stop: now())
-			|>filter(fn: (r) => r["devicename"] == "%v" and r["resourcename"] == "%v" )
-			|>group()
-			|>filter(fn: (r) => r._field == "readingid")
-			|>count()`, deviceName, resourceName))
+		readingCountQuery(influx.NewQuery().RangeAll().
+			Filter(influx.Eq("devicename", deviceName), influx.Eq("resourcename", resourceName))))
 	if err != nil {
 		return 0, err
 	}
@@ -395,12 +432,8 @@ func (c *HybridClient) ReadingCountByDeviceNameAndResourceName(deviceName string
 func (c *HybridClient) ReadingCountByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, start int, end int) (uint32, errors.EdgeX) {
 	//Influx count
 	count, err := TotalCountInflux(c,
-		fmt.Sprintf(`
-			|>range(start:time(v:%v), stop: time(v:%v))
-			|>filter(fn: (r) => r["devicename"] == "%v" and r["resourcename"] == "%v" )
-			|>group()
-			|>filter(fn: (r) => r._field == "readingid")
-			|>count()`, start, end, deviceName, resourceName))
+		readingCountQuery(influx.NewQuery().Range(int64(start), int64(end)).
+			Filter(influx.Eq("devicename", deviceName), influx.Eq("resourcename", resourceName))))
 	if err != nil {
 		return 0, err
 	}
@@ -410,12 +443,7 @@ func (c *HybridClient) ReadingCountByDeviceNameAndResourceNameAndTimeRange(devic
 // ReadingCountByTimeRange returns the count of Readings from the database within specified time range
 func (c *HybridClient) ReadingCountByTimeRange(start int, end int) (uint32, errors.EdgeX) {
 	//Influx count
-	count, err := TotalCountInflux(c,
-		fmt.Sprintf(`
-			|>range(start:time(v:%v), stop: time(v:%v))			
-			|>group()
-			|>filter(fn: (r) => r._field == "readingid")
-			|>count()`, start, end))
+	count, err := TotalCountInflux(c, readingCountQuery(influx.NewQuery().Range(int64(start), int64(end))))
 	if err != nil {
 		return 0, err
 	}
@@ -425,14 +453,8 @@ func (c *HybridClient) ReadingCountByTimeRange(start int, end int) (uint32, erro
 // ReadingsByResourceNameAndTimeRange query readings by resourceName and specified time range. Readings are sorted in descending order of origin time.
 func (c *HybridClient) ReadingsByResourceNameAndTimeRange(resourceName string, start int, end int, offset int, limit int) (readings []model.Reading, err errors.EdgeX) {
 	readings, err = allInfluxReadings(c,
-		fmt.Sprintf(`
-		|>range(start: 0, stop: now())
-		|>filter(fn: (r) => r["resourcename"] == "%v")
-		|>filter(fn: (r) => r["_field"] == "eventtype" or r["_field"] == "readingid" or r["_field"] == "units" or r["_field"] == "readingorigin" or r["_field"] == "valuetype" or r["_field"] == "value")
-		|>group()
-		|>sort(columns: ["_time"])
-		|>pivot(rowKey: ["_time","counter","devicename","_measurement","resourcename"], columnKey: ["_field"], valueColumn: "_value")
-		|>limit(n: %v, offset: %v)`, resourceName, limit, offset))
+		readingsQuery(influx.NewQuery().RangeAll().
+			Filter(influx.Eq("resourcename", resourceName)), offset, limit))
 	if err != nil {
 		return nil, err
 	}
@@ -441,14 +463,8 @@ func (c *HybridClient) ReadingsByResourceNameAndTimeRange(resourceName string, s
 
 func (c *HybridClient) ReadingsByDeviceNameAndResourceName(deviceName string, resourceName string, offset int, limit int) (readings []model.Reading, err errors.EdgeX) {
 	readings, err = allInfluxReadings(c,
-		fmt.Sprintf(`
-		|>range(start: 0, stop: now())
-		|>filter(fn: (r) => r["devicename"] == "%v" and r["resourcename"] == "%v")
-		|>filter(fn: (r) => r["_field"] == "eventtype" or r["_field"] == "readingid" or r["_field"] == "units" or r["_field"] == "readingorigin" or r["_field"] == "valuetype" or r["_field"] == "value")
-		|>group()
-		|>sort(columns: ["_time"])
-		|>pivot(rowKey: ["_time","counter","devicename","_measurement","resourcename"], columnKey: ["_field"], valueColumn: "_value")
-		|>limit(n: %v, offset: %v)`, deviceName, resourceName, limit, offset))
+		readingsQuery(influx.NewQuery().RangeAll().
+			Filter(influx.Eq("devicename", deviceName), influx.Eq("resourcename", resourceName)), offset, limit))
 	if err != nil {
 		return nil, err
 	}
@@ -457,14 +473,8 @@ func (c *HybridClient) ReadingsByDeviceNameAndResourceName(deviceName string, re
 
 func (c *HybridClient) ReadingsByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, start int, end int, offset int, limit int) (readings []model.Reading, err errors.EdgeX) {
 	readings, err = allInfluxReadings(c,
-		fmt.Sprintf(`
-		|>range(start:time(v:%v), stop: time(v:%v))	
-		|>filter(fn: (r) => r["devicename"] == "%v" and r["resourcename"] == "%v")
-		|>filter(fn: (r) => r["_field"] == "eventtype" or r["_field"] == "readingid" or r["_field"] == "units" or r["_field"] == "readingorigin" or r["_field"] == "valuetype" or r["_field"] == "value")
-		|>group()
-		|>sort(columns: ["_time"])
-		|>pivot(rowKey: ["_time","counter","devicename","_measurement","resourcename"], columnKey: ["_field"], valueColumn: "_value")
-		|>limit(n: %v, offset: %v)`, start, end, deviceName, resourceName, limit, offset))
+		readingsQuery(influx.NewQuery().Range(int64(start), int64(end)).
+			Filter(influx.Eq("devicename", deviceName), influx.Eq("resourcename", resourceName)), offset, limit))
 	if err != nil {
 		return nil, err
 	}
@@ -472,13 +482,7 @@ func (c *HybridClient) ReadingsByDeviceNameAndResourceNameAndTimeRange(deviceNam
 }
 
 func (c *HybridClient) ReadingsByDeviceNameAndResourceNamesAndTimeRange(deviceName string, resourceNames []string, start, end, offset, limit int) (readings []model.Reading, totalCount uint32, err errors.EdgeX) {
-	var resourcePart string
-	for _, resource := range resourceNames {
-		resourcePart += fmt.Sprintf(` r["resourcename"] == "%v" or`, resource)
-	}
-	//remove the last or
-	i := strings.LastIndex(resourcePart, "or")
-	resourcePart = resourcePart[:i] + strings.Replace(resourcePart[i:], "or", "", 1)
+	filter := influx.And(influx.Eq("devicename", deviceName), influx.In("resourcename", resourceNames...))
 
 	countchannel := make(chan uint32)
 	readingchannel := make(chan []model.Reading)
@@ -487,12 +491,7 @@ func (c *HybridClient) ReadingsByDeviceNameAndResourceNamesAndTimeRange(deviceNa
 	go func() {
 		//Influx count
 		count, err1 := TotalCountInflux(c,
-			fmt.Sprintf(`
-			|>range(start:time(v:%v), stop: time(v:%v))	
-			|>filter(fn: (r) => r["devicename"] == "%v" and (%v))	
-			|>group()
-			|>filter(fn: (r) => r._field == "readingid")
-			|>count()`, start, end, deviceName, resourcePart))
+			readingCountQuery(influx.NewQuery().Range(int64(start), int64(end)).Filter(filter)))
 		if err1 != nil {
 			err = err1
 		}
@@ -501,14 +500,7 @@ func (c *HybridClient) ReadingsByDeviceNameAndResourceNamesAndTimeRange(deviceNa
 
 	go func() {
 		readings, err1 := allInfluxReadings(c,
-			fmt.Sprintf(`
-			|>range(start:time(v:%v), stop: time(v:%v))
-			|>filter(fn: (r) => r["devicename"] == "%v" and (%v))
-			|>filter(fn: (r) => r["_field"] == "eventtype" or r["_field"] == "readingid" or r["_field"] == "units" or r["_field"] == "readingorigin" or r["_field"] == "valuetype" or r["_field"] == "value")
-			|>group()
-			|>sort(columns: ["_time"])
-			|>pivot(rowKey: ["_time","counter","devicename","_measurement","resourcename"], columnKey: ["_field"], valueColumn: "_value")
-			|>limit(n: %v, offset: %v)`, start, end, deviceName, resourcePart, limit, offset))
+			readingsQuery(influx.NewQuery().Range(int64(start), int64(end)).Filter(filter), offset, limit))
 		if err1 != nil {
 			err = err1
 		}
@@ -526,14 +518,8 @@ func (c *HybridClient) ReadingsByDeviceNameAndResourceNamesAndTimeRange(deviceNa
 
 func (c *HybridClient) ReadingsByDeviceNameAndTimeRange(deviceName string, start int, end int, offset int, limit int) (readings []model.Reading, err errors.EdgeX) {
 	readings, err = allInfluxReadings(c,
-		fmt.Sprintf(`
-		|>range(start:time(v:%v), stop: time(v:%v))	
-		|>filter(fn: (r) => r["devicename"] == "%v")
-		|>filter(fn: (r) => r["_field"] == "eventtype" or r["_field"] == "readingid" or r["_field"] == "units" or r["_field"] == "readingorigin" or r["_field"] == "valuetype" or r["_field"] == "value")
-		|>group()
-		|>sort(columns: ["_time"])
-		|>pivot(rowKey: ["_time","counter","devicename","_measurement","resourcename"], columnKey: ["_field"], valueColumn: "_value")
-		|>limit(n: %v, offset: %v)`, start, end, deviceName, limit, offset))
+		readingsQuery(influx.NewQuery().Range(int64(start), int64(end)).
+			Filter(influx.Eq("devicename", deviceName)), offset, limit))
 	if err != nil {
 		return nil, err
 	}
@@ -543,14 +529,125 @@ func (c *HybridClient) ReadingsByDeviceNameAndTimeRange(deviceName string, start
 func (c *HybridClient) ReadingCountByDeviceNameAndTimeRange(deviceName string, start int, end int) (uint32, errors.EdgeX) {
 	//Influx count
 	count, err := TotalCountInflux(c,
-		fmt.Sprintf(`
-			|>range(start:time(v:%v), stop: time(v:%v))
-			|>filter(fn: (r) => r["devicename"] == "%v")
-			|>group()
-			|>filter(fn: (r) => r._field == "readingid")
-			|>count()`, start, end, deviceName))
+		readingCountQuery(influx.NewQuery().Range(int64(start), int64(end)).
+			Filter(influx.Eq("devicename", deviceName))))
 	if err != nil {
 		return 0, err
 	}
 	return count, nil
 }
+
+// ReadingsByCursor queries, in ascending order of event origin then reading id, at most limit readings matching the
+// filter and positioned after the cursor.  The returned cursor is left unchanged once every reading has been visited.
+func (c *HybridClient) ReadingsByCursor(filter pkgModels.ReadingFilter, cursor pkgModels.Cursor, limit int) ([]model.Reading, pkgModels.Cursor, errors.EdgeX) {
+	if limit <= 0 {
+		return nil, cursor, nil
+	}
+	start := filter.Start
+	if cursor.Id != "" {
+		start = cursor.Score
+	}
+	q := influx.NewQuery().Range(start, filter.End+1)
+	if filter.DeviceName != "" {
+		q.Filter(influx.Eq("devicename", filter.DeviceName))
+	}
+	if filter.ResourceName != "" {
+		q.Filter(influx.Eq("resourcename", filter.ResourceName))
+	}
+	q.Filter(influx.In("_field", readingFields...)).
+		Group().
+		Pivot([]string{"_time", "counter", "devicename", "_measurement", "resourcename"}, "_field", "_value")
+	if cursor.Id != "" {
+		q.Filter(influx.Or(influx.TimeAfter(cursor.Score), influx.And(influx.TimeAt(cursor.Score), influx.Gt("readingid", cursor.Id))))
+	}
+	return cursorInfluxReadings(c, q.Sort("_time", "readingid").Limit(limit, 0), cursor)
+}
+
+// ReadingsBeforeCursor queries, in descending order of event origin then reading id, at most limit readings of a device
+// positioned after the cursor.  The readings of every device are queried when name is empty.  The returned cursor is
+// the zero cursor once every reading has been visited.
+func (c *HybridClient) ReadingsBeforeCursor(name string, cursor pkgModels.Cursor, limit int) ([]model.Reading, pkgModels.Cursor, errors.EdgeX) {
+	if limit <= 0 {
+		return nil, cursor, nil
+	}
+	q := influx.NewQuery().RangeAll()
+	if name != "" {
+		q.Filter(influx.Eq("devicename", name))
+	}
+	q.Filter(influx.In("_field", readingFields...)).
+		Group().
+		Pivot([]string{"_time", "counter", "devicename", "_measurement", "resourcename"}, "_field", "_value")
+	if !cursor.IsZero() {
+		q.Filter(influx.Or(influx.TimeBefore(cursor.Score), influx.And(influx.TimeAt(cursor.Score), influx.Lt("readingid", cursor.Id))))
+	}
+	readings, next, err := cursorInfluxReadings(c, q.SortDescending("_time", "readingid").Limit(limit, 0), cursor)
+	if err != nil {
+		return nil, cursor, err
+	}
+	if len(readings) < limit {
+		next = pkgModels.Cursor{}
+	}
+	return readings, next, nil
+}
+
+// EventsBeforeCursor queries, in descending order of origin then id, at most limit events of a device positioned after
+// the cursor.  The events of every device are queried when name is empty.  The returned cursor is the zero cursor once
+// every event has been visited.
+func (c *HybridClient) EventsBeforeCursor(name string, cursor pkgModels.Cursor, limit int) ([]model.Event, pkgModels.Cursor, errors.EdgeX) {
+	if limit <= 0 {
+		return nil, cursor, nil
+	}
+	q := influx.NewQuery().RangeAll()
+	if name != "" {
+		q.Filter(influx.Eq("devicename", name))
+	}
+	q.Group().
+		Pivot([]string{"_time", "counter", "devicename", "_measurement", "resourcename"}, "_field", "_value")
+	if !cursor.IsZero() {
+		q.Filter(influx.Or(influx.TimeBefore(cursor.Score), influx.And(influx.TimeAt(cursor.Score), influx.Lt("eventid", cursor.Id))))
+	}
+	events, err := AllEvents(c, 0, limit, q.SortDescending("_time", "eventid", "counter"))
+	if err != nil {
+		return nil, cursor, err
+	}
+	for _, e := range events {
+		// the readings of an event come in descending order of counter
+		slices.Reverse(e.Readings)
+	}
+	if len(events) < limit {
+		return events, pkgModels.Cursor{}, nil
+	}
+	last := events[len(events)-1]
+	return events, pkgModels.Cursor{Score: last.Origin, Id: last.Id}, nil
+}
+
+// AggregateReadingsByDeviceNameAndResourceNameAndTimeRange downsamples the readings of the specified device resource
+// within the time range by pushing each aggregation function into a flux aggregateWindow
+func (c *HybridClient) AggregateReadingsByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, start int, end int, window int64, functions []string) ([]pkgModels.ReadingAggregate, errors.EdgeX) {
+	values := make(map[int64]map[string]float64)
+	for _, function := range functions {
+		err := aggregateInfluxReadings(c,
+			numericReadingsQuery(influx.NewQuery().Range(int64(start), int64(end)).
+				Filter(influx.Eq("devicename", deviceName), influx.Eq("resourcename", resourceName))).
+				Group().
+				Sort("_time").
+				AggregateWindow(window, function),
+			function, values)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	aggregates := make([]pkgModels.ReadingAggregate, 0, len(values))
+	for windowStart, v := range values {
+		aggregates = append(aggregates, pkgModels.ReadingAggregate{
+			DeviceName:   deviceName,
+			ResourceName: resourceName,
+			Start:        windowStart,
+			End:          windowStart + window,
+			Values:       v,
+		})
+	}
+	sort.Slice(aggregates, func(i, j int) bool { return aggregates[i].Start < aggregates[j].Start })
+	return aggregates, nil
+}
@@ -13,13 +13,17 @@ import (
 	"encoding/hex"
 	"encoding/json"
 
+	"github.com/edgexfoundry/edgex-go/internal/pkg/db/influx"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
 	"github.com/google/uuid"
-	"github.com/influxdata/influxdb-client-go/v2/api"
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+	"github.com/influxdata/influxdb-client-go/v2/api/query"
+	"github.com/influxdata/influxdb-client-go/v2/api/write"
 )
 
-func TotalCountInflux(conn *HybridClient, fluxQuery string) (uint32, errors.EdgeX) {
+func TotalCountInflux(conn *HybridClient, fluxQuery *influx.Query) (uint32, errors.EdgeX) {
 	var count uint32
 	result, err := conn.influxClient.QueryData(fluxQuery)
 	if err == nil {
@@ -36,69 +40,111 @@ func TotalCountInflux(conn *HybridClient, fluxQuery string) (uint32, errors.Edge
 	return count, nil
 }
 
-func createReading(result *api.QueryTableResult) (newrdng models.Reading) {
+// Constants related to the fields holding the JSON encoded event and reading tags
+const (
+	eventTagsField   = "eventtags"
+	readingTagsField = "readingtags"
+)
+
+// encodeTags encodes the tags into the JSON string stored in a field, nothing being stored for empty tags
+func encodeTags(tags map[string]any) (string, errors.EdgeX) {
+	if len(tags) == 0 {
+		return "", nil
+	}
+	bytes, err := json.Marshal(tags)
+	if err != nil {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to encode the tags", err)
+	}
+	return string(bytes), nil
+}
+
+// decodeTags rebuilds the tags stored in the field of the record, nil being returned when no tags were stored
+func decodeTags(record *query.FluxRecord, field string) map[string]any {
+	encoded, ok := record.ValueByKey(field).(string)
+	if !ok || len(encoded) == 0 {
+		return nil
+	}
+	var tags map[string]any
+	_ = json.Unmarshal([]byte(encoded), &tags)
+	return tags
+}
+
+func createReading(record *query.FluxRecord) (newrdng models.Reading) {
 	//Create the simple reading object
-	if result.Record().ValueByKey("eventtype") == "sr" {
+	if record.ValueByKey("eventtype") == "sr" {
 		newrdng := models.SimpleReading{}
-		newrdng.Id = fmt.Sprintf("%v", result.Record().ValueByKey("readingid"))
-		newrdng.ResourceName = fmt.Sprintf("%v", result.Record().ValueByKey("resourcename"))
-		newrdng.DeviceName = fmt.Sprintf("%v", result.Record().ValueByKey("devicename"))
-		newrdng.ProfileName = fmt.Sprintf("%v", result.Record().Measurement())
-		newrdng.ValueType = fmt.Sprintf("%v", result.Record().ValueByKey("valuetype"))
-		newrdng.Units = fmt.Sprintf("%v", result.Record().ValueByKey("units"))
+		newrdng.Id = fmt.Sprintf("%v", record.ValueByKey("readingid"))
+		newrdng.ResourceName = fmt.Sprintf("%v", record.ValueByKey("resourcename"))
+		newrdng.DeviceName = fmt.Sprintf("%v", record.ValueByKey("devicename"))
+		newrdng.ProfileName = fmt.Sprintf("%v", record.Measurement())
+		newrdng.ValueType = fmt.Sprintf("%v", record.ValueByKey("valuetype"))
+		newrdng.Units = fmt.Sprintf("%v", record.ValueByKey("units"))
 		if newrdng.Units == "<nil>" {
 			newrdng.Units = ""
 		}
 
-		newrdng.Value = fmt.Sprintf("%v", result.Record().ValueByKey("value"))
-		newrdng.Origin, _ = strconv.ParseInt(fmt.Sprintf("%v", result.Record().ValueByKey("readingorigin")), 10, 64)
-		newrdng.Tags = map[string]any{} //TODO
+		newrdng.Value = fmt.Sprintf("%v", record.ValueByKey("value"))
+		newrdng.Origin, _ = strconv.ParseInt(fmt.Sprintf("%v", record.ValueByKey("readingorigin")), 10, 64)
+		newrdng.Tags = decodeTags(record, readingTagsField)
 		return newrdng
 	}
 
 	//Create the binary reading object
-	if result.Record().ValueByKey("eventtype") == "br" {
+	if record.ValueByKey("eventtype") == "br" {
 		newrdng := models.BinaryReading{}
-		newrdng.Id = fmt.Sprintf("%v", result.Record().ValueByKey("readingid"))
-		newrdng.ResourceName = fmt.Sprintf("%v", result.Record().ValueByKey("resourcename"))
-		newrdng.DeviceName = fmt.Sprintf("%v", result.Record().ValueByKey("devicename"))
-		newrdng.ProfileName = fmt.Sprintf("%v", result.Record().Measurement())
-		newrdng.ValueType = fmt.Sprintf("%v", result.Record().ValueByKey("valuetype"))
-		newrdng.Units = fmt.Sprintf("%v", result.Record().ValueByKey("units"))
+		newrdng.Id = fmt.Sprintf("%v", record.ValueByKey("readingid"))
+		newrdng.ResourceName = fmt.Sprintf("%v", record.ValueByKey("resourcename"))
+		newrdng.DeviceName = fmt.Sprintf("%v", record.ValueByKey("devicename"))
+		newrdng.ProfileName = fmt.Sprintf("%v", record.Measurement())
+		newrdng.ValueType = fmt.Sprintf("%v", record.ValueByKey("valuetype"))
+		newrdng.Units = fmt.Sprintf("%v", record.ValueByKey("units"))
 		if newrdng.Units == "<nil>" {
 			newrdng.Units = ""
 		}
-		newrdng.MediaType = fmt.Sprintf("%v", result.Record().ValueByKey("mediatype"))
-		newrdng.BinaryValue, _ = hex.DecodeString(fmt.Sprintf("%v", result.Record().ValueByKey("value")))
-		newrdng.Origin, _ = strconv.ParseInt(fmt.Sprintf("%v", result.Record().ValueByKey("readingorigin")), 10, 64)
-		newrdng.Tags = map[string]any{} //TODO
+		newrdng.MediaType = fmt.Sprintf("%v", record.ValueByKey("mediatype"))
+		newrdng.BinaryValue, _ = hex.DecodeString(fmt.Sprintf("%v", record.ValueByKey("value")))
+		newrdng.Origin, _ = strconv.ParseInt(fmt.Sprintf("%v", record.ValueByKey("readingorigin")), 10, 64)
+		newrdng.Tags = decodeTags(record, readingTagsField)
 		return newrdng
 	}
 
 	//Create the object reading object
-	if result.Record().ValueByKey("eventtype") == "or" {
+	if record.ValueByKey("eventtype") == "or" {
 		newrdng := models.ObjectReading{}
-		newrdng.Id = fmt.Sprintf("%v", result.Record().ValueByKey("readingid"))
-		newrdng.ResourceName = fmt.Sprintf("%v", result.Record().ValueByKey("resourcename"))
-		newrdng.DeviceName = fmt.Sprintf("%v", result.Record().ValueByKey("devicename"))
-		newrdng.ProfileName = fmt.Sprintf("%v", result.Record().Measurement())
-		newrdng.ValueType = fmt.Sprintf("%v", result.Record().ValueByKey("valuetype"))
-		newrdng.Units = fmt.Sprintf("%v", result.Record().ValueByKey("units"))
+		newrdng.Id = fmt.Sprintf("%v", record.ValueByKey("readingid"))
+		newrdng.ResourceName = fmt.Sprintf("%v", record.ValueByKey("resourcename"))
+		newrdng.DeviceName = fmt.Sprintf("%v", record.ValueByKey("devicename"))
+		newrdng.ProfileName = fmt.Sprintf("%v", record.Measurement())
+		newrdng.ValueType = fmt.Sprintf("%v", record.ValueByKey("valuetype"))
+		newrdng.Units = fmt.Sprintf("%v", record.ValueByKey("units"))
 		if newrdng.Units == "<nil>" {
 			newrdng.Units = ""
 		}
-		hexarry, _ := hex.DecodeString(fmt.Sprintf("%v", result.Record().ValueByKey("value")))
+		hexarry, _ := hex.DecodeString(fmt.Sprintf("%v", record.ValueByKey("value")))
 		var obj interface{}
 		_ = json.Unmarshal(hexarry, &obj)
 		newrdng.ObjectValue = obj
-		newrdng.Origin, _ = strconv.ParseInt(fmt.Sprintf("%v", result.Record().ValueByKey("readingorigin")), 10, 64)
-		newrdng.Tags = map[string]any{} //TODO
+		newrdng.Origin, _ = strconv.ParseInt(fmt.Sprintf("%v", record.ValueByKey("readingorigin")), 10, 64)
+		newrdng.Tags = decodeTags(record, readingTagsField)
 		return newrdng
 	}
 	return nil
 }
 
-func allInfluxReadings(conn *HybridClient, fluxQuery string) ([]models.Reading, errors.EdgeX) {
+// createEvent creates the event, without any reading, from the record of one of its readings
+func createEvent(record *query.FluxRecord) models.Event {
+	return models.Event{
+		Id:          fmt.Sprintf("%v", record.ValueByKey("eventid")),
+		DeviceName:  fmt.Sprintf("%v", record.ValueByKey("devicename")),
+		ProfileName: fmt.Sprintf("%v", record.Measurement()),
+		SourceName:  fmt.Sprintf("%v", record.ValueByKey("sourcename")),
+		Origin:      record.Time().UnixNano(),
+		Tags:        decodeTags(record, eventTagsField),
+		Readings:    []models.Reading{},
+	}
+}
+
+func allInfluxReadings(conn *HybridClient, fluxQuery *influx.Query) ([]models.Reading, errors.EdgeX) {
 	resultsArr := []models.Reading{}
 	var reading models.Reading
 	result, err := conn.influxClient.QueryData(fluxQuery)
@@ -110,7 +156,7 @@ func allInfluxReadings(conn *HybridClient, fluxQuery string) ([]models.Reading,
 			if result.Err() != nil {
 				fmt.Printf("query parsing error: %s\n", result.Err().Error())
 			}
-			reading = createReading(result)
+			reading = createReading(result.Record())
 			resultsArr = append(resultsArr, reading)
 		}
 
@@ -121,7 +167,50 @@ func allInfluxReadings(conn *HybridClient, fluxQuery string) ([]models.Reading,
 	return resultsArr, nil
 }
 
-func AllEvents(conn *HybridClient, offset int, limit int, fluxQuery string) ([]models.Event, errors.EdgeX) {
+// cursorInfluxReadings runs the flux query and returns the readings along with the cursor positioned on the last one.
+// The points being timestamped with the event origin, the cursor origin is the origin of the event of the reading.
+func cursorInfluxReadings(conn *HybridClient, fluxQuery *influx.Query, cursor pkgModels.Cursor) ([]models.Reading, pkgModels.Cursor, errors.EdgeX) {
+	result, err := conn.influxClient.QueryData(fluxQuery)
+	if err != nil {
+		return nil, cursor, errors.NewCommonEdgeX(errors.KindDatabaseError, "INFLUXDB reading error", err)
+	}
+	var readings []models.Reading
+	for result.Next() {
+		record := result.Record()
+		readings = append(readings, createReading(record))
+		cursor = pkgModels.Cursor{Score: record.Time().UnixNano(), Id: fmt.Sprintf("%v", record.ValueByKey("readingid"))}
+	}
+	if result.Err() != nil {
+		return nil, cursor, errors.NewCommonEdgeX(errors.KindDatabaseError, "INFLUXDB reading parsing error", result.Err())
+	}
+	return readings, cursor, nil
+}
+
+// aggregateInfluxReadings runs an aggregateWindow flux query for the given aggregation function and merges the
+// resulting window values into aggregates, keyed by window start
+func aggregateInfluxReadings(conn *HybridClient, fluxQuery *influx.Query, function string, aggregates map[int64]map[string]float64) errors.EdgeX {
+	result, err := conn.influxClient.QueryData(fluxQuery)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("INFLUXDB %s aggregation error", function), err)
+	}
+	for result.Next() {
+		value, err := strconv.ParseFloat(fmt.Sprintf("%v", result.Record().Value()), 64)
+		if err != nil {
+			continue
+		}
+		start := result.Record().Time().UnixNano()
+		if _, ok := aggregates[start]; !ok {
+			aggregates[start] = make(map[string]float64)
+		}
+		aggregates[start][function] = value
+	}
+	if result.Err() != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "INFLUXDB query parsing error", result.Err())
+	}
+	return nil
+}
+
+func AllEvents(conn *HybridClient, offset int, limit int, fluxQuery *influx.Query) ([]models.Event, errors.EdgeX) {
 
 	if offset <= 0 {
 		offset = 1
@@ -150,7 +239,7 @@ func AllEvents(conn *HybridClient, offset int, limit int, fluxQuery string) ([]m
 					continue // Do not consider reading in offset calcul
 				}
 				//Create the reading object
-				reading = createReading(result)
+				reading = createReading(result.Record())
 				currentevent.Readings = append(currentevent.Readings, reading)
 			} else {
 				// Add the last event
@@ -168,17 +257,9 @@ func AllEvents(conn *HybridClient, offset int, limit int, fluxQuery string) ([]m
 					}
 				}
 				curid = result.Record().ValueByKey("eventid").(string)
-				currentevent = models.Event{
-					Id:          fmt.Sprintf("%v", result.Record().ValueByKey("eventid")),
-					DeviceName:  fmt.Sprintf("%v", result.Record().ValueByKey("devicename")),
-					ProfileName: fmt.Sprintf("%v", result.Record().Measurement()),
-					SourceName:  fmt.Sprintf("%v", result.Record().ValueByKey("sourcename")),
-					Origin:      result.Record().Time().UnixNano(),
-				}
-
-				currentevent.Readings = []models.Reading{}
+				currentevent = createEvent(result.Record())
 				//Create the reading object
-				reading = createReading(result)
+				reading = createReading(result.Record())
 				currentevent.Readings = append(currentevent.Readings, reading)
 			}
 		}
@@ -195,55 +276,84 @@ func AllEvents(conn *HybridClient, offset int, limit int, fluxQuery string) ([]m
 }
 
 func AddEvent(conn *HybridClient, e models.Event) (addedEvent models.Event, edgeXerr errors.EdgeX) {
-	var baseReading *models.BaseReading
-	var err error
 	unixtime := time.Unix(0, int64(e.Origin))
-	var counter int
-	for _, r := range e.Readings {
-		counter++
-		switch newReading := r.(type) {
-
-		case models.BinaryReading: //https://docs.edgexfoundry.org/3.0/examples/Ch-ExamplesSendingAndConsumingBinary/
-			baseReading = &newReading.BaseReading
-			if err = checkReadingValue(baseReading); err != nil {
-				return e, errors.NewCommonEdgeXWrapper(err)
-			}
-			conn.influxClient.WritePoint(e.ProfileName,
-				map[string]string{"devicename": e.DeviceName, "resourcename": r.GetBaseReading().ResourceName, "counter": strconv.Itoa(counter)}, // this is decided as most of queries in the interface are by device name and then resourcename. Also tried to reduce high cardinality
-				map[string]interface{}{"eventid": e.Id, "readingid": r.GetBaseReading().Id, "readingorigin": fmt.Sprintf("%v", r.GetBaseReading().Origin), "eventtype": "br", "units": newReading.Units, "valuetype": newReading.ValueType, "mediatype": newReading.MediaType, "value": hex.EncodeToString(newReading.BinaryValue)},
-				unixtime)
-
-		case models.SimpleReading:
-			baseReading = &newReading.BaseReading
-			if err = checkReadingValue(baseReading); err != nil {
-				return e, errors.NewCommonEdgeXWrapper(err)
-			}
+	// build all the points first, so that an invalid reading doesn't leave the event partially written
+	points := make([]*write.Point, len(e.Readings))
+	for i, r := range e.Readings {
+		tags, fields, err := readingPoint(e, r, i+1)
+		if err != nil {
+			return e, errors.NewCommonEdgeXWrapper(err)
+		}
+		points[i] = influxdb2.NewPoint(e.ProfileName, tags, fields, unixtime)
+	}
+	err := conn.influxClient.WritePoints(points...)
+	if err != nil {
+		return e, errors.NewCommonEdgeXWrapper(err)
+	}
+	return e, nil // TODO sort the reading as per resources as done in add events
+}
 
-			conn.influxClient.WritePoint(e.ProfileName,
-				map[string]string{"devicename": e.DeviceName, "resourcename": r.GetBaseReading().ResourceName, "counter": strconv.Itoa(counter)}, // this is decided as most of queries in the interface are by device name and then resourcename. Also tried to reduce high cardinality
-				map[string]interface{}{"eventid": e.Id, "readingid": r.GetBaseReading().Id, "readingorigin": fmt.Sprintf("%v", r.GetBaseReading().Origin), "eventtype": "sr", "sourcename": e.SourceName, "units": newReading.Units, "valuetype": newReading.ValueType, "value": newReading.Value},
-				unixtime)
+// readingPoint returns the tags and fields of the point storing the reading of the event.  counter is the position
+// of the reading in the event, which distinguishes the readings of a same resource in the event.
+func readingPoint(e models.Event, r models.Reading, counter int) (map[string]string, map[string]interface{}, errors.EdgeX) {
+	var baseReading *models.BaseReading
+	var err errors.EdgeX
+	var fields map[string]interface{}
+	switch newReading := r.(type) {
 
-		case models.ObjectReading:
-			baseReading = &newReading.BaseReading
-			if err = checkReadingValue(baseReading); err != nil {
-				return e, errors.NewCommonEdgeXWrapper(err)
-			}
-			s, err := json.Marshal(newReading.ObjectValue)
-			if err != nil {
-				return e, errors.NewCommonEdgeXWrapper(err)
-			}
+	case models.BinaryReading: //https://docs.edgexfoundry.org/3.0/examples/Ch-ExamplesSendingAndConsumingBinary/
+		baseReading = &newReading.BaseReading
+		if err = checkReadingValue(baseReading); err != nil {
+			return nil, nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		fields = map[string]interface{}{"eventtype": "br", "mediatype": newReading.MediaType, "value": hex.EncodeToString(newReading.BinaryValue)}
 
-			conn.influxClient.WritePoint(e.ProfileName,
-				map[string]string{"devicename": e.DeviceName, "resourcename": r.GetBaseReading().ResourceName, "counter": strconv.Itoa(counter)}, // this is decided as most of queries in the interface are by device name and then resourcename. Also tried to reduce high cardinality
-				map[string]interface{}{"eventid": e.Id, "readingid": r.GetBaseReading().Id, "readingorigin": fmt.Sprintf("%v", r.GetBaseReading().Origin), "eventtype": "or", "sourcename": e.SourceName, "units": newReading.Units, "valuetype": newReading.ValueType, "value": hex.EncodeToString(s)},
-				unixtime)
+	case models.SimpleReading:
+		baseReading = &newReading.BaseReading
+		if err = checkReadingValue(baseReading); err != nil {
+			return nil, nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		fields = map[string]interface{}{"eventtype": "sr", "value": newReading.Value}
 
-		default:
-			return e, errors.NewCommonEdgeX(errors.KindContractInvalid, "unsupported reading type", nil)
+	case models.ObjectReading:
+		baseReading = &newReading.BaseReading
+		if err = checkReadingValue(baseReading); err != nil {
+			return nil, nil, errors.NewCommonEdgeXWrapper(err)
 		}
+		s, jsonErr := json.Marshal(newReading.ObjectValue)
+		if jsonErr != nil {
+			return nil, nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to encode the object value", jsonErr)
+		}
+		fields = map[string]interface{}{"eventtype": "or", "value": hex.EncodeToString(s)}
+
+	default:
+		return nil, nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "unsupported reading type", nil)
 	}
-	return e, nil // TODO sort the reading as per resources as done in add events
+
+	fields["eventid"] = e.Id
+	fields["sourcename"] = e.SourceName
+	fields["readingid"] = baseReading.Id
+	fields["readingorigin"] = fmt.Sprintf("%v", baseReading.Origin)
+	fields["units"] = baseReading.Units
+	fields["valuetype"] = baseReading.ValueType
+	eventTags, err := encodeTags(e.Tags)
+	if err != nil {
+		return nil, nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	if len(eventTags) > 0 {
+		fields[eventTagsField] = eventTags
+	}
+	readingTags, err := encodeTags(baseReading.Tags)
+	if err != nil {
+		return nil, nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	if len(readingTags) > 0 {
+		fields[readingTagsField] = readingTags
+	}
+
+	// this is decided as most of queries in the interface are by device name and then resourcename. Also tried to reduce high cardinality
+	tags := map[string]string{"devicename": e.DeviceName, "resourcename": baseReading.ResourceName, "counter": strconv.Itoa(counter)}
+	return tags, fields, nil
 }
 
 func checkReadingValue(b *models.BaseReading) errors.EdgeX {
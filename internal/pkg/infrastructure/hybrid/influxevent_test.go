@@ -0,0 +1,90 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hybrid
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	"github.com/influxdata/influxdb-client-go/v2/api/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEventId     = "7a1707f0-166f-4c4b-bc9d-1d54c74e0137"
+	testReadingId   = "43a2b8a6-8d5b-4c7a-8e4b-5e2b9fbb0c7f"
+	testDeviceName  = "device"
+	testProfileName = "profile"
+	testSourceName  = "source"
+	testOrigin      = int64(1600666185705354000)
+)
+
+func testBaseReading(valueType string, tags map[string]any) models.BaseReading {
+	return models.BaseReading{
+		Id:           testReadingId,
+		Origin:       testOrigin,
+		DeviceName:   testDeviceName,
+		ResourceName: "resource",
+		ProfileName:  testProfileName,
+		ValueType:    valueType,
+		Units:        "C",
+		Tags:         tags,
+	}
+}
+
+// recordOf returns the record a pivoted query returns for the point
+func recordOf(measurement string, tags map[string]string, fields map[string]interface{}, t time.Time) *query.FluxRecord {
+	values := map[string]interface{}{"_measurement": measurement, "_time": t}
+	for k, v := range tags {
+		values[k] = v
+	}
+	for k, v := range fields {
+		values[k] = v
+	}
+	return query.NewFluxRecord(0, values)
+}
+
+func TestReadingPointRoundTrip(t *testing.T) {
+	readingTags := map[string]any{"floor": "3", "calibrated": true, "gain": 1.5}
+	eventTags := map[string]any{"gateway": "gw-1", "site": map[string]any{"id": float64(7)}}
+
+	tests := []struct {
+		name      string
+		reading   models.Reading
+		eventTags map[string]any
+	}{
+		{"simple reading", models.SimpleReading{BaseReading: testBaseReading(common.ValueTypeInt32, readingTags), Value: "21"}, eventTags},
+		{"simple reading without tags", models.SimpleReading{BaseReading: testBaseReading(common.ValueTypeInt32, nil), Value: "21"}, nil},
+		{"binary reading", models.BinaryReading{BaseReading: testBaseReading(common.ValueTypeBinary, readingTags), BinaryValue: []byte{0x01, 0xff}, MediaType: "application/octet-stream"}, eventTags},
+		{"object reading", models.ObjectReading{BaseReading: testBaseReading(common.ValueTypeObject, readingTags), ObjectValue: map[string]any{"temperature": 21.5, "unit": "C"}}, eventTags},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			event := models.Event{
+				Id:          testEventId,
+				DeviceName:  testDeviceName,
+				ProfileName: testProfileName,
+				SourceName:  testSourceName,
+				Origin:      testOrigin,
+				Tags:        testCase.eventTags,
+			}
+			tags, fields, err := readingPoint(event, testCase.reading, 1)
+			require.NoError(t, err)
+
+			record := recordOf(event.ProfileName, tags, fields, time.Unix(0, event.Origin))
+			assert.Equal(t, testCase.reading, createReading(record))
+
+			event.Readings = []models.Reading{}
+			assert.Equal(t, event, createEvent(record))
+		})
+	}
+}
+
+func TestReadingPointUnsupportedReading(t *testing.T) {
+	_, _, err := readingPoint(models.Event{}, nil, 1)
+	require.Error(t, err)
+}
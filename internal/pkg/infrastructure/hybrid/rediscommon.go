@@ -4,8 +4,12 @@
 package hybrid
 
 import (
+	"time"
+
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 	model "github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 )
 
 // Add a new device profle
@@ -252,27 +256,27 @@ func (c *HybridClient) ProvisionWatcherCountByProfileName(name string) (uint32,
 }
 
 // AddInterval adds a new interval
-func (c *HybridClient) AddInterval(interval model.Interval) (model.Interval, errors.EdgeX) {
+func (c *HybridClient) AddInterval(interval pkgModels.Interval) (pkgModels.Interval, errors.EdgeX) {
 	return c.redisClient.AddInterval(interval)
 }
 
 // IntervalByName gets a interval by name
-func (c *HybridClient) IntervalByName(name string) (interval model.Interval, edgeXerr errors.EdgeX) {
+func (c *HybridClient) IntervalByName(name string) (interval pkgModels.Interval, edgeXerr errors.EdgeX) {
 	return c.redisClient.IntervalByName(name)
 }
 
 // IntervalById gets a interval by id
-func (c *HybridClient) IntervalById(id string) (interval model.Interval, edgeXerr errors.EdgeX) {
+func (c *HybridClient) IntervalById(id string) (interval pkgModels.Interval, edgeXerr errors.EdgeX) {
 	return c.redisClient.IntervalById(id)
 }
 
 // AllIntervals query intervals with offset and limit
-func (c *HybridClient) AllIntervals(offset int, limit int) (intervals []model.Interval, edgeXerr errors.EdgeX) {
+func (c *HybridClient) AllIntervals(offset int, limit int) (intervals []pkgModels.Interval, edgeXerr errors.EdgeX) {
 	return c.redisClient.AllIntervals(offset, limit)
 }
 
 // UpdateInterval updates a interval
-func (c *HybridClient) UpdateInterval(interval model.Interval) errors.EdgeX {
+func (c *HybridClient) UpdateInterval(interval pkgModels.Interval) errors.EdgeX {
 	return c.redisClient.UpdateInterval(interval)
 }
 
@@ -292,22 +296,22 @@ func (c *HybridClient) IntervalActionTotalCount() (uint32, errors.EdgeX) {
 }
 
 // AddIntervalAction adds a new intervalAction
-func (c *HybridClient) AddIntervalAction(action model.IntervalAction) (model.IntervalAction, errors.EdgeX) {
+func (c *HybridClient) AddIntervalAction(action pkgModels.IntervalAction) (pkgModels.IntervalAction, errors.EdgeX) {
 	return c.redisClient.AddIntervalAction(action)
 }
 
 // AllIntervalActions query intervalActions with offset and limit
-func (c *HybridClient) AllIntervalActions(offset int, limit int) (intervalActions []model.IntervalAction, edgeXerr errors.EdgeX) {
+func (c *HybridClient) AllIntervalActions(offset int, limit int) (intervalActions []pkgModels.IntervalAction, edgeXerr errors.EdgeX) {
 	return c.redisClient.AllIntervalActions(offset, limit)
 }
 
 // IntervalActionByName gets a intervalAction by name
-func (c *HybridClient) IntervalActionByName(name string) (action model.IntervalAction, edgeXerr errors.EdgeX) {
+func (c *HybridClient) IntervalActionByName(name string) (action pkgModels.IntervalAction, edgeXerr errors.EdgeX) {
 	return c.redisClient.IntervalActionByName(name)
 }
 
 // IntervalActionsByIntervalName query intervalActions by offset, limit and intervalName
-func (c *HybridClient) IntervalActionsByIntervalName(offset int, limit int, intervalName string) (actions []model.IntervalAction, edgeXerr errors.EdgeX) {
+func (c *HybridClient) IntervalActionsByIntervalName(offset int, limit int, intervalName string) (actions []pkgModels.IntervalAction, edgeXerr errors.EdgeX) {
 	return c.redisClient.IntervalActionsByIntervalName(offset, limit, intervalName)
 }
 
@@ -317,15 +321,52 @@ func (c *HybridClient) DeleteIntervalActionByName(name string) errors.EdgeX {
 }
 
 // IntervalActionById gets a intervalAction by id
-func (c *HybridClient) IntervalActionById(id string) (action model.IntervalAction, edgeXerr errors.EdgeX) {
+func (c *HybridClient) IntervalActionById(id string) (action pkgModels.IntervalAction, edgeXerr errors.EdgeX) {
 	return c.redisClient.IntervalActionById(id)
 }
 
 // UpdateIntervalAction updates a intervalAction
-func (c *HybridClient) UpdateIntervalAction(action model.IntervalAction) errors.EdgeX {
+func (c *HybridClient) UpdateIntervalAction(action pkgModels.IntervalAction) errors.EdgeX {
 	return c.redisClient.UpdateIntervalAction(action)
 }
 
+// AddIntervalActionRecord adds a new interval action record
+func (c *HybridClient) AddIntervalActionRecord(r pkgModels.IntervalActionRecord) (pkgModels.IntervalActionRecord, errors.EdgeX) {
+	return c.redisClient.AddIntervalActionRecord(r)
+}
+
+// IntervalActionRecordsByTimeRange queries the records of an interval action, or of all interval actions when
+// actionName is empty, by scheduled time range, offset, and limit
+func (c *HybridClient) IntervalActionRecordsByTimeRange(actionName string, start int, end int, offset int, limit int) ([]pkgModels.IntervalActionRecord, uint32, errors.EdgeX) {
+	return c.redisClient.IntervalActionRecordsByTimeRange(actionName, start, end, offset, limit)
+}
+
+// TrimIntervalActionRecords deletes the oldest records of the actions of an interval beyond maxCount
+func (c *HybridClient) TrimIntervalActionRecords(intervalName string, maxCount int) errors.EdgeX {
+	return c.redisClient.TrimIntervalActionRecords(intervalName, maxCount)
+}
+
+// IntervalLastRun queries the scheduled time of the last run of an interval
+func (c *HybridClient) IntervalLastRun(intervalName string) (int64, errors.EdgeX) {
+	return c.redisClient.IntervalLastRun(intervalName)
+}
+
+// UpdateIntervalLastRun updates the scheduled time of the last run of an interval
+func (c *HybridClient) UpdateIntervalLastRun(intervalName string, scheduledTime int64) errors.EdgeX {
+	return c.redisClient.UpdateIntervalLastRun(intervalName, scheduledTime)
+}
+
+// AcquireLease acquires the lease for the holder, or renews it if the holder already holds it, and returns the holder
+// of the lease
+func (c *HybridClient) AcquireLease(name string, holder string, duration time.Duration) (string, errors.EdgeX) {
+	return c.redisClient.AcquireLease(name, holder, duration)
+}
+
+// ReleaseLease releases the lease if the holder holds it
+func (c *HybridClient) ReleaseLease(name string, holder string) errors.EdgeX {
+	return c.redisClient.ReleaseLease(name, holder)
+}
+
 // AddSubscription adds a new subscription
 func (c *HybridClient) AddSubscription(subscription model.Subscription) (model.Subscription, errors.EdgeX) {
 	return c.redisClient.AddSubscription(subscription)
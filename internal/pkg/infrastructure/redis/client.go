@@ -7,6 +7,7 @@ package redis
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
@@ -14,6 +15,8 @@ import (
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 	redisClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/redis"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/labelselector"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 
 	"github.com/google/uuid"
 )
@@ -32,6 +35,10 @@ func NewClient(config db.Configuration, logger logger.LoggingClient) (*Client, e
 		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "redis client creation failed", err)
 	}
 
+	conn := dc.Pool.Get()
+	defer conn.Close()
+	migrate(conn, dc.BatchSize, logger)
+
 	return dc, nil
 }
 
@@ -50,6 +57,15 @@ func (c *Client) AddEvent(e model.Event) (model.Event, errors.EdgeX) {
 	return addEvent(conn, e)
 }
 
+// AddEvents adds the events within a single transaction.  The events which can't be added are skipped and their errors
+// are returned at their index, while an error is returned when the transaction fails.
+func (c *Client) AddEvents(events []model.Event) ([]errors.EdgeX, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	return addEvents(conn, events)
+}
+
 // EventById gets an event by id
 func (c *Client) EventById(id string) (event model.Event, edgeXerr errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -249,6 +265,20 @@ func (c *Client) AllDeviceProfiles(offset int, limit int, labels []string) ([]mo
 	return deviceProfiles, nil
 }
 
+// DeviceProfilesBeforeCursor query device profiles positioned after the cursor in the order of AllDeviceProfiles, and
+// returns the cursor positioned on the last device profile visited, or the zero cursor once every device profile has
+// been visited
+func (c *Client) DeviceProfilesBeforeCursor(cursor pkgModels.Cursor, limit int) ([]model.DeviceProfile, pkgModels.Cursor, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	deviceProfiles, next, edgeXerr := deviceProfilesBeforeCursor(conn, cursor, limit)
+	if edgeXerr != nil {
+		return deviceProfiles, cursor, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return deviceProfiles, next, nil
+}
+
 // DeviceProfilesByModel query device profiles with offset, limit and model
 func (c *Client) DeviceProfilesByModel(offset int, limit int, model string) ([]model.DeviceProfile, errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -339,6 +369,20 @@ func (c *Client) AllDeviceServices(offset int, limit int, labels []string) (devi
 	return deviceServices, nil
 }
 
+// DeviceServicesBeforeCursor query device services positioned after the cursor in the order of AllDeviceServices, and
+// returns the cursor positioned on the last device service visited, or the zero cursor once every device service has
+// been visited
+func (c *Client) DeviceServicesBeforeCursor(cursor pkgModels.Cursor, limit int) ([]model.DeviceService, pkgModels.Cursor, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	deviceServices, next, edgeXerr := deviceServicesBeforeCursor(conn, cursor, limit)
+	if edgeXerr != nil {
+		return deviceServices, cursor, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return deviceServices, next, nil
+}
+
 // Add a new device
 func (c *Client) AddDevice(d model.Device) (model.Device, errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -484,6 +528,19 @@ func (c *Client) AllDevices(offset int, limit int, labels []string) ([]model.Dev
 	return devices, nil
 }
 
+// DevicesBeforeCursor query devices positioned after the cursor in the order of AllDevices, and returns the cursor
+// positioned on the last device visited, or the zero cursor once every device has been visited
+func (c *Client) DevicesBeforeCursor(cursor pkgModels.Cursor, limit int) ([]model.Device, pkgModels.Cursor, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	devices, next, edgeXerr := devicesBeforeCursor(conn, cursor, limit)
+	if edgeXerr != nil {
+		return devices, cursor, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return devices, next, nil
+}
+
 // EventsByDeviceName query events by offset, limit and device name
 func (c *Client) EventsByDeviceName(offset int, limit int, name string) (events []model.Event, edgeXerr errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -497,6 +554,21 @@ func (c *Client) EventsByDeviceName(offset int, limit int, name string) (events
 	return events, nil
 }
 
+// EventsBeforeCursor query, from the latest to the oldest, the events of a device positioned after the cursor and
+// returns the cursor positioned on the last event visited, or the zero cursor once every event has been visited.  The
+// events of every device are queried when name is empty.
+func (c *Client) EventsBeforeCursor(name string, cursor pkgModels.Cursor, limit int) ([]model.Event, pkgModels.Cursor, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	events, next, edgeXerr := eventsBeforeCursor(conn, name, cursor, limit)
+	if edgeXerr != nil {
+		return events, cursor, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query events by cursor and name %s", name), edgeXerr)
+	}
+	return events, next, nil
+}
+
 // EventsByTimeRange query events by time range, offset, and limit
 func (c *Client) EventsByTimeRange(startTime int, endTime int, offset int, limit int) (events []model.Event, edgeXerr errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -575,6 +647,21 @@ func (c *Client) ReadingsByDeviceName(offset int, limit int, name string) (readi
 	return readings, nil
 }
 
+// ReadingsBeforeCursor query, from the latest to the oldest, the readings of a device positioned after the cursor and
+// returns the cursor positioned on the last reading visited, or the zero cursor once every reading has been visited.
+// The readings of every device are queried when name is empty.
+func (c *Client) ReadingsBeforeCursor(name string, cursor pkgModels.Cursor, limit int) ([]model.Reading, pkgModels.Cursor, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	readings, next, edgeXerr := readingsBeforeCursor(conn, name, cursor, limit)
+	if edgeXerr != nil {
+		return readings, cursor, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query readings by cursor and name %s", name), edgeXerr)
+	}
+	return readings, next, nil
+}
+
 // ReadingCountByDeviceName returns the count of Readings associated a specific Device from the database
 func (c *Client) ReadingCountByDeviceName(deviceName string) (uint32, errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -588,6 +675,19 @@ func (c *Client) ReadingCountByDeviceName(deviceName string) (uint32, errors.Edg
 	return count, nil
 }
 
+// ReadingCountByProfileName returns the count of Readings associated a specific Device Profile from the database
+func (c *Client) ReadingCountByProfileName(profileName string) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(ReadingsCollectionProfileName, profileName))
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return count, nil
+}
+
 // ReadingCountByResourceName returns the count of Readings associated a specific Resource from the database
 func (c *Client) ReadingCountByResourceName(resourceName string) (uint32, errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -730,6 +830,36 @@ func (c *Client) ReadingCountByDeviceNameAndTimeRange(deviceName string, start i
 	return count, nil
 }
 
+// AggregateReadingsByDeviceNameAndResourceNameAndTimeRange downsamples the readings of the specified device resource
+// within the time range into windows of the given duration (nanoseconds), applying the requested aggregation functions
+func (c *Client) AggregateReadingsByDeviceNameAndResourceNameAndTimeRange(deviceName string, resourceName string, start int, end int, window int64, functions []string) ([]pkgModels.ReadingAggregate, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	aggregates, edgeXerr := aggregateReadingsByDeviceNameAndResourceNameAndTimeRange(conn, deviceName, resourceName, start, end, window, functions, c.BatchSize)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to aggregate readings by deviceName %s, resourceName %s and time range %v ~ %v", deviceName, resourceName, start, end), edgeXerr)
+	}
+
+	return aggregates, nil
+}
+
+// ReadingsByCursor queries, in ascending order of origin then id, at most limit readings matching the filter and
+// positioned after the cursor.  The returned cursor is left unchanged once every reading has been visited.
+func (c *Client) ReadingsByCursor(filter pkgModels.ReadingFilter, cursor pkgModels.Cursor, limit int) ([]model.Reading, pkgModels.Cursor, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	readings, next, edgeXerr := readingsByCursor(conn, filter, cursor, limit)
+	if edgeXerr != nil {
+		return nil, cursor, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query readings by deviceName %s, resourceName %s and time range %v ~ %v", filter.DeviceName, filter.ResourceName, filter.Start, filter.End), edgeXerr)
+	}
+
+	return readings, next, nil
+}
+
 // AddProvisionWatcher adds a new provision watcher
 func (c *Client) AddProvisionWatcher(pw model.ProvisionWatcher) (model.ProvisionWatcher, errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -961,7 +1091,7 @@ func (c *Client) ProvisionWatcherCountByProfileName(name string) (uint32, errors
 }
 
 // AddInterval adds a new interval
-func (c *Client) AddInterval(interval model.Interval) (model.Interval, errors.EdgeX) {
+func (c *Client) AddInterval(interval pkgModels.Interval) (pkgModels.Interval, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
@@ -973,7 +1103,7 @@ func (c *Client) AddInterval(interval model.Interval) (model.Interval, errors.Ed
 }
 
 // IntervalByName gets a interval by name
-func (c *Client) IntervalByName(name string) (interval model.Interval, edgeXerr errors.EdgeX) {
+func (c *Client) IntervalByName(name string) (interval pkgModels.Interval, edgeXerr errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
@@ -985,7 +1115,7 @@ func (c *Client) IntervalByName(name string) (interval model.Interval, edgeXerr
 }
 
 // IntervalById gets a interval by id
-func (c *Client) IntervalById(id string) (interval model.Interval, edgeXerr errors.EdgeX) {
+func (c *Client) IntervalById(id string) (interval pkgModels.Interval, edgeXerr errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
@@ -997,7 +1127,7 @@ func (c *Client) IntervalById(id string) (interval model.Interval, edgeXerr erro
 }
 
 // AllIntervals query intervals with offset and limit
-func (c *Client) AllIntervals(offset int, limit int) (intervals []model.Interval, edgeXerr errors.EdgeX) {
+func (c *Client) AllIntervals(offset int, limit int) (intervals []pkgModels.Interval, edgeXerr errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
@@ -1009,7 +1139,7 @@ func (c *Client) AllIntervals(offset int, limit int) (intervals []model.Interval
 }
 
 // UpdateInterval updates a interval
-func (c *Client) UpdateInterval(interval model.Interval) errors.EdgeX {
+func (c *Client) UpdateInterval(interval pkgModels.Interval) errors.EdgeX {
 	conn := c.Pool.Get()
 	defer conn.Close()
 	return updateInterval(conn, interval)
@@ -1055,7 +1185,7 @@ func (c *Client) IntervalActionTotalCount() (uint32, errors.EdgeX) {
 }
 
 // AddIntervalAction adds a new intervalAction
-func (c *Client) AddIntervalAction(action model.IntervalAction) (model.IntervalAction, errors.EdgeX) {
+func (c *Client) AddIntervalAction(action pkgModels.IntervalAction) (pkgModels.IntervalAction, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
@@ -1066,7 +1196,7 @@ func (c *Client) AddIntervalAction(action model.IntervalAction) (model.IntervalA
 }
 
 // AllIntervalActions query intervalActions with offset and limit
-func (c *Client) AllIntervalActions(offset int, limit int) (intervalActions []model.IntervalAction, edgeXerr errors.EdgeX) {
+func (c *Client) AllIntervalActions(offset int, limit int) (intervalActions []pkgModels.IntervalAction, edgeXerr errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
@@ -1078,7 +1208,7 @@ func (c *Client) AllIntervalActions(offset int, limit int) (intervalActions []mo
 }
 
 // IntervalActionByName gets a intervalAction by name
-func (c *Client) IntervalActionByName(name string) (action model.IntervalAction, edgeXerr errors.EdgeX) {
+func (c *Client) IntervalActionByName(name string) (action pkgModels.IntervalAction, edgeXerr errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
@@ -1090,7 +1220,7 @@ func (c *Client) IntervalActionByName(name string) (action model.IntervalAction,
 }
 
 // IntervalActionsByIntervalName query intervalActions by offset, limit and intervalName
-func (c *Client) IntervalActionsByIntervalName(offset int, limit int, intervalName string) (actions []model.IntervalAction, edgeXerr errors.EdgeX) {
+func (c *Client) IntervalActionsByIntervalName(offset int, limit int, intervalName string) (actions []pkgModels.IntervalAction, edgeXerr errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
@@ -1116,7 +1246,7 @@ func (c *Client) DeleteIntervalActionByName(name string) errors.EdgeX {
 }
 
 // IntervalActionById gets a intervalAction by id
-func (c *Client) IntervalActionById(id string) (action model.IntervalAction, edgeXerr errors.EdgeX) {
+func (c *Client) IntervalActionById(id string) (action pkgModels.IntervalAction, edgeXerr errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
@@ -1128,12 +1258,100 @@ func (c *Client) IntervalActionById(id string) (action model.IntervalAction, edg
 }
 
 // UpdateIntervalAction updates a intervalAction
-func (c *Client) UpdateIntervalAction(action model.IntervalAction) errors.EdgeX {
+func (c *Client) UpdateIntervalAction(action pkgModels.IntervalAction) errors.EdgeX {
 	conn := c.Pool.Get()
 	defer conn.Close()
 	return updateIntervalAction(conn, action)
 }
 
+// AddIntervalActionRecord adds a new interval action record
+func (c *Client) AddIntervalActionRecord(r pkgModels.IntervalActionRecord) (pkgModels.IntervalActionRecord, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(r.Id) == 0 {
+		r.Id = uuid.New().String()
+	}
+
+	return r, addIntervalActionRecord(conn, r)
+}
+
+// IntervalActionRecordsByTimeRange queries the records of an interval action, or of all interval actions when
+// actionName is empty, by scheduled time range, offset, and limit
+func (c *Client) IntervalActionRecordsByTimeRange(actionName string, start int, end int, offset int, limit int) ([]pkgModels.IntervalActionRecord, uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	records, totalCount, edgeXerr := intervalActionRecordsByTimeRange(conn, actionName, start, end, offset, limit)
+	if edgeXerr != nil {
+		return nil, 0, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query interval action records by action name '%s', time range %v ~ %v, offset %d, and limit %d", actionName, start, end, offset, limit), edgeXerr)
+	}
+	return records, totalCount, nil
+}
+
+// TrimIntervalActionRecords deletes the oldest records of the actions of an interval beyond maxCount
+func (c *Client) TrimIntervalActionRecords(intervalName string, maxCount int) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := trimIntervalActionRecords(conn, intervalName, maxCount)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to trim the interval action records of interval %s to %d records", intervalName, maxCount), edgeXerr)
+	}
+	return nil
+}
+
+// IntervalLastRun queries the scheduled time of the last run of an interval
+func (c *Client) IntervalLastRun(intervalName string) (int64, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	lastRun, edgeXerr := intervalLastRun(conn, intervalName)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return lastRun, nil
+}
+
+// UpdateIntervalLastRun updates the scheduled time of the last run of an interval
+func (c *Client) UpdateIntervalLastRun(intervalName string, scheduledTime int64) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := updateIntervalLastRun(conn, intervalName, scheduledTime)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to update the last run of interval %s", intervalName), edgeXerr)
+	}
+	return nil
+}
+
+// AcquireLease acquires the lease for the holder, or renews it if the holder already holds it, the holder holding the
+// lease for the duration unless renewing it.  It returns the holder of the lease, which is another holder if the lease
+// is already held.
+func (c *Client) AcquireLease(name string, holder string, duration time.Duration) (string, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	current, edgeXerr := acquireLease(conn, name, holder, duration)
+	if edgeXerr != nil {
+		return "", errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to acquire the lease %s for %s", name, holder), edgeXerr)
+	}
+	return current, nil
+}
+
+// ReleaseLease releases the lease if the holder holds it
+func (c *Client) ReleaseLease(name string, holder string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := releaseLease(conn, name, holder)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to release the lease %s for %s", name, holder), edgeXerr)
+	}
+	return nil
+}
+
 // AddSubscription adds a new subscription
 func (c *Client) AddSubscription(subscription model.Subscription) (model.Subscription, errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -1675,10 +1893,449 @@ func (c *Client) LatestReadingByOffset(offset uint32) (model.Reading, errors.Edg
 	conn := c.Pool.Get()
 	defer conn.Close()
 
-	reading, edgeXerr := latestReadingByOffset(conn, int(offset))
+	reading, edgeXerr := latestReadingByOffset(conn, ReadingsCollectionOrigin, int(offset))
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return reading, nil
+}
+
+// LatestReadingByOffsetAndDeviceName returns the specified device's latest reading by offset
+func (c *Client) LatestReadingByOffsetAndDeviceName(offset uint32, deviceName string) (model.Reading, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	reading, edgeXerr := latestReadingByOffset(conn, CreateKey(ReadingsCollectionDeviceName, deviceName), int(offset))
 	if edgeXerr != nil {
 		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
 
 	return reading, nil
 }
+
+// LatestReadingByOffsetAndProfileName returns the specified device profile's latest reading by offset
+func (c *Client) LatestReadingByOffsetAndProfileName(offset uint32, profileName string) (model.Reading, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	reading, edgeXerr := latestReadingByOffset(conn, CreateKey(ReadingsCollectionProfileName, profileName), int(offset))
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return reading, nil
+}
+
+// AddCommandAuditRecord adds a new command audit record
+func (c *Client) AddCommandAuditRecord(r pkgModels.CommandAuditRecord) (pkgModels.CommandAuditRecord, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(r.Id) == 0 {
+		r.Id = uuid.New().String()
+	}
+
+	return r, addCommandAuditRecord(conn, r)
+}
+
+// CommandAuditRecordsByTimeRange queries the command audit records of a device, or of all devices when deviceName is
+// empty, by time range, offset, and limit
+func (c *Client) CommandAuditRecordsByTimeRange(deviceName string, start int, end int, offset int, limit int) ([]pkgModels.CommandAuditRecord, uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	records, totalCount, edgeXerr := commandAuditRecordsByTimeRange(conn, deviceName, start, end, offset, limit)
+	if edgeXerr != nil {
+		return nil, 0, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query command audit records by device name '%s', time range %v ~ %v, offset %d, and limit %d", deviceName, start, end, offset, limit), edgeXerr)
+	}
+	return records, totalCount, nil
+}
+
+// TrimCommandAuditRecords deletes the oldest command audit records beyond maxCount
+func (c *Client) TrimCommandAuditRecords(maxCount int) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := trimCommandAuditRecords(conn, maxCount)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to trim command audit records to %d records", maxCount), edgeXerr)
+	}
+	return nil
+}
+
+// AddCommandJob adds a new command job
+func (c *Client) AddCommandJob(job pkgModels.CommandJob) (pkgModels.CommandJob, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(job.Id) == 0 {
+		job.Id = uuid.New().String()
+	}
+
+	return addCommandJob(conn, job)
+}
+
+// CommandJobByName gets a command job by name
+func (c *Client) CommandJobByName(name string) (job pkgModels.CommandJob, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	job, edgeXerr = commandJobByName(conn, name)
+	if edgeXerr != nil {
+		return job, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return
+}
+
+// AllCommandJobs query command jobs with offset and limit
+func (c *Client) AllCommandJobs(offset int, limit int) (jobs []pkgModels.CommandJob, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	jobs, edgeXerr = allCommandJobs(conn, offset, limit)
+	if edgeXerr != nil {
+		return jobs, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return jobs, nil
+}
+
+// UpdateCommandJob updates a command job
+func (c *Client) UpdateCommandJob(job pkgModels.CommandJob) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := updateCommandJob(conn, job)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to update the command job with name %s", job.Name), edgeXerr)
+	}
+	return nil
+}
+
+// DeleteCommandJobByName deletes the command job by name
+func (c *Client) DeleteCommandJobByName(name string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteCommandJobByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the command job with name %s", name), edgeXerr)
+	}
+	return nil
+}
+
+// CommandJobTotalCount returns the total count of CommandJob from the database
+func (c *Client) CommandJobTotalCount() (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, CommandJobCollection)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return count, nil
+}
+
+// AddDeviceTwin adds a new device twin
+func (c *Client) AddDeviceTwin(twin pkgModels.DeviceTwin) (pkgModels.DeviceTwin, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(twin.Id) == 0 {
+		twin.Id = uuid.New().String()
+	}
+
+	return addDeviceTwin(conn, twin)
+}
+
+// DeviceTwinByDeviceName gets a device twin by device name
+func (c *Client) DeviceTwinByDeviceName(deviceName string) (twin pkgModels.DeviceTwin, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	twin, edgeXerr = deviceTwinByDeviceName(conn, deviceName)
+	if edgeXerr != nil {
+		return twin, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return
+}
+
+// DeviceTwinExists checks whether the device twin of the device exists
+func (c *Client) DeviceTwinExists(deviceName string) (bool, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	exists, edgeXerr := deviceTwinExists(conn, deviceName)
+	if edgeXerr != nil {
+		return false, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to check the device twin existence of device %s", deviceName), edgeXerr)
+	}
+	return exists, nil
+}
+
+// AllDeviceTwins query device twins with offset and limit
+func (c *Client) AllDeviceTwins(offset int, limit int) (twins []pkgModels.DeviceTwin, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	twins, edgeXerr = allDeviceTwins(conn, offset, limit)
+	if edgeXerr != nil {
+		return twins, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return twins, nil
+}
+
+// UpdateDeviceTwin updates a device twin
+func (c *Client) UpdateDeviceTwin(twin pkgModels.DeviceTwin) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := updateDeviceTwin(conn, twin)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to update the device twin of device %s", twin.DeviceName), edgeXerr)
+	}
+	return nil
+}
+
+// DeleteDeviceTwinByDeviceName deletes the device twin by device name
+func (c *Client) DeleteDeviceTwinByDeviceName(deviceName string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteDeviceTwinByDeviceName(conn, deviceName)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the device twin of device %s", deviceName), edgeXerr)
+	}
+	return nil
+}
+
+// DeviceTwinTotalCount returns the total count of DeviceTwin from the database
+func (c *Client) DeviceTwinTotalCount() (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, DeviceTwinCollection)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return count, nil
+}
+
+// DeviceProfilesByLabelSelector query the device profiles whose labels satisfy the label selector with offset and limit, and returns the total
+// count of the selected device profiles
+func (c *Client) DeviceProfilesByLabelSelector(offset int, limit int, selector labelselector.Selector) ([]model.DeviceProfile, uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	objects, totalCount, edgeXerr := deviceProfilesByLabelSelector(conn, offset, limit, selector)
+	if edgeXerr != nil {
+		return objects, totalCount, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return objects, totalCount, nil
+}
+
+// DeviceServicesByLabelSelector query the device services whose labels satisfy the label selector with offset and limit, and returns the total
+// count of the selected device services
+func (c *Client) DeviceServicesByLabelSelector(offset int, limit int, selector labelselector.Selector) ([]model.DeviceService, uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	objects, totalCount, edgeXerr := deviceServicesByLabelSelector(conn, offset, limit, selector)
+	if edgeXerr != nil {
+		return objects, totalCount, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return objects, totalCount, nil
+}
+
+// DevicesByLabelSelector query the devices whose labels satisfy the label selector with offset and limit, and returns the total
+// count of the selected devices
+func (c *Client) DevicesByLabelSelector(offset int, limit int, selector labelselector.Selector) ([]model.Device, uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	objects, totalCount, edgeXerr := devicesByLabelSelector(conn, offset, limit, selector)
+	if edgeXerr != nil {
+		return objects, totalCount, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return objects, totalCount, nil
+}
+
+// ProvisionWatchersByLabelSelector query the provision watchers whose labels satisfy the label selector with offset and limit, and returns the total
+// count of the selected provision watchers
+func (c *Client) ProvisionWatchersByLabelSelector(offset int, limit int, selector labelselector.Selector) ([]model.ProvisionWatcher, uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	objects, totalCount, edgeXerr := provisionWatchersByLabelSelector(conn, offset, limit, selector)
+	if edgeXerr != nil {
+		return objects, totalCount, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return objects, totalCount, nil
+}
+
+// AddDeviceGroup adds a new device group
+func (c *Client) AddDeviceGroup(g pkgModels.DeviceGroup) (pkgModels.DeviceGroup, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(g.Id) == 0 {
+		g.Id = uuid.New().String()
+	}
+
+	return addDeviceGroup(conn, g)
+}
+
+// DeviceGroupById gets a device group by id
+func (c *Client) DeviceGroupById(id string) (g pkgModels.DeviceGroup, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	g, edgeXerr = deviceGroupById(conn, id)
+	if edgeXerr != nil {
+		return g, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query device group by id %s", id), edgeXerr)
+	}
+	return
+}
+
+// DeviceGroupByName gets a device group by name
+func (c *Client) DeviceGroupByName(name string) (g pkgModels.DeviceGroup, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	g, edgeXerr = deviceGroupByName(conn, name)
+	if edgeXerr != nil {
+		return g, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return
+}
+
+// AllDeviceGroups query device groups with offset and limit
+func (c *Client) AllDeviceGroups(offset int, limit int) (groups []pkgModels.DeviceGroup, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	groups, edgeXerr = allDeviceGroups(conn, offset, limit)
+	if edgeXerr != nil {
+		return groups, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return groups, nil
+}
+
+// UpdateDeviceGroup updates a device group
+func (c *Client) UpdateDeviceGroup(g pkgModels.DeviceGroup) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := updateDeviceGroup(conn, g)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to update device group %s", g.Name), edgeXerr)
+	}
+	return nil
+}
+
+// DeleteDeviceGroupByName deletes the device group by name
+func (c *Client) DeleteDeviceGroupByName(name string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	edgeXerr := deleteDeviceGroupByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete device group %s", name), edgeXerr)
+	}
+	return nil
+}
+
+// DeviceGroupTotalCount returns the total count of DeviceGroup from the database
+func (c *Client) DeviceGroupTotalCount() (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, DeviceGroupCollection)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return count, nil
+}
+
+// AddDeviceProfileRevision adds a new revision of a device profile, numbered after the latest revision of the profile
+func (c *Client) AddDeviceProfileRevision(r pkgModels.DeviceProfileRevision) (pkgModels.DeviceProfileRevision, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(r.Id) == 0 {
+		r.Id = uuid.New().String()
+	}
+
+	return addDeviceProfileRevision(conn, r)
+}
+
+// AddDeviceProfileWithRevision adds a new device profile along with its revision, which is numbered after the latest
+// revision of the profile
+func (c *Client) AddDeviceProfileWithRevision(dp model.DeviceProfile, r pkgModels.DeviceProfileRevision) (model.DeviceProfile, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if dp.Id != "" {
+		_, err := uuid.Parse(dp.Id)
+		if err != nil {
+			return model.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindInvalidId, "ID failed UUID parsing", err)
+		}
+	} else {
+		dp.Id = uuid.New().String()
+	}
+	if len(r.Id) == 0 {
+		r.Id = uuid.New().String()
+	}
+
+	return addDeviceProfileWithRevision(conn, dp, r)
+}
+
+// UpdateDeviceProfileWithRevision updates a device profile along with adding its revision, which is numbered after the
+// latest revision of the profile
+func (c *Client) UpdateDeviceProfileWithRevision(dp model.DeviceProfile, r pkgModels.DeviceProfileRevision) (pkgModels.DeviceProfileRevision, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	if len(r.Id) == 0 {
+		r.Id = uuid.New().String()
+	}
+
+	return updateDeviceProfileWithRevision(conn, dp, r)
+}
+
+// DeviceProfileRevisionByNumber gets the revision of a device profile by revision number
+func (c *Client) DeviceProfileRevisionByNumber(profileName string, revision int64) (r pkgModels.DeviceProfileRevision, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	r, edgeXerr = deviceProfileRevisionByNumber(conn, profileName, revision)
+	if edgeXerr != nil {
+		return r, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return
+}
+
+// DeviceProfileRevisionsByProfileName query the revisions of a device profile with offset and limit, the latest revision first
+func (c *Client) DeviceProfileRevisionsByProfileName(offset int, limit int, profileName string) (revisions []pkgModels.DeviceProfileRevision, edgeXerr errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	revisions, edgeXerr = deviceProfileRevisionsByProfileName(conn, offset, limit, profileName)
+	if edgeXerr != nil {
+		return revisions, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query revisions by offset %d, limit %d and profile name %s", offset, limit, profileName), edgeXerr)
+	}
+	return revisions, nil
+}
+
+// DeviceProfileRevisionCountByProfileName returns the count of the revisions of a device profile from the database
+func (c *Client) DeviceProfileRevisionCountByProfileName(profileName string) (uint32, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer conn.Close()
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, CreateKey(DeviceProfileRevisionCollectionProfileName, profileName))
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return count, nil
+}
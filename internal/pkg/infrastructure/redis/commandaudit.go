@@ -0,0 +1,105 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	CommandAuditCollection           = "cc|audit"
+	CommandAuditCollectionDeviceName = CommandAuditCollection + DBKeySeparator + common.Device + DBKeySeparator + common.Name
+)
+
+// commandAuditRecordStoredKey return the command audit record's stored key which combines the collection name and object id
+func commandAuditRecordStoredKey(id string) string {
+	return CreateKey(CommandAuditCollection, id)
+}
+
+// addCommandAuditRecord adds a new command audit record into DB
+func addCommandAuditRecord(conn redis.Conn, r pkgModels.CommandAuditRecord) errors.EdgeX {
+	m, err := json.Marshal(r)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal command audit record for Redis persistence", err)
+	}
+	storedKey := commandAuditRecordStoredKey(r.Id)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, CommandAuditCollection, r.Created, storedKey)
+	_ = conn.Send(ZADD, CreateKey(CommandAuditCollectionDeviceName, r.DeviceName), r.Created, storedKey)
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "command audit record creation failed", err)
+	}
+	return nil
+}
+
+// commandAuditRecordsByTimeRange query the command audit records of a device, or of all devices when deviceName is
+// empty, by time range, offset, and limit.  The records are sorted by creation time in descending order.
+func commandAuditRecordsByTimeRange(conn redis.Conn, deviceName string, start int, end int, offset int, limit int) (records []pkgModels.CommandAuditRecord, totalCount uint32, edgeXerr errors.EdgeX) {
+	key := CommandAuditCollection
+	if deviceName != "" {
+		key = CreateKey(CommandAuditCollectionDeviceName, deviceName)
+	}
+	totalCount, edgeXerr = getMemberCountByScoreRange(conn, key, start, end)
+	if edgeXerr != nil {
+		return nil, 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	objects, edgeXerr := getObjectsByScoreRange(conn, key, start, end, offset, limit)
+	if edgeXerr != nil {
+		return nil, 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	records = make([]pkgModels.CommandAuditRecord, len(objects))
+	for i, o := range objects {
+		if err := json.Unmarshal(o, &records[i]); err != nil {
+			return nil, 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "command audit record format parsing failed from the database", err)
+		}
+	}
+	return records, totalCount, nil
+}
+
+// trimCommandAuditRecords deletes the oldest command audit records beyond maxCount
+func trimCommandAuditRecords(conn redis.Conn, maxCount int) errors.EdgeX {
+	count, edgeXerr := getMemberNumber(conn, ZCARD, CommandAuditCollection)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	excess := int(count) - maxCount
+	if excess <= 0 {
+		return nil
+	}
+	storedKeys, err := redis.Strings(conn.Do(ZRANGE, CommandAuditCollection, 0, excess-1))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "query oldest command audit records from database failed", err)
+	}
+	objects, err := redis.ByteSlices(conn.Do(MGET, pkgCommon.ConvertStringsToInterfaces(storedKeys)...))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "query oldest command audit records from database failed", err)
+	}
+
+	_ = conn.Send(MULTI)
+	for i, storedKey := range storedKeys {
+		_ = conn.Send(DEL, storedKey)
+		_ = conn.Send(ZREM, CommandAuditCollection, storedKey)
+		// the record may have been deleted concurrently, in which case it can't be removed from its device index
+		var r pkgModels.CommandAuditRecord
+		if objects[i] != nil && json.Unmarshal(objects[i], &r) == nil {
+			_ = conn.Send(ZREM, CreateKey(CommandAuditCollectionDeviceName, r.DeviceName), storedKey)
+		}
+	}
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("deletion of %d command audit records failed", excess), err)
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/gomodule/redigo/redis"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+const (
+	CommandJobCollection     = "cc|job"
+	CommandJobCollectionName = CommandJobCollection + DBKeySeparator + common.Name
+)
+
+// commandJobStoredKey return the command job's stored key which combines the collection name and object id
+func commandJobStoredKey(id string) string {
+	return CreateKey(CommandJobCollection, id)
+}
+
+// sendAddCommandJobCmd sends redis command for adding command job.  The jobs are ordered by creation time, as they
+// are modified on each run.
+func sendAddCommandJobCmd(conn redis.Conn, storedKey string, job models.CommandJob) errors.EdgeX {
+	m, err := json.Marshal(job)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal command job for Redis persistence", err)
+	}
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, CommandJobCollection, job.Created, storedKey)
+	_ = conn.Send(HSET, CommandJobCollectionName, job.Name, storedKey)
+	return nil
+}
+
+// addCommandJob adds a new command job into DB
+func addCommandJob(conn redis.Conn, job models.CommandJob) (models.CommandJob, errors.EdgeX) {
+	exists, edgeXerr := objectIdExists(conn, commandJobStoredKey(job.Id))
+	if edgeXerr != nil {
+		return job, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return job, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("command job id %s already exists", job.Id), edgeXerr)
+	}
+
+	exists, edgeXerr = objectNameExists(conn, CommandJobCollectionName, job.Name)
+	if edgeXerr != nil {
+		return job, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return job, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("command job name %s already exists", job.Name), edgeXerr)
+	}
+
+	ts := pkgCommon.MakeTimestamp()
+	if job.Created == 0 {
+		job.Created = ts
+	}
+	job.Modified = ts
+
+	storedKey := commandJobStoredKey(job.Id)
+	_ = conn.Send(MULTI)
+	edgeXerr = sendAddCommandJobCmd(conn, storedKey, job)
+	if edgeXerr != nil {
+		return job, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "command job creation failed", err)
+	}
+
+	return job, edgeXerr
+}
+
+// commandJobByName query command job by name from DB
+func commandJobByName(conn redis.Conn, name string) (job models.CommandJob, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectByHash(conn, CommandJobCollectionName, name, &job)
+	if edgeXerr != nil {
+		return job, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query command job by name %s", name), edgeXerr)
+	}
+	return
+}
+
+// allCommandJobs queries command jobs by offset and limit
+func allCommandJobs(conn redis.Conn, offset, limit int) (jobs []models.CommandJob, edgeXerr errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, CommandJobCollection, offset, limit)
+	if edgeXerr != nil {
+		return jobs, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	jobs = make([]models.CommandJob, len(objects))
+	for i, o := range objects {
+		j := models.CommandJob{}
+		err := json.Unmarshal(o, &j)
+		if err != nil {
+			return []models.CommandJob{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "command job format parsing failed from the database", err)
+		}
+		jobs[i] = j
+	}
+	return jobs, nil
+}
+
+// sendDeleteCommandJobCmd sends redis command for deleting command job
+func sendDeleteCommandJobCmd(conn redis.Conn, storedKey string, job models.CommandJob) {
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, CommandJobCollection, storedKey)
+	_ = conn.Send(HDEL, CommandJobCollectionName, job.Name)
+}
+
+// deleteCommandJobByName deletes the command job by name
+func deleteCommandJobByName(conn redis.Conn, name string) errors.EdgeX {
+	job, edgeXerr := commandJobByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	storedKey := commandJobStoredKey(job.Id)
+	_ = conn.Send(MULTI)
+	sendDeleteCommandJobCmd(conn, storedKey, job)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "command job deletion failed", err)
+	}
+	return nil
+}
+
+// updateCommandJob updates a command job
+func updateCommandJob(conn redis.Conn, job models.CommandJob) errors.EdgeX {
+	oldJob, edgeXerr := commandJobByName(conn, job.Name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if oldJob.Id != job.Id {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("command job %s with id %s doesn't exist", job.Name, job.Id), nil)
+	}
+
+	job.Modified = pkgCommon.MakeTimestamp()
+	storedKey := commandJobStoredKey(job.Id)
+	_ = conn.Send(MULTI)
+	sendDeleteCommandJobCmd(conn, storedKey, oldJob)
+	edgeXerr = sendAddCommandJobCmd(conn, storedKey, job)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "command job update failed", err)
+	}
+
+	return nil
+}
@@ -19,6 +19,7 @@ const (
 	HDEL             = "HDEL"
 	SADD             = "SADD"
 	SREM             = "SREM"
+	SISMEMBER        = "SISMEMBER"
 	ZADD             = "ZADD"
 	ZREM             = "ZREM"
 	EXEC             = "EXEC"
@@ -30,14 +31,22 @@ const (
 	UNLINK           = "UNLINK"
 	ZRANGEBYSCORE    = "ZRANGEBYSCORE"
 	ZREVRANGEBYSCORE = "ZREVRANGEBYSCORE"
+	ZREVRANGEBYLEX   = "ZREVRANGEBYLEX"
 	LIMIT            = "LIMIT"
+	WITHSCORES       = "WITHSCORES"
 	ZUNIONSTORE      = "ZUNIONSTORE"
 	ZINTERSTORE      = "ZINTERSTORE"
+	ZSCAN            = "ZSCAN"
+	COUNT            = "COUNT"
+	WATCH            = "WATCH"
 )
 
 const (
 	InfiniteMin     = "-inf"
 	InfiniteMax     = "+inf"
+	InfiniteLexMin  = "-"
+	InfiniteLexMax  = "+"
 	GreaterThanZero = "(0"
 	DBKeySeparator  = ":"
+	LabelKey        = "labelkey"
 )
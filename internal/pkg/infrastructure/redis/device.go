@@ -10,6 +10,8 @@ import (
 	"fmt"
 
 	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/labelselector"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
@@ -22,6 +24,7 @@ const (
 	DeviceCollection            = "md|dv"
 	DeviceCollectionName        = DeviceCollection + DBKeySeparator + common.Name
 	DeviceCollectionLabel       = DeviceCollection + DBKeySeparator + common.Label
+	DeviceCollectionLabelKey    = DeviceCollection + DBKeySeparator + LabelKey
 	DeviceCollectionServiceName = DeviceCollection + DBKeySeparator + common.Service + DBKeySeparator + common.Name
 	DeviceCollectionProfileName = DeviceCollection + DBKeySeparator + common.Profile + DBKeySeparator + common.Name
 )
@@ -63,6 +66,7 @@ func sendAddDeviceCmd(conn redis.Conn, storedKey string, d models.Device) errors
 	for _, label := range d.Labels {
 		_ = conn.Send(ZADD, CreateKey(DeviceCollectionLabel, label), d.Modified, storedKey)
 	}
+	sendAddLabelKeysCmd(conn, DeviceCollectionLabelKey, d.Labels, d.Modified, storedKey)
 	return nil
 }
 
@@ -163,6 +167,7 @@ func sendDeleteDeviceCmd(conn redis.Conn, storedKey string, device models.Device
 	for _, label := range device.Labels {
 		_ = conn.Send(ZREM, CreateKey(DeviceCollectionLabel, label), storedKey)
 	}
+	sendDeleteLabelKeysCmd(conn, DeviceCollectionLabelKey, device.Labels, storedKey)
 }
 
 // deleteDevice deletes a device
@@ -215,6 +220,25 @@ func devicesByLabels(conn redis.Conn, offset int, limit int, labels []string) (d
 	return devices, nil
 }
 
+// devicesBeforeCursor query, in the order of the queries by offset, at most limit devices positioned after the cursor
+func devicesBeforeCursor(conn redis.Conn, cursor pkgModels.Cursor, limit int) (devices []models.Device, next pkgModels.Cursor, edgeXerr errors.EdgeX) {
+	objects, next, edgeXerr := getObjectsBeforeMemberCursor(conn, DeviceCollection, DeviceCollection, cursor, limit)
+	if edgeXerr != nil {
+		return nil, cursor, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	devices = make([]models.Device, len(objects))
+	for i, in := range objects {
+		dp := models.Device{}
+		err := json.Unmarshal(in, &dp)
+		if err != nil {
+			return nil, cursor, errors.NewCommonEdgeX(errors.KindDatabaseError, "device format parsing failed from the database", err)
+		}
+		devices[i] = dp
+	}
+	return devices, next, nil
+}
+
 // devicesByProfileName query devices by offset, limit and profile name
 func devicesByProfileName(conn redis.Conn, offset int, limit int, profileName string) (devices []models.Device, edgeXerr errors.EdgeX) {
 	objects, err := getObjectsByRevRange(conn, CreateKey(DeviceCollectionProfileName, profileName), offset, limit)
@@ -264,3 +288,23 @@ func updateDevice(conn redis.Conn, d models.Device) errors.EdgeX {
 
 	return nil
 }
+
+// devicesByLabelSelector query devices whose labels satisfy the label selector with offset and limit, and returns the total count of
+// the selected devices
+func devicesByLabelSelector(conn redis.Conn, offset int, limit int, selector labelselector.Selector) (devices []models.Device, totalCount uint32, edgeXerr errors.EdgeX) {
+	objects, totalCount, edgeXerr := getObjectsByLabelSelector(conn, ZREVRANGE, DeviceCollection, selector, offset, limit)
+	if edgeXerr != nil {
+		return devices, totalCount, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	devices = make([]models.Device, len(objects))
+	for i, in := range objects {
+		o := models.Device{}
+		err := json.Unmarshal(in, &o)
+		if err != nil {
+			return []models.Device{}, totalCount, errors.NewCommonEdgeX(errors.KindDatabaseError, "device format parsing failed from the database", err)
+		}
+		devices[i] = o
+	}
+	return devices, totalCount, nil
+}
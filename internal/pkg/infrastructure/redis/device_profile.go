@@ -10,6 +10,8 @@ import (
 	"fmt"
 
 	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/labelselector"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
@@ -22,6 +24,7 @@ const (
 	DeviceProfileCollection             = "md|dp"
 	DeviceProfileCollectionName         = DeviceProfileCollection + DBKeySeparator + common.Name
 	DeviceProfileCollectionLabel        = DeviceProfileCollection + DBKeySeparator + common.Label
+	DeviceProfileCollectionLabelKey     = DeviceProfileCollection + DBKeySeparator + LabelKey
 	DeviceProfileCollectionModel        = DeviceProfileCollection + DBKeySeparator + common.Model
 	DeviceProfileCollectionManufacturer = DeviceProfileCollection + DBKeySeparator + common.Manufacturer
 )
@@ -63,11 +66,12 @@ func sendAddDeviceProfileCmd(conn redis.Conn, storedKey string, dp models.Device
 	for _, label := range dp.Labels {
 		_ = conn.Send(ZADD, CreateKey(DeviceProfileCollectionLabel, label), dp.Modified, storedKey)
 	}
+	sendAddLabelKeysCmd(conn, DeviceProfileCollectionLabelKey, dp.Labels, dp.Modified, storedKey)
 	return nil
 }
 
-// addDeviceProfile adds a device profile to DB
-func addDeviceProfile(conn redis.Conn, dp models.DeviceProfile) (models.DeviceProfile, errors.EdgeX) {
+// deviceProfileToAdd checks the device profile to add doesn't conflict with the existing ones, and sets its timestamps
+func deviceProfileToAdd(conn redis.Conn, dp models.DeviceProfile) (models.DeviceProfile, errors.EdgeX) {
 	// query device profile name and id to avoid the conflict
 	exists, edgeXerr := deviceProfileIdExists(conn, dp.Id)
 	if edgeXerr != nil {
@@ -90,6 +94,15 @@ func addDeviceProfile(conn redis.Conn, dp models.DeviceProfile) (models.DevicePr
 		dp.Created = ts
 	}
 	dp.Modified = ts
+	return dp, nil
+}
+
+// addDeviceProfile adds a device profile to DB
+func addDeviceProfile(conn redis.Conn, dp models.DeviceProfile) (models.DeviceProfile, errors.EdgeX) {
+	dp, edgeXerr := deviceProfileToAdd(conn, dp)
+	if edgeXerr != nil {
+		return dp, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
 
 	storedKey := deviceProfileStoredKey(dp.Id)
 	_ = conn.Send(MULTI)
@@ -133,6 +146,7 @@ func sendDeleteDeviceProfileCmd(conn redis.Conn, storedKey string, dp models.Dev
 	for _, label := range dp.Labels {
 		_ = conn.Send(ZREM, CreateKey(DeviceProfileCollectionLabel, label), storedKey)
 	}
+	sendDeleteLabelKeysCmd(conn, DeviceProfileCollectionLabelKey, dp.Labels, storedKey)
 }
 
 func deleteDeviceProfile(conn redis.Conn, dp models.DeviceProfile) errors.EdgeX {
@@ -146,24 +160,33 @@ func deleteDeviceProfile(conn redis.Conn, dp models.DeviceProfile) errors.EdgeX
 	return nil
 }
 
-// updateDeviceProfile updates a device profile to DB
-func updateDeviceProfile(conn redis.Conn, dp models.DeviceProfile) (edgeXerr errors.EdgeX) {
-	var oldDeviceProfile models.DeviceProfile
-	oldDeviceProfile, edgeXerr = deviceProfileById(conn, dp.Id)
+// deviceProfileToUpdate returns the stored device profile replaced by the updated one, along with the updated device
+// profile taking the id and creation timestamp of the stored one
+func deviceProfileToUpdate(conn redis.Conn, dp models.DeviceProfile) (models.DeviceProfile, models.DeviceProfile, errors.EdgeX) {
+	oldDeviceProfile, edgeXerr := deviceProfileById(conn, dp.Id)
 	if edgeXerr == nil {
 		if dp.Name != oldDeviceProfile.Name {
-			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device profile name '%s' not match the exsting '%s' ", dp.Name, oldDeviceProfile.Name), nil)
+			return oldDeviceProfile, dp, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device profile name '%s' not match the exsting '%s' ", dp.Name, oldDeviceProfile.Name), nil)
 		}
 	} else {
 		oldDeviceProfile, edgeXerr = deviceProfileByName(conn, dp.Name)
 		if edgeXerr != nil {
-			return errors.NewCommonEdgeXWrapper(edgeXerr)
+			return oldDeviceProfile, dp, errors.NewCommonEdgeXWrapper(edgeXerr)
 		}
 	}
 
 	dp.Id = oldDeviceProfile.Id
 	dp.Created = oldDeviceProfile.Created
 	dp.Modified = pkgCommon.MakeTimestamp()
+	return oldDeviceProfile, dp, nil
+}
+
+// updateDeviceProfile updates a device profile to DB
+func updateDeviceProfile(conn redis.Conn, dp models.DeviceProfile) (edgeXerr errors.EdgeX) {
+	oldDeviceProfile, dp, edgeXerr := deviceProfileToUpdate(conn, dp)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
 
 	storedKey := deviceProfileStoredKey(dp.Id)
 	_ = conn.Send(MULTI)
@@ -180,6 +203,68 @@ func updateDeviceProfile(conn redis.Conn, dp models.DeviceProfile) (edgeXerr err
 	return nil
 }
 
+// addDeviceProfileWithRevision adds a device profile to DB, recording its revision in the same transaction
+func addDeviceProfileWithRevision(conn redis.Conn, dp models.DeviceProfile, r pkgModels.DeviceProfileRevision) (models.DeviceProfile, errors.EdgeX) {
+	edgeXerr := watchDeviceProfileRevisions(conn, r)
+	if edgeXerr != nil {
+		return dp, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	dp, edgeXerr = deviceProfileToAdd(conn, dp)
+	if edgeXerr != nil {
+		return dp, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	r.Profile = dp
+	r.Created = dp.Modified
+
+	_ = conn.Send(MULTI)
+	edgeXerr = sendAddDeviceProfileCmd(conn, deviceProfileStoredKey(dp.Id), dp)
+	if edgeXerr != nil {
+		return dp, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	edgeXerr = sendAddDeviceProfileRevisionCmd(conn, r)
+	if edgeXerr != nil {
+		return dp, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	edgeXerr = execDeviceProfileRevisionTransaction(conn, dp.Name, "device profile creation failed")
+	if edgeXerr != nil {
+		return dp, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return dp, nil
+}
+
+// updateDeviceProfileWithRevision updates a device profile to DB, recording its revision in the same transaction
+func updateDeviceProfileWithRevision(conn redis.Conn, dp models.DeviceProfile, r pkgModels.DeviceProfileRevision) (pkgModels.DeviceProfileRevision, errors.EdgeX) {
+	edgeXerr := watchDeviceProfileRevisions(conn, r)
+	if edgeXerr != nil {
+		return r, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	oldDeviceProfile, dp, edgeXerr := deviceProfileToUpdate(conn, dp)
+	if edgeXerr != nil {
+		return r, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	r.Profile = dp
+	r.Created = dp.Modified
+
+	storedKey := deviceProfileStoredKey(dp.Id)
+	_ = conn.Send(MULTI)
+	sendDeleteDeviceProfileCmd(conn, storedKey, oldDeviceProfile)
+	edgeXerr = sendAddDeviceProfileCmd(conn, storedKey, dp)
+	if edgeXerr != nil {
+		return r, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	edgeXerr = sendAddDeviceProfileRevisionCmd(conn, r)
+	if edgeXerr != nil {
+		return r, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	edgeXerr = execDeviceProfileRevisionTransaction(conn, dp.Name, "device profile update failed")
+	if edgeXerr != nil {
+		return r, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return r, nil
+}
+
 // deleteDeviceProfileById deletes the device profile by id
 func deleteDeviceProfileById(conn redis.Conn, id string) errors.EdgeX {
 	deviceProfile, err := deviceProfileById(conn, id)
@@ -242,6 +327,25 @@ func deviceProfilesByLabels(conn redis.Conn, offset int, limit int, labels []str
 	return deviceProfiles, nil
 }
 
+// deviceProfilesBeforeCursor query, in the order of the queries by offset, at most limit device profiles positioned after the cursor
+func deviceProfilesBeforeCursor(conn redis.Conn, cursor pkgModels.Cursor, limit int) (deviceProfiles []models.DeviceProfile, next pkgModels.Cursor, edgeXerr errors.EdgeX) {
+	objects, next, edgeXerr := getObjectsBeforeMemberCursor(conn, DeviceProfileCollection, DeviceProfileCollection, cursor, limit)
+	if edgeXerr != nil {
+		return nil, cursor, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	deviceProfiles = make([]models.DeviceProfile, len(objects))
+	for i, in := range objects {
+		dp := models.DeviceProfile{}
+		err := json.Unmarshal(in, &dp)
+		if err != nil {
+			return nil, cursor, errors.NewCommonEdgeX(errors.KindDatabaseError, "device profile format parsing failed from the database", err)
+		}
+		deviceProfiles[i] = dp
+	}
+	return deviceProfiles, next, nil
+}
+
 // deviceProfilesByModel query device profiles by offset, limit and model
 func deviceProfilesByModel(conn redis.Conn, offset int, limit int, model string) (deviceProfiles []models.DeviceProfile, edgeXerr errors.EdgeX) {
 	objects, err := getObjectsByRevRange(conn, CreateKey(DeviceProfileCollectionModel, model), offset, limit)
@@ -332,3 +436,23 @@ func deviceProfilesByManufacturerAndModel(conn redis.Conn, offset int, limit int
 	}
 	return deviceProfiles, totalCount, nil
 }
+
+// deviceProfilesByLabelSelector query device profiles whose labels satisfy the label selector with offset and limit, and returns the total count of
+// the selected device profiles
+func deviceProfilesByLabelSelector(conn redis.Conn, offset int, limit int, selector labelselector.Selector) (deviceProfiles []models.DeviceProfile, totalCount uint32, edgeXerr errors.EdgeX) {
+	objects, totalCount, edgeXerr := getObjectsByLabelSelector(conn, ZREVRANGE, DeviceProfileCollection, selector, offset, limit)
+	if edgeXerr != nil {
+		return deviceProfiles, totalCount, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	deviceProfiles = make([]models.DeviceProfile, len(objects))
+	for i, in := range objects {
+		o := models.DeviceProfile{}
+		err := json.Unmarshal(in, &o)
+		if err != nil {
+			return []models.DeviceProfile{}, totalCount, errors.NewCommonEdgeX(errors.KindDatabaseError, "device profile format parsing failed from the database", err)
+		}
+		deviceProfiles[i] = o
+	}
+	return deviceProfiles, totalCount, nil
+}
@@ -10,6 +10,8 @@ import (
 	"fmt"
 
 	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/labelselector"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
@@ -19,9 +21,10 @@ import (
 )
 
 const (
-	DeviceServiceCollection      = "md|ds"
-	DeviceServiceCollectionName  = DeviceServiceCollection + DBKeySeparator + common.Name
-	DeviceServiceCollectionLabel = DeviceServiceCollection + DBKeySeparator + common.Label
+	DeviceServiceCollection         = "md|ds"
+	DeviceServiceCollectionName     = DeviceServiceCollection + DBKeySeparator + common.Name
+	DeviceServiceCollectionLabel    = DeviceServiceCollection + DBKeySeparator + common.Label
+	DeviceServiceCollectionLabelKey = DeviceServiceCollection + DBKeySeparator + LabelKey
 )
 
 // deviceServiceStoredKey return the device service's stored key which combines the collection name and object id
@@ -53,6 +56,7 @@ func sendAddDeviceServiceCmd(conn redis.Conn, storedKey string, ds models.Device
 	for _, label := range ds.Labels { // Store the redisKey into Sorted Set of labels with Modified as the score for order
 		_ = conn.Send(ZADD, CreateKey(DeviceServiceCollectionLabel, label), ds.Modified, storedKey)
 	}
+	sendAddLabelKeysCmd(conn, DeviceServiceCollectionLabelKey, ds.Labels, ds.Modified, storedKey)
 	return nil
 }
 
@@ -125,6 +129,7 @@ func sendDeleteDeviceServiceCmd(conn redis.Conn, storedKey string, ds models.Dev
 	for _, label := range ds.Labels {
 		_ = conn.Send(ZREM, CreateKey(DeviceServiceCollectionLabel, label), storedKey)
 	}
+	sendDeleteLabelKeysCmd(conn, DeviceServiceCollectionLabelKey, ds.Labels, storedKey)
 }
 
 func deleteDeviceService(conn redis.Conn, ds models.DeviceService) errors.EdgeX {
@@ -200,6 +205,25 @@ func deviceServicesByLabels(conn redis.Conn, offset int, limit int, labels []str
 	return deviceServices, nil
 }
 
+// deviceServicesBeforeCursor query, in the order of the queries by offset, at most limit device services positioned after the cursor
+func deviceServicesBeforeCursor(conn redis.Conn, cursor pkgModels.Cursor, limit int) (deviceServices []models.DeviceService, next pkgModels.Cursor, edgeXerr errors.EdgeX) {
+	objects, next, edgeXerr := getObjectsBeforeCursor(conn, DeviceServiceCollection, DeviceServiceCollection, cursor, limit)
+	if edgeXerr != nil {
+		return nil, cursor, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	deviceServices = make([]models.DeviceService, len(objects))
+	for i, in := range objects {
+		s := models.DeviceService{}
+		err := json.Unmarshal(in, &s)
+		if err != nil {
+			return nil, cursor, errors.NewCommonEdgeX(errors.KindDatabaseError, "device service format parsing failed from the database", err)
+		}
+		deviceServices[i] = s
+	}
+	return deviceServices, next, nil
+}
+
 func updateDeviceService(conn redis.Conn, ds models.DeviceService) errors.EdgeX {
 	oldDeviceService, edgeXerr := deviceServiceByName(conn, ds.Name)
 	if edgeXerr != nil {
@@ -221,3 +245,23 @@ func updateDeviceService(conn redis.Conn, ds models.DeviceService) errors.EdgeX
 
 	return nil
 }
+
+// deviceServicesByLabelSelector query device services whose labels satisfy the label selector with offset and limit, and returns the total count of
+// the selected device services
+func deviceServicesByLabelSelector(conn redis.Conn, offset int, limit int, selector labelselector.Selector) (deviceServices []models.DeviceService, totalCount uint32, edgeXerr errors.EdgeX) {
+	objects, totalCount, edgeXerr := getObjectsByLabelSelector(conn, ZREVRANGE, DeviceServiceCollection, selector, offset, limit)
+	if edgeXerr != nil {
+		return deviceServices, totalCount, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	deviceServices = make([]models.DeviceService, len(objects))
+	for i, in := range objects {
+		o := models.DeviceService{}
+		err := json.Unmarshal(in, &o)
+		if err != nil {
+			return []models.DeviceService{}, totalCount, errors.NewCommonEdgeX(errors.KindDatabaseError, "device service format parsing failed from the database", err)
+		}
+		deviceServices[i] = o
+	}
+	return deviceServices, totalCount, nil
+}
@@ -0,0 +1,169 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/gomodule/redigo/redis"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+const (
+	DeviceGroupCollection     = "md|dg"
+	DeviceGroupCollectionName = DeviceGroupCollection + DBKeySeparator + common.Name
+)
+
+// deviceGroupStoredKey return the device group's stored key which combines the collection name and object id
+func deviceGroupStoredKey(id string) string {
+	return CreateKey(DeviceGroupCollection, id)
+}
+
+// deviceGroupNameExists whether the device group exists by name
+func deviceGroupNameExists(conn redis.Conn, name string) (bool, errors.EdgeX) {
+	exists, err := objectNameExists(conn, DeviceGroupCollectionName, name)
+	if err != nil {
+		return false, errors.NewCommonEdgeXWrapper(err)
+	}
+	return exists, nil
+}
+
+// sendAddDeviceGroupCmd sends redis command for adding device group
+func sendAddDeviceGroupCmd(conn redis.Conn, storedKey string, g models.DeviceGroup) errors.EdgeX {
+	m, err := json.Marshal(g)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal device group for Redis persistence", err)
+	}
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, DeviceGroupCollection, g.Created, storedKey)
+	_ = conn.Send(HSET, DeviceGroupCollectionName, g.Name, storedKey)
+	return nil
+}
+
+// addDeviceGroup adds a new device group into DB
+func addDeviceGroup(conn redis.Conn, g models.DeviceGroup) (models.DeviceGroup, errors.EdgeX) {
+	exists, edgeXerr := objectIdExists(conn, deviceGroupStoredKey(g.Id))
+	if edgeXerr != nil {
+		return g, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return g, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device group id %s already exists", g.Id), edgeXerr)
+	}
+
+	exists, edgeXerr = deviceGroupNameExists(conn, g.Name)
+	if edgeXerr != nil {
+		return g, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return g, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device group name %s already exists", g.Name), edgeXerr)
+	}
+
+	ts := pkgCommon.MakeTimestamp()
+	if g.Created == 0 {
+		g.Created = ts
+	}
+	g.Modified = ts
+
+	storedKey := deviceGroupStoredKey(g.Id)
+	_ = conn.Send(MULTI)
+	edgeXerr = sendAddDeviceGroupCmd(conn, storedKey, g)
+	if edgeXerr != nil {
+		return g, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "device group creation failed", err)
+	}
+
+	return g, edgeXerr
+}
+
+// deviceGroupById query device group by id from DB
+func deviceGroupById(conn redis.Conn, id string) (g models.DeviceGroup, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectById(conn, deviceGroupStoredKey(id), &g)
+	if edgeXerr != nil {
+		return g, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return
+}
+
+// deviceGroupByName query device group by name from DB
+func deviceGroupByName(conn redis.Conn, name string) (g models.DeviceGroup, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectByHash(conn, DeviceGroupCollectionName, name, &g)
+	if edgeXerr != nil {
+		return g, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query device group by name %s", name), edgeXerr)
+	}
+	return
+}
+
+// allDeviceGroups queries device groups by offset and limit
+func allDeviceGroups(conn redis.Conn, offset, limit int) (groups []models.DeviceGroup, edgeXerr errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, DeviceGroupCollection, offset, limit)
+	if edgeXerr != nil {
+		return groups, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	groups = make([]models.DeviceGroup, len(objects))
+	for i, o := range objects {
+		g := models.DeviceGroup{}
+		err := json.Unmarshal(o, &g)
+		if err != nil {
+			return []models.DeviceGroup{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "device group format parsing failed from the database", err)
+		}
+		groups[i] = g
+	}
+	return groups, nil
+}
+
+// sendDeleteDeviceGroupCmd sends redis command for deleting device group
+func sendDeleteDeviceGroupCmd(conn redis.Conn, storedKey string, g models.DeviceGroup) {
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, DeviceGroupCollection, storedKey)
+	_ = conn.Send(HDEL, DeviceGroupCollectionName, g.Name)
+}
+
+// deleteDeviceGroupByName deletes the device group by name
+func deleteDeviceGroupByName(conn redis.Conn, name string) errors.EdgeX {
+	g, edgeXerr := deviceGroupByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	storedKey := deviceGroupStoredKey(g.Id)
+	_ = conn.Send(MULTI)
+	sendDeleteDeviceGroupCmd(conn, storedKey, g)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device group deletion failed", err)
+	}
+	return nil
+}
+
+// updateDeviceGroup updates a device group
+func updateDeviceGroup(conn redis.Conn, g models.DeviceGroup) errors.EdgeX {
+	oldGroup, edgeXerr := deviceGroupById(conn, g.Id)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if oldGroup.Name != g.Name {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device group name %s cannot be changed", oldGroup.Name), nil)
+	}
+
+	g.Modified = pkgCommon.MakeTimestamp()
+	storedKey := deviceGroupStoredKey(g.Id)
+	_ = conn.Send(MULTI)
+	sendDeleteDeviceGroupCmd(conn, storedKey, oldGroup)
+	edgeXerr = sendAddDeviceGroupCmd(conn, storedKey, g)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device group update failed", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/gomodule/redigo/redis"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+const (
+	DeviceProfileRevisionCollection            = "md|dpr"
+	DeviceProfileRevisionCollectionProfileName = DeviceProfileRevisionCollection + DBKeySeparator + common.Profile + DBKeySeparator + common.Name
+)
+
+// deviceProfileRevisionStoredKey return the device profile revision's stored key which combines the collection name and object id
+func deviceProfileRevisionStoredKey(id string) string {
+	return CreateKey(DeviceProfileRevisionCollection, id)
+}
+
+// watchDeviceProfileRevisions watches the revisions of a device profile, so that the transaction recording the
+// revision fails if another revision of the profile is recorded meanwhile, and checks the revision is numbered after
+// the latest revision of the profile, which its changes are computed from.  The revisions are never modified, and are
+// kept when the profile is deleted so that the numbering goes on if the profile is added again.
+func watchDeviceProfileRevisions(conn redis.Conn, r models.DeviceProfileRevision) errors.EdgeX {
+	_, err := conn.Do(WATCH, CreateKey(DeviceProfileRevisionCollectionProfileName, r.ProfileName))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("fail to watch the revisions of device profile %s", r.ProfileName), err)
+	}
+	latest, edgeXerr := deviceProfileRevisionsByProfileName(conn, 0, 1, r.ProfileName)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	var latestRevision int64
+	if len(latest) > 0 {
+		latestRevision = latest[0].Revision
+	}
+	if r.Revision != latestRevision+1 {
+		return errors.NewCommonEdgeX(errors.KindStatusConflict,
+			fmt.Sprintf("device profile %s was changed concurrently, its latest revision is %d", r.ProfileName, latestRevision), nil)
+	}
+	return nil
+}
+
+// sendAddDeviceProfileRevisionCmd send redis command for adding device profile revision
+func sendAddDeviceProfileRevisionCmd(conn redis.Conn, r models.DeviceProfileRevision) errors.EdgeX {
+	m, err := json.Marshal(r)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal device profile revision for Redis persistence", err)
+	}
+	storedKey := deviceProfileRevisionStoredKey(r.Id)
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, CreateKey(DeviceProfileRevisionCollectionProfileName, r.ProfileName), r.Revision, storedKey)
+	return nil
+}
+
+// execDeviceProfileRevisionTransaction executes the transaction recording a revision of the device profile, which is
+// discarded when another revision of the profile was recorded since its revisions were watched
+func execDeviceProfileRevisionTransaction(conn redis.Conn, profileName string, failure string) errors.EdgeX {
+	reply, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, failure, err)
+	} else if reply == nil {
+		return errors.NewCommonEdgeX(errors.KindStatusConflict, fmt.Sprintf("device profile %s was changed concurrently", profileName), nil)
+	}
+	return nil
+}
+
+// addDeviceProfileRevision adds a new revision of a device profile into DB, see watchDeviceProfileRevisions for its
+// numbering
+func addDeviceProfileRevision(conn redis.Conn, r models.DeviceProfileRevision) (models.DeviceProfileRevision, errors.EdgeX) {
+	exists, edgeXerr := objectIdExists(conn, deviceProfileRevisionStoredKey(r.Id))
+	if edgeXerr != nil {
+		return r, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return r, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device profile revision id %s already exists", r.Id), edgeXerr)
+	}
+
+	edgeXerr = watchDeviceProfileRevisions(conn, r)
+	if edgeXerr != nil {
+		return r, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if r.Created == 0 {
+		r.Created = pkgCommon.MakeTimestamp()
+	}
+
+	_ = conn.Send(MULTI)
+	edgeXerr = sendAddDeviceProfileRevisionCmd(conn, r)
+	if edgeXerr != nil {
+		return r, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	edgeXerr = execDeviceProfileRevisionTransaction(conn, r.ProfileName, "device profile revision creation failed")
+	if edgeXerr != nil {
+		return r, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return r, nil
+}
+
+// deviceProfileRevisionByNumber query the revision of a device profile by revision number from DB
+func deviceProfileRevisionByNumber(conn redis.Conn, profileName string, revision int64) (r models.DeviceProfileRevision, edgeXerr errors.EdgeX) {
+	objects, edgeXerr := getObjectsByScoreRange(conn, CreateKey(DeviceProfileRevisionCollectionProfileName, profileName), int(revision), int(revision), 0, 1)
+	if edgeXerr != nil {
+		return r, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if len(objects) == 0 {
+		return r, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("revision %d of device profile %s doesn't exist in the database", revision, profileName), nil)
+	}
+	err := json.Unmarshal(objects[0], &r)
+	if err != nil {
+		return r, errors.NewCommonEdgeX(errors.KindDatabaseError, "device profile revision format parsing failed from the database", err)
+	}
+	return r, nil
+}
+
+// deviceProfileRevisionsByProfileName query the revisions of a device profile by offset and limit from DB, the latest
+// revision first
+func deviceProfileRevisionsByProfileName(conn redis.Conn, offset int, limit int, profileName string) (revisions []models.DeviceProfileRevision, edgeXerr errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, CreateKey(DeviceProfileRevisionCollectionProfileName, profileName), offset, limit)
+	if edgeXerr != nil {
+		return revisions, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	revisions = make([]models.DeviceProfileRevision, len(objects))
+	for i, o := range objects {
+		r := models.DeviceProfileRevision{}
+		err := json.Unmarshal(o, &r)
+		if err != nil {
+			return []models.DeviceProfileRevision{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "device profile revision format parsing failed from the database", err)
+		}
+		revisions[i] = r
+	}
+	return revisions, nil
+}
@@ -0,0 +1,71 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	contractsModels "github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+const testRevisionProfileName = "revision-profile"
+
+func TestUpdateDeviceProfileWithRevision(t *testing.T) {
+	profile := contractsModels.DeviceProfile{
+		Id:          "3c9a5d2e-8f1b-4b7c-9e6d-0a1b2c3d4e01",
+		Name:        testRevisionProfileName,
+		Description: "before the update",
+	}
+
+	tests := []struct {
+		name         string
+		revision     int64
+		conflict     bool
+		expectedKind errors.ErrKind
+	}{
+		{"updated", 2, false, ""},
+		{"not numbered after the latest revision", 1, false, errors.KindStatusConflict},
+		{"revision recorded concurrently", 2, true, errors.KindStatusConflict},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			conn := newFakeConn()
+			_, err := addDeviceProfileWithRevision(conn, profile, models.DeviceProfileRevision{
+				Id: "3c9a5d2e-8f1b-4b7c-9e6d-0a1b2c3d4e02", ProfileName: testRevisionProfileName, Revision: 1,
+			})
+			require.NoError(t, err)
+
+			updated := profile
+			updated.Description = "after the update"
+			conn.conflict = testCase.conflict
+			revision, err := updateDeviceProfileWithRevision(conn, updated, models.DeviceProfileRevision{
+				Id: "3c9a5d2e-8f1b-4b7c-9e6d-0a1b2c3d4e03", ProfileName: testRevisionProfileName, Revision: testCase.revision,
+			})
+
+			stored, edgeXerr := deviceProfileByName(conn, testRevisionProfileName)
+			require.NoError(t, edgeXerr)
+			revisions, edgeXerr := deviceProfileRevisionsByProfileName(conn, 0, -1, testRevisionProfileName)
+			require.NoError(t, edgeXerr)
+			if testCase.expectedKind != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.expectedKind, errors.Kind(err))
+				// neither the profile nor its revisions are changed
+				assert.Equal(t, profile.Description, stored.Description)
+				require.Len(t, revisions, 1)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, updated.Description, stored.Description)
+			assert.Equal(t, stored, revision.Profile)
+			require.Len(t, revisions, 2)
+			assert.Equal(t, int64(2), revisions[0].Revision)
+			assert.Equal(t, updated.Description, revisions[0].Profile.Description)
+		})
+	}
+}
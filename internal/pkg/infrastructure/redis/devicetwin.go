@@ -0,0 +1,161 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/gomodule/redigo/redis"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+const (
+	DeviceTwinCollection           = "md|dtw"
+	DeviceTwinCollectionDeviceName = DeviceTwinCollection + DBKeySeparator + common.Device + DBKeySeparator + common.Name
+)
+
+// deviceTwinStoredKey return the device twin's stored key which combines the collection name and object id
+func deviceTwinStoredKey(id string) string {
+	return CreateKey(DeviceTwinCollection, id)
+}
+
+// sendAddDeviceTwinCmd sends redis command for adding device twin.  The twins are ordered by creation time, as they
+// are modified on each event of their device.
+func sendAddDeviceTwinCmd(conn redis.Conn, storedKey string, twin models.DeviceTwin) errors.EdgeX {
+	m, err := json.Marshal(twin)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal device twin for Redis persistence", err)
+	}
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, DeviceTwinCollection, twin.Created, storedKey)
+	_ = conn.Send(HSET, DeviceTwinCollectionDeviceName, twin.DeviceName, storedKey)
+	return nil
+}
+
+// addDeviceTwin adds a new device twin into DB
+func addDeviceTwin(conn redis.Conn, twin models.DeviceTwin) (models.DeviceTwin, errors.EdgeX) {
+	exists, edgeXerr := objectIdExists(conn, deviceTwinStoredKey(twin.Id))
+	if edgeXerr != nil {
+		return twin, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return twin, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device twin id %s already exists", twin.Id), edgeXerr)
+	}
+
+	exists, edgeXerr = deviceTwinExists(conn, twin.DeviceName)
+	if edgeXerr != nil {
+		return twin, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return twin, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device twin of device %s already exists", twin.DeviceName), edgeXerr)
+	}
+
+	ts := pkgCommon.MakeTimestamp()
+	if twin.Created == 0 {
+		twin.Created = ts
+	}
+	twin.Modified = ts
+
+	storedKey := deviceTwinStoredKey(twin.Id)
+	_ = conn.Send(MULTI)
+	edgeXerr = sendAddDeviceTwinCmd(conn, storedKey, twin)
+	if edgeXerr != nil {
+		return twin, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "device twin creation failed", err)
+	}
+
+	return twin, edgeXerr
+}
+
+// deviceTwinByDeviceName query device twin by device name from DB
+func deviceTwinByDeviceName(conn redis.Conn, deviceName string) (twin models.DeviceTwin, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectByHash(conn, DeviceTwinCollectionDeviceName, deviceName, &twin)
+	if edgeXerr != nil {
+		return twin, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query device twin by device name %s", deviceName), edgeXerr)
+	}
+	return
+}
+
+// allDeviceTwins queries device twins by offset and limit
+func allDeviceTwins(conn redis.Conn, offset, limit int) (twins []models.DeviceTwin, edgeXerr errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRevRange(conn, DeviceTwinCollection, offset, limit)
+	if edgeXerr != nil {
+		return twins, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	twins = make([]models.DeviceTwin, len(objects))
+	for i, o := range objects {
+		t := models.DeviceTwin{}
+		err := json.Unmarshal(o, &t)
+		if err != nil {
+			return []models.DeviceTwin{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "device twin format parsing failed from the database", err)
+		}
+		twins[i] = t
+	}
+	return twins, nil
+}
+
+// sendDeleteDeviceTwinCmd sends redis command for deleting device twin
+func sendDeleteDeviceTwinCmd(conn redis.Conn, storedKey string, twin models.DeviceTwin) {
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, DeviceTwinCollection, storedKey)
+	_ = conn.Send(HDEL, DeviceTwinCollectionDeviceName, twin.DeviceName)
+}
+
+// deleteDeviceTwinByDeviceName deletes the device twin by device name
+func deleteDeviceTwinByDeviceName(conn redis.Conn, deviceName string) errors.EdgeX {
+	twin, edgeXerr := deviceTwinByDeviceName(conn, deviceName)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	storedKey := deviceTwinStoredKey(twin.Id)
+	_ = conn.Send(MULTI)
+	sendDeleteDeviceTwinCmd(conn, storedKey, twin)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device twin deletion failed", err)
+	}
+	return nil
+}
+
+// updateDeviceTwin updates a device twin
+func updateDeviceTwin(conn redis.Conn, twin models.DeviceTwin) errors.EdgeX {
+	oldTwin, edgeXerr := deviceTwinByDeviceName(conn, twin.DeviceName)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if oldTwin.Id != twin.Id {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device twin of device %s with id %s doesn't exist", twin.DeviceName, twin.Id), nil)
+	}
+
+	twin.Modified = pkgCommon.MakeTimestamp()
+	storedKey := deviceTwinStoredKey(twin.Id)
+	_ = conn.Send(MULTI)
+	sendDeleteDeviceTwinCmd(conn, storedKey, oldTwin)
+	edgeXerr = sendAddDeviceTwinCmd(conn, storedKey, twin)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "device twin update failed", err)
+	}
+
+	return nil
+}
+
+// deviceTwinExists whether the device twin exists by device name
+func deviceTwinExists(conn redis.Conn, deviceName string) (bool, errors.EdgeX) {
+	exists, err := objectNameExists(conn, DeviceTwinCollectionDeviceName, deviceName)
+	if err != nil {
+		return false, errors.NewCommonEdgeXWrapper(err)
+	}
+	return exists, nil
+}
@@ -0,0 +1,89 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+const testDeviceTwinId = "c2e5b7a6-9c1b-4fc8-9d1a-2f0b3c9d5e01"
+
+func deviceTwinData() models.DeviceTwin {
+	return models.DeviceTwin{
+		Id:         testDeviceTwinId,
+		DeviceName: testDeviceName,
+		Properties: map[string]models.TwinProperty{
+			testResourceName: {Desired: "28.5", DesiredModified: 1000},
+		},
+	}
+}
+
+func TestAddDeviceTwin(t *testing.T) {
+	conn := newFakeConn()
+	added, err := addDeviceTwin(conn, deviceTwinData())
+	require.NoError(t, err)
+	assert.NotZero(t, added.Created)
+	assert.NotZero(t, added.Modified)
+
+	twin, err := deviceTwinByDeviceName(conn, testDeviceName)
+	require.NoError(t, err)
+	assert.Equal(t, added, twin)
+	exists, err := deviceTwinExists(conn, testDeviceName)
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	// a device has a single twin
+	duplicate := deviceTwinData()
+	duplicate.Id = "c2e5b7a6-9c1b-4fc8-9d1a-2f0b3c9d5e02"
+	_, err = addDeviceTwin(conn, duplicate)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindDuplicateName, errors.Kind(err))
+}
+
+func TestUpdateDeviceTwin(t *testing.T) {
+	conn := newFakeConn()
+	added, err := addDeviceTwin(conn, deviceTwinData())
+	require.NoError(t, err)
+
+	updated := added
+	updated.Properties = map[string]models.TwinProperty{
+		testResourceName: {Desired: "28.5", DesiredModified: 1000, Reported: "28.50", ReportedOrigin: 2000},
+	}
+	require.NoError(t, updateDeviceTwin(conn, updated))
+
+	twin, err := deviceTwinByDeviceName(conn, testDeviceName)
+	require.NoError(t, err)
+	assert.Equal(t, updated.Properties, twin.Properties)
+	assert.Equal(t, added.Created, twin.Created)
+	assert.Equal(t, []string{deviceTwinStoredKey(testDeviceTwinId)}, conn.zsetMembers(DeviceTwinCollection))
+
+	// the twin is updated by the id it was read with, so that a twin deleted and added again meanwhile isn't overwritten
+	other := updated
+	other.Id = "c2e5b7a6-9c1b-4fc8-9d1a-2f0b3c9d5e02"
+	err = updateDeviceTwin(conn, other)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+}
+
+func TestDeleteDeviceTwinByDeviceName(t *testing.T) {
+	conn := newFakeConn()
+	_, err := addDeviceTwin(conn, deviceTwinData())
+	require.NoError(t, err)
+
+	require.NoError(t, deleteDeviceTwinByDeviceName(conn, testDeviceName))
+
+	_, err = deviceTwinByDeviceName(conn, testDeviceName)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+	exists, err := objectIdExists(conn, deviceTwinStoredKey(testDeviceTwinId))
+	require.NoError(t, err)
+	assert.False(t, exists)
+	assert.Empty(t, conn.zsetMembers(DeviceTwinCollection))
+}
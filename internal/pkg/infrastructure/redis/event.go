@@ -12,17 +12,20 @@ import (
 	"time"
 
 	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
 	"github.com/gomodule/redigo/redis"
+	"github.com/google/uuid"
 )
 
 const (
-	EventsCollection           = "cd|evt"
-	EventsCollectionOrigin     = EventsCollection + DBKeySeparator + common.Origin
-	EventsCollectionDeviceName = EventsCollection + DBKeySeparator + common.Device + DBKeySeparator + common.Name
-	EventsCollectionReadings   = EventsCollection + DBKeySeparator + "readings"
+	EventsCollection            = "cd|evt"
+	EventsCollectionOrigin      = EventsCollection + DBKeySeparator + common.Origin
+	EventsCollectionDeviceName  = EventsCollection + DBKeySeparator + common.Device + DBKeySeparator + common.Name
+	EventsCollectionReadings    = EventsCollection + DBKeySeparator + "readings"
+	EventsCollectionProfileName = EventsCollection + DBKeySeparator + common.Profile + DBKeySeparator + common.Name
 )
 
 // asyncDeleteEventsByIds deletes all events with given event Ids.  This function is implemented to be run as a separate
@@ -55,6 +58,7 @@ func (c *Client) asyncDeleteEventsByIds(eventIds []string) {
 		_ = conn.Send(ZREM, EventsCollection, storedKey)
 		_ = conn.Send(ZREM, EventsCollectionOrigin, storedKey)
 		_ = conn.Send(ZREM, CreateKey(EventsCollectionDeviceName, e.DeviceName), storedKey)
+		_ = conn.Send(ZREM, CreateKey(EventsCollectionProfileName, e.ProfileName), storedKey)
 		queriesInQueue++
 
 		if queriesInQueue >= c.BatchSize {
@@ -101,12 +105,29 @@ func (c *Client) DeleteEventsByDeviceName(deviceName string) (edgeXerr errors.Ed
 // DeleteEventsByAge deletes events and their corresponding readings that are older than age.  This function is implemented to starts up
 // two goroutines to delete readings and events in the background to achieve better performance.
 func (c *Client) DeleteEventsByAge(age int64) (edgeXerr errors.EdgeX) {
+	return c.deleteEventsByKeyAndAge(EventsCollectionOrigin, age)
+}
+
+// DeleteEventsByAgeAndDeviceName deletes the specified device's events and their corresponding readings that are
+// older than age, in the background like DeleteEventsByAge.
+func (c *Client) DeleteEventsByAgeAndDeviceName(age int64, deviceName string) (edgeXerr errors.EdgeX) {
+	return c.deleteEventsByKeyAndAge(CreateKey(EventsCollectionDeviceName, deviceName), age)
+}
+
+// DeleteEventsByAgeAndProfileName deletes the specified device profile's events and their corresponding readings that
+// are older than age, in the background like DeleteEventsByAge.
+func (c *Client) DeleteEventsByAgeAndProfileName(age int64, profileName string) (edgeXerr errors.EdgeX) {
+	return c.deleteEventsByKeyAndAge(CreateKey(EventsCollectionProfileName, profileName), age)
+}
+
+// deleteEventsByKeyAndAge deletes the events indexed by key, along with their readings, that are older than age
+func (c *Client) deleteEventsByKeyAndAge(key string, age int64) (edgeXerr errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer conn.Close()
 
 	expireTimestamp := time.Now().UnixNano() - age
 
-	eventIds, readingIds, err := getEventReadingIdsByKeyScoreRange(conn, EventsCollectionOrigin, "0", strconv.FormatInt(expireTimestamp, 10))
+	eventIds, readingIds, err := getEventReadingIdsByKeyScoreRange(conn, key, "0", strconv.FormatInt(expireTimestamp, 10))
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
@@ -130,8 +151,87 @@ func addEvent(conn redis.Conn, e models.Event) (addedEvent models.Event, edgeXer
 	if errors.Kind(edgeXerr) != errors.KindEntityDoesNotExist {
 		return addedEvent, errors.NewCommonEdgeX(errors.KindDuplicateName, "Event Id exists", nil)
 	}
-	edgeXerr = nil
 
+	e, stored, edgeXerr := newStoredEvent(e)
+	if edgeXerr != nil {
+		return models.Event{}, edgeXerr
+	}
+
+	_ = conn.Send(MULTI)
+	stored.send(conn)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "event creation failed", err)
+	}
+
+	return e, edgeXerr
+}
+
+// addEvents adds the events and their readings within a single transaction.  The events which can't be added, i.e.
+// whose id already exists or whose readings are invalid, are skipped and their errors are returned at their index.
+func addEvents(conn redis.Conn, events []models.Event) (failures []errors.EdgeX, edgeXerr errors.EdgeX) {
+	failures = make([]errors.EdgeX, len(events))
+	if len(events) == 0 {
+		return failures, nil
+	}
+
+	// pipeline the existence checks of all the event ids
+	for _, e := range events {
+		_ = conn.Send(EXISTS, eventStoredKey(e.Id))
+	}
+	if err := conn.Flush(); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "event existence check failed", err)
+	}
+	batchIds := make(map[string]bool, len(events))
+	for i, e := range events {
+		exists, err := redis.Bool(conn.Receive())
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "event existence check failed", err)
+		}
+		if exists || batchIds[e.Id] {
+			failures[i] = errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("Event Id %s exists", e.Id), nil)
+		} else if _, err = uuid.Parse(e.Id); err != nil {
+			failures[i] = errors.NewCommonEdgeX(errors.KindInvalidId, "uuid parsing failed", err)
+		}
+		batchIds[e.Id] = true
+	}
+
+	stored := make([]storedEvent, 0, len(events))
+	for i, e := range events {
+		if failures[i] != nil {
+			continue
+		}
+		_, s, err := newStoredEvent(e)
+		if err != nil {
+			failures[i] = err
+			continue
+		}
+		stored = append(stored, s)
+	}
+	if len(stored) == 0 {
+		return failures, nil
+	}
+
+	_ = conn.Send(MULTI)
+	for _, s := range stored {
+		s.send(conn)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "events creation failed", err)
+	}
+	return failures, nil
+}
+
+// storedEvent holds an event and its readings marshalled for storage
+type storedEvent struct {
+	event    models.Event
+	blob     []byte
+	readings []storedReading
+}
+
+// newStoredEvent validates and marshals the event and its readings, returning the event as it is stored
+func newStoredEvent(e models.Event) (models.Event, storedEvent, errors.EdgeX) {
 	event := models.Event{
 		Id:          e.Id,
 		DeviceName:  e.DeviceName,
@@ -143,44 +243,48 @@ func addEvent(conn redis.Conn, e models.Event) (addedEvent models.Event, edgeXer
 
 	m, err := json.Marshal(event)
 	if err != nil {
-		return addedEvent, errors.NewCommonEdgeX(errors.KindContractInvalid, "event parsing failed", err)
+		return models.Event{}, storedEvent{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "event parsing failed", err)
+	}
+
+	stored := storedEvent{event: event, blob: m, readings: make([]storedReading, len(e.Readings))}
+	var newReadings []models.Reading
+	for i, r := range e.Readings {
+		newReading, storedReading, err := newStoredReading(r)
+		if err != nil {
+			return models.Event{}, storedEvent{}, err
+		}
+		newReadings = append(newReadings, newReading)
+		stored.readings[i] = storedReading
 	}
+	e.Readings = newReadings
+	return e, stored, nil
+}
 
+// send queues the commands saving the event, its readings and their indexes
+func (s storedEvent) send(conn redis.Conn) {
+	e := s.event
 	storedKey := eventStoredKey(e.Id)
-	_ = conn.Send(MULTI)
 	// use the SET command to save event as blob
-	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(SET, storedKey, s.blob)
 	_ = conn.Send(ZADD, EventsCollection, e.Origin, storedKey)
 	_ = conn.Send(ZADD, EventsCollectionOrigin, e.Origin, storedKey)
 	_ = conn.Send(ZADD, CreateKey(EventsCollectionDeviceName, e.DeviceName), e.Origin, storedKey)
+	_ = conn.Send(ZADD, CreateKey(EventsCollectionProfileName, e.ProfileName), e.Origin, storedKey)
 
 	// add reading ids as sorted set under each event id
 	// sort by the order provided by device service
-	rids := make([]interface{}, len(e.Readings)*2+1)
+	rids := make([]interface{}, len(s.readings)*2+1)
 	rids[0] = CreateKey(EventsCollectionReadings, e.Id)
-	var newReadings []models.Reading
-	for i, r := range e.Readings {
-		newReading, err := addReading(conn, r)
-		if err != nil {
-			return models.Event{}, err
-		}
-		newReadings = append(newReadings, newReading)
+	for i, r := range s.readings {
+		r.send(conn)
 
 		// set the sorted set score to the index of the reading
 		rids[i*2+1] = i
-		rids[i*2+2] = CreateKey(ReadingsCollection, newReading.GetBaseReading().Id)
+		rids[i*2+2] = CreateKey(ReadingsCollection, r.base.Id)
 	}
-	e.Readings = newReadings
 	if len(rids) > 1 {
 		_ = conn.Send(ZADD, rids...)
 	}
-
-	_, err = conn.Do(EXEC)
-	if err != nil {
-		edgeXerr = errors.NewCommonEdgeX(errors.KindDatabaseError, "event creation failed", err)
-	}
-
-	return e, edgeXerr
 }
 
 func deleteEventById(conn redis.Conn, id string) (edgeXerr errors.EdgeX) {
@@ -205,6 +309,7 @@ func deleteEventById(conn redis.Conn, id string) (edgeXerr errors.EdgeX) {
 	_ = conn.Send(ZREM, EventsCollection, storedKey)
 	_ = conn.Send(ZREM, EventsCollectionOrigin, storedKey)
 	_ = conn.Send(ZREM, CreateKey(EventsCollectionDeviceName, e.DeviceName), storedKey)
+	_ = conn.Send(ZREM, CreateKey(EventsCollectionProfileName, e.ProfileName), storedKey)
 
 	res, err := redis.Values(conn.Do(EXEC))
 	if err != nil {
@@ -265,6 +370,24 @@ func eventsByDeviceName(conn redis.Conn, offset int, limit int, name string) (ev
 	return convertObjectsToEvents(conn, objects)
 }
 
+// eventsBeforeCursor query, in descending order of origin then id, at most limit events positioned after the cursor.  The
+// events of every device are queried when name is empty.
+func eventsBeforeCursor(conn redis.Conn, name string, cursor pkgModels.Cursor, limit int) (events []models.Event, next pkgModels.Cursor, edgeXerr errors.EdgeX) {
+	key := EventsCollection
+	if name != "" {
+		key = CreateKey(EventsCollectionDeviceName, name)
+	}
+	objects, next, edgeXerr := getObjectsBeforeCursor(conn, key, EventsCollection, cursor, limit)
+	if edgeXerr != nil {
+		return nil, cursor, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	events, edgeXerr = convertObjectsToEvents(conn, objects)
+	if edgeXerr != nil {
+		return nil, cursor, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return events, next, nil
+}
+
 // eventsByTimeRange query events by time range, offset, and limit
 func eventsByTimeRange(conn redis.Conn, startTime int, endTime int, offset int, limit int) (events []models.Event, edgeXerr errors.EdgeX) {
 	objects, edgeXerr := getObjectsByScoreRange(conn, EventsCollectionOrigin, startTime, endTime, offset, limit)
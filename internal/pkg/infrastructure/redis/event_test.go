@@ -0,0 +1,99 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testEventId1 = "a2e5b7a6-9c1b-4fc8-9d1a-2f0b3c9d5e01"
+	testEventId2 = "a2e5b7a6-9c1b-4fc8-9d1a-2f0b3c9d5e02"
+	testEventId3 = "a2e5b7a6-9c1b-4fc8-9d1a-2f0b3c9d5e03"
+)
+
+func eventData(id string, readingId string) models.Event {
+	reading := simpleReadingData()
+	reading.Id = readingId
+	return models.Event{
+		Id:          id,
+		DeviceName:  testDeviceName,
+		ProfileName: testProfileName,
+		SourceName:  testResourceName,
+		Origin:      reading.Origin,
+		Readings:    []models.Reading{reading},
+	}
+}
+
+func TestAddEvents(t *testing.T) {
+	event1 := eventData(testEventId1, "b2e5b7a6-9c1b-4fc8-9d1a-2f0b3c9d5e01")
+	event2 := eventData(testEventId2, "b2e5b7a6-9c1b-4fc8-9d1a-2f0b3c9d5e02")
+	duplicateEvent1 := eventData(testEventId1, "b2e5b7a6-9c1b-4fc8-9d1a-2f0b3c9d5e03")
+	existingEvent := eventData(testEventId3, "b2e5b7a6-9c1b-4fc8-9d1a-2f0b3c9d5e04")
+	invalidIdEvent := eventData("invalid", "b2e5b7a6-9c1b-4fc8-9d1a-2f0b3c9d5e05")
+	invalidReadingEvent := eventData(testEventId2, "b2e5b7a6-9c1b-4fc8-9d1a-2f0b3c9d5e06")
+	invalidReading := simpleReadingData()
+	invalidReading.Id = "invalid"
+	invalidReadingEvent.Readings = []models.Reading{invalidReading}
+
+	tests := []struct {
+		name           string
+		events         []models.Event
+		expectedKinds  []errors.ErrKind
+		expectedStored []string
+	}{
+		{"all added", []models.Event{event1, event2}, []errors.ErrKind{"", ""}, []string{testEventId1, testEventId2}},
+		{"duplicate id in the batch", []models.Event{event1, duplicateEvent1, event2}, []errors.ErrKind{"", errors.KindDuplicateName, ""}, []string{testEventId1, testEventId2}},
+		{"id already exists", []models.Event{existingEvent, event1}, []errors.ErrKind{errors.KindDuplicateName, ""}, []string{testEventId1}},
+		{"invalid id", []models.Event{invalidIdEvent, event1}, []errors.ErrKind{errors.KindInvalidId, ""}, []string{testEventId1}},
+		{"invalid reading", []models.Event{event1, invalidReadingEvent}, []errors.ErrKind{"", errors.KindInvalidId}, []string{testEventId1}},
+		{"none added", []models.Event{existingEvent, invalidIdEvent}, []errors.ErrKind{errors.KindDuplicateName, errors.KindInvalidId}, nil},
+		{"no events", nil, nil, nil},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			conn := newFakeConn()
+			existingBlob, err := json.Marshal(existingEvent)
+			require.NoError(t, err)
+			conn.strings[eventStoredKey(testEventId3)] = existingBlob
+
+			failures, edgeXerr := addEvents(conn, testCase.events)
+			require.NoError(t, edgeXerr)
+
+			require.Len(t, failures, len(testCase.events))
+			for i, expectedKind := range testCase.expectedKinds {
+				if expectedKind == "" {
+					assert.NoError(t, failures[i], "event %d should be added", i)
+					continue
+				}
+				require.Error(t, failures[i], "event %d should fail", i)
+				assert.Equal(t, expectedKind, errors.Kind(failures[i]), "event %d", i)
+			}
+
+			var stored []string
+			for i, e := range testCase.events {
+				if failures[i] != nil {
+					continue
+				}
+				require.Contains(t, conn.strings, eventStoredKey(e.Id))
+				assert.Contains(t, conn.strings, readingStoredKey(e.Readings[0].(models.SimpleReading).Id))
+				stored = append(stored, e.Id)
+			}
+			assert.Equal(t, testCase.expectedStored, stored)
+			// the readings of the events which failed are not stored
+			for i, e := range testCase.events {
+				if failures[i] != nil {
+					assert.NotContains(t, conn.strings, readingStoredKey(e.Readings[0].(models.SimpleReading).Id))
+				}
+			}
+			assert.Equal(t, existingBlob, conn.strings[eventStoredKey(testEventId3)], "the existing event should not be overwritten")
+		})
+	}
+}
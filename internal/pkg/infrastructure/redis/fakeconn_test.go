@@ -0,0 +1,322 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// fakeConn is an in-memory redis.Conn supporting the few commands used by the tests, including pipelining and
+// MULTI/EXEC transactions.  A sorted set is scanned in a single ZSCAN iteration.
+type fakeConn struct {
+	strings map[string][]byte
+	zsets   map[string]map[string]float64
+	sets    map[string]map[string]bool
+	hashes  map[string]map[string][]byte
+	// commands records the names of the executed commands
+	commands []string
+
+	pending []any
+	multi   bool
+	queued  [][]any
+	// conflict discards the next transaction as if a watched key was modified by another client
+	conflict bool
+}
+
+var _ redis.Conn = &fakeConn{}
+
+func newFakeConn() *fakeConn {
+	return &fakeConn{
+		strings: make(map[string][]byte),
+		zsets:   make(map[string]map[string]float64),
+		sets:    make(map[string]map[string]bool),
+		hashes:  make(map[string]map[string][]byte),
+	}
+}
+
+func (c *fakeConn) Close() error { return nil }
+
+func (c *fakeConn) Err() error { return nil }
+
+func (c *fakeConn) Flush() error { return nil }
+
+func (c *fakeConn) Send(commandName string, args ...any) error {
+	if commandName == MULTI {
+		c.multi = true
+		c.pending = append(c.pending, "OK")
+		return nil
+	}
+	if c.multi {
+		c.queued = append(c.queued, append([]any{commandName}, args...))
+		c.pending = append(c.pending, "QUEUED")
+		return nil
+	}
+	c.pending = append(c.pending, c.execute(commandName, args...))
+	return nil
+}
+
+func (c *fakeConn) Receive() (any, error) {
+	if len(c.pending) == 0 {
+		return nil, fmt.Errorf("no pending reply")
+	}
+	reply := c.pending[0]
+	c.pending = c.pending[1:]
+	if err, ok := reply.(error); ok {
+		return nil, err
+	}
+	return reply, nil
+}
+
+// Do executes the command after discarding the pending replies, or returns the pending replies for an empty command
+func (c *fakeConn) Do(commandName string, args ...any) (any, error) {
+	pending := c.pending
+	c.pending = nil
+	if commandName == "" {
+		return pending, nil
+	}
+
+	var reply any
+	if commandName == EXEC && c.conflict {
+		c.multi = false
+		c.queued = nil
+		c.conflict = false
+		return nil, nil
+	}
+	if commandName == EXEC {
+		replies := make([]any, len(c.queued))
+		for i, command := range c.queued {
+			replies[i] = c.execute(command[0].(string), command[1:]...)
+		}
+		c.multi = false
+		c.queued = nil
+		reply = replies
+	} else {
+		reply = c.execute(commandName, args...)
+	}
+	if err, ok := reply.(error); ok {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (c *fakeConn) execute(commandName string, args ...any) any {
+	c.commands = append(c.commands, commandName)
+	key := toString(args[0])
+	switch commandName {
+	case SET:
+		c.strings[key] = toBytes(args[1])
+		return "OK"
+	case GET:
+		if value, ok := c.strings[key]; ok {
+			return value
+		}
+		return nil
+	case MGET:
+		values := make([]any, len(args))
+		for i, arg := range args {
+			if value, ok := c.strings[toString(arg)]; ok {
+				values[i] = value
+			}
+		}
+		return values
+	case DEL:
+		delete(c.strings, key)
+		return int64(1)
+	case EXISTS:
+		if _, ok := c.strings[key]; ok {
+			return int64(1)
+		}
+		return int64(0)
+	case ZADD:
+		if c.zsets[key] == nil {
+			c.zsets[key] = make(map[string]float64)
+		}
+		for i := 1; i+1 < len(args); i += 2 {
+			score, err := strconv.ParseFloat(toString(args[i]), 64)
+			if err != nil {
+				return err
+			}
+			c.zsets[key][toString(args[i+1])] = score
+		}
+		return int64((len(args) - 1) / 2)
+	case ZREM:
+		delete(c.zsets[key], toString(args[1]))
+		return int64(1)
+	case ZCARD:
+		return int64(len(c.zsets[key]))
+	case ZRANGE:
+		members := c.zsetMembers(key)
+		start, stop := args[1].(int), args[2].(int)
+		values := make([]any, 0, len(members))
+		for _, member := range members[min(start, len(members)):min(stop+1, len(members))] {
+			values = append(values, []byte(member))
+		}
+		return values
+	case ZREVRANGE:
+		members := c.zsetMembers(key)
+		slices.Reverse(members)
+		start, stop := args[1].(int), args[2].(int)
+		if stop < 0 {
+			stop += len(members)
+		}
+		values := make([]any, 0, len(members))
+		for _, member := range members[min(start, len(members)):min(stop+1, len(members))] {
+			values = append(values, []byte(member))
+		}
+		return values
+	case ZRANGEBYSCORE:
+		// only the inclusive bounds are supported
+		minScore, _ := strconv.ParseFloat(toString(args[1]), 64)
+		maxScore, _ := strconv.ParseFloat(toString(args[2]), 64)
+		withScores := false
+		offset, count := 0, -1
+		for i := 3; i < len(args); i++ {
+			switch args[i] {
+			case WITHSCORES:
+				withScores = true
+			case LIMIT:
+				offset, count = args[i+1].(int), args[i+2].(int)
+				i += 2
+			}
+		}
+		values := make([]any, 0)
+		for _, member := range c.zsetMembers(key) {
+			score := c.zsets[key][member]
+			if score < minScore || score > maxScore {
+				continue
+			}
+			if offset > 0 {
+				offset--
+				continue
+			}
+			if count == 0 {
+				break
+			}
+			count--
+			values = append(values, []byte(member))
+			if withScores {
+				values = append(values, []byte(strconv.FormatFloat(score, 'f', -1, 64)))
+			}
+		}
+		return values
+	case ZCOUNT:
+		// only the infinite bounds are supported
+		return int64(len(c.zsets[key]))
+	case ZSCAN:
+		members := make([]string, 0, len(c.zsets[key]))
+		for member := range c.zsets[key] {
+			members = append(members, member)
+		}
+		sort.Strings(members)
+		pairs := make([]any, 0, 2*len(members))
+		for _, member := range members {
+			pairs = append(pairs, []byte(member), []byte(strconv.FormatFloat(c.zsets[key][member], 'f', -1, 64)))
+		}
+		return []any{[]byte("0"), pairs}
+	case ZREVRANGEBYLEX:
+		members := c.zsetMembers(key)
+		sort.Sort(sort.Reverse(sort.StringSlice(members)))
+		lexMax, lexMin := toString(args[1]), toString(args[2])
+		values := make([]any, 0, len(members))
+		for _, member := range members {
+			if (lexMax != InfiniteLexMax && !lexBelow(member, lexMax)) || (lexMin != InfiniteLexMin && !lexAbove(member, lexMin)) {
+				continue
+			}
+			values = append(values, []byte(member))
+		}
+		if len(args) == 6 {
+			offset, count := min(len(values), args[4].(int)), args[5].(int)
+			values = values[offset:min(len(values), offset+count)]
+		}
+		return values
+	case SADD:
+		if c.sets[key] == nil {
+			c.sets[key] = make(map[string]bool)
+		}
+		c.sets[key][toString(args[1])] = true
+		return int64(1)
+	case SISMEMBER:
+		if c.sets[key][toString(args[1])] {
+			return int64(1)
+		}
+		return int64(0)
+	case HSET:
+		if c.hashes[key] == nil {
+			c.hashes[key] = make(map[string][]byte)
+		}
+		c.hashes[key][toString(args[1])] = toBytes(args[2])
+		return int64(1)
+	case HGET:
+		if value, ok := c.hashes[key][toString(args[1])]; ok {
+			return value
+		}
+		return nil
+	case HEXISTS:
+		if _, ok := c.hashes[key][toString(args[1])]; ok {
+			return int64(1)
+		}
+		return int64(0)
+	case HDEL:
+		delete(c.hashes[key], toString(args[1]))
+		return int64(1)
+	default:
+		// the other commands only update the indexes which aren't checked by the tests
+		return int64(1)
+	}
+}
+
+// zsetMembers returns the members of the sorted set ordered by score
+func (c *fakeConn) zsetMembers(key string) []string {
+	members := make([]string, 0, len(c.zsets[key]))
+	for member := range c.zsets[key] {
+		members = append(members, member)
+	}
+	// the members of the same score are ordered lexicographically
+	sort.Slice(members, func(i, j int) bool {
+		if c.zsets[key][members[i]] == c.zsets[key][members[j]] {
+			return members[i] < members[j]
+		}
+		return c.zsets[key][members[i]] < c.zsets[key][members[j]]
+	})
+	return members
+}
+
+func toString(arg any) string {
+	switch v := arg.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func toBytes(arg any) []byte {
+	if v, ok := arg.([]byte); ok {
+		return v
+	}
+	return []byte(toString(arg))
+}
+
+// lexBelow checks whether the member is below the exclusive, i.e. "(", or inclusive, i.e. "[", lexicographical bound
+func lexBelow(member string, bound string) bool {
+	if bound[0] == '(' {
+		return member < bound[1:]
+	}
+	return member <= bound[1:]
+}
+
+// lexAbove checks whether the member is above the exclusive, i.e. "(", or inclusive, i.e. "[", lexicographical bound
+func lexAbove(member string, bound string) bool {
+	if bound[0] == '(' {
+		return member > bound[1:]
+	}
+	return member >= bound[1:]
+}
@@ -10,10 +10,10 @@ import (
 	"fmt"
 
 	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/models"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
-	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
 
 	"github.com/gomodule/redigo/redis"
 )
@@ -136,6 +136,7 @@ func deleteIntervalByName(conn redis.Conn, name string) errors.EdgeX {
 	storedKey := intervalStoredKey(interval.Id)
 	_ = conn.Send(MULTI)
 	sendDeleteIntervalCmd(conn, storedKey, interval)
+	_ = conn.Send(HDEL, IntervalLastRunCollection, name)
 	_, err := conn.Do(EXEC)
 	if err != nil {
 		return errors.NewCommonEdgeX(errors.KindDatabaseError, "interval deletion failed", err)
@@ -0,0 +1,143 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const (
+	IntervalActionRecordCollection             = "ss|ar"
+	IntervalActionRecordCollectionActionName   = IntervalActionRecordCollection + DBKeySeparator + common.Name
+	IntervalActionRecordCollectionIntervalName = IntervalActionRecordCollection + DBKeySeparator + common.Interval + DBKeySeparator + common.Name
+	// IntervalLastRunCollection is the hash of the scheduled time of the last run by interval name, which is kept apart
+	// from the records as the history may be disabled or trimmed
+	IntervalLastRunCollection = "ss|lr"
+)
+
+// intervalActionRecordStoredKey return the interval action record's stored key which combines the collection name and object id
+func intervalActionRecordStoredKey(id string) string {
+	return CreateKey(IntervalActionRecordCollection, id)
+}
+
+// addIntervalActionRecord adds a new interval action record into DB, the records being sorted by scheduled time
+func addIntervalActionRecord(conn redis.Conn, r pkgModels.IntervalActionRecord) errors.EdgeX {
+	m, err := json.Marshal(r)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal interval action record for Redis persistence", err)
+	}
+	storedKey := intervalActionRecordStoredKey(r.Id)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, IntervalActionRecordCollection, r.ScheduledTime, storedKey)
+	_ = conn.Send(ZADD, CreateKey(IntervalActionRecordCollectionActionName, r.ActionName), r.ScheduledTime, storedKey)
+	_ = conn.Send(ZADD, CreateKey(IntervalActionRecordCollectionIntervalName, r.IntervalName), r.ScheduledTime, storedKey)
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "interval action record creation failed", err)
+	}
+	return nil
+}
+
+// intervalActionRecordsByTimeRange query the records of an interval action, or of all interval actions when actionName
+// is empty, scheduled within the time range, by offset, and limit.  The records are sorted by scheduled time in
+// descending order.
+func intervalActionRecordsByTimeRange(conn redis.Conn, actionName string, start int, end int, offset int, limit int) (records []pkgModels.IntervalActionRecord, totalCount uint32, edgeXerr errors.EdgeX) {
+	key := IntervalActionRecordCollection
+	if actionName != "" {
+		key = CreateKey(IntervalActionRecordCollectionActionName, actionName)
+	}
+	totalCount, edgeXerr = getMemberCountByScoreRange(conn, key, start, end)
+	if edgeXerr != nil {
+		return nil, 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	objects, edgeXerr := getObjectsByScoreRange(conn, key, start, end, offset, limit)
+	if edgeXerr != nil {
+		return nil, 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	records, edgeXerr = convertObjectsToIntervalActionRecords(objects)
+	if edgeXerr != nil {
+		return nil, 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return records, totalCount, nil
+}
+
+// intervalLastRun query the scheduled time of the last run of the interval, the last run being not found when the
+// interval has not run yet
+func intervalLastRun(conn redis.Conn, intervalName string) (int64, errors.EdgeX) {
+	lastRun, err := redis.Int64(conn.Do(HGET, IntervalLastRunCollection, intervalName))
+	if err == redis.ErrNil {
+		return 0, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("no last run of interval %s", intervalName), nil)
+	} else if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query the last run of interval %s from database failed", intervalName), err)
+	}
+	return lastRun, nil
+}
+
+// updateIntervalLastRun updates the scheduled time of the last run of the interval
+func updateIntervalLastRun(conn redis.Conn, intervalName string, scheduledTime int64) errors.EdgeX {
+	_, err := conn.Do(HSET, IntervalLastRunCollection, intervalName, scheduledTime)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("update the last run of interval %s failed", intervalName), err)
+	}
+	return nil
+}
+
+func convertObjectsToIntervalActionRecords(objects [][]byte) ([]pkgModels.IntervalActionRecord, errors.EdgeX) {
+	records := make([]pkgModels.IntervalActionRecord, len(objects))
+	for i, o := range objects {
+		if err := json.Unmarshal(o, &records[i]); err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "interval action record format parsing failed from the database", err)
+		}
+	}
+	return records, nil
+}
+
+// trimIntervalActionRecords deletes the records of the actions of the interval with the oldest scheduled times beyond
+// maxCount, so that the records of an interval running rarely aren't deleted by those of the other intervals
+func trimIntervalActionRecords(conn redis.Conn, intervalName string, maxCount int) errors.EdgeX {
+	intervalKey := CreateKey(IntervalActionRecordCollectionIntervalName, intervalName)
+	count, edgeXerr := getMemberNumber(conn, ZCARD, intervalKey)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	excess := int(count) - maxCount
+	if excess <= 0 {
+		return nil
+	}
+	storedKeys, err := redis.Strings(conn.Do(ZRANGE, intervalKey, 0, excess-1))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "query oldest interval action records from database failed", err)
+	}
+	objects, err := redis.ByteSlices(conn.Do(MGET, pkgCommon.ConvertStringsToInterfaces(storedKeys)...))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "query oldest interval action records from database failed", err)
+	}
+
+	_ = conn.Send(MULTI)
+	for i, storedKey := range storedKeys {
+		_ = conn.Send(DEL, storedKey)
+		_ = conn.Send(ZREM, IntervalActionRecordCollection, storedKey)
+		_ = conn.Send(ZREM, intervalKey, storedKey)
+		// the record may have been deleted concurrently, in which case it can't be removed from its action index
+		var r pkgModels.IntervalActionRecord
+		if objects[i] != nil && json.Unmarshal(objects[i], &r) == nil {
+			_ = conn.Send(ZREM, CreateKey(IntervalActionRecordCollectionActionName, r.ActionName), storedKey)
+		}
+	}
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("deletion of %d interval action records failed", excess), err)
+	}
+	return nil
+}
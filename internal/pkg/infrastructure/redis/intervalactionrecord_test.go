@@ -0,0 +1,72 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+func TestTrimIntervalActionRecords(t *testing.T) {
+	conn := newFakeConn()
+	var hourlyIds, dailyIds []string
+	for i := 1; i <= 5; i++ {
+		id := fmt.Sprintf("hourly-%d", i)
+		require.NoError(t, addIntervalActionRecord(conn, pkgModels.IntervalActionRecord{Id: id, ActionName: "purge", IntervalName: "hourly", ScheduledTime: int64(100 + i)}))
+		hourlyIds = append(hourlyIds, id)
+	}
+	// the records of the interval running rarely are the oldest ones
+	for i := 1; i <= 2; i++ {
+		id := fmt.Sprintf("daily-%d", i)
+		require.NoError(t, addIntervalActionRecord(conn, pkgModels.IntervalActionRecord{Id: id, ActionName: "backup", IntervalName: "daily", ScheduledTime: int64(i)}))
+		dailyIds = append(dailyIds, id)
+	}
+
+	require.NoError(t, trimIntervalActionRecords(conn, "hourly", 3))
+
+	storedKeys := func(ids ...string) []string {
+		keys := make([]string, len(ids))
+		for i, id := range ids {
+			keys[i] = intervalActionRecordStoredKey(id)
+		}
+		return keys
+	}
+	assert.Equal(t, storedKeys(hourlyIds[2:]...), conn.zsetMembers(CreateKey(IntervalActionRecordCollectionIntervalName, "hourly")))
+	assert.Equal(t, storedKeys(hourlyIds[2:]...), conn.zsetMembers(CreateKey(IntervalActionRecordCollectionActionName, "purge")))
+	assert.Equal(t, storedKeys(dailyIds...), conn.zsetMembers(CreateKey(IntervalActionRecordCollectionIntervalName, "daily")))
+	assert.Equal(t, storedKeys(append(dailyIds, hourlyIds[2:]...)...), conn.zsetMembers(IntervalActionRecordCollection))
+	for _, id := range hourlyIds[:2] {
+		exists, err := objectIdExists(conn, intervalActionRecordStoredKey(id))
+		require.NoError(t, err)
+		assert.False(t, exists)
+	}
+
+	// the records of an interval within the count are kept
+	require.NoError(t, trimIntervalActionRecords(conn, "daily", 3))
+	assert.Equal(t, storedKeys(dailyIds...), conn.zsetMembers(CreateKey(IntervalActionRecordCollectionIntervalName, "daily")))
+}
+
+func TestIntervalLastRun(t *testing.T) {
+	conn := newFakeConn()
+	_, err := intervalLastRun(conn, "hourly")
+	require.Error(t, err)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+
+	require.NoError(t, updateIntervalLastRun(conn, "hourly", 1000))
+	require.NoError(t, updateIntervalLastRun(conn, "hourly", 2000))
+	require.NoError(t, updateIntervalLastRun(conn, "daily", 500))
+
+	lastRun, err := intervalLastRun(conn, "hourly")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2000), lastRun)
+	lastRun, err = intervalLastRun(conn, "daily")
+	require.NoError(t, err)
+	assert.Equal(t, int64(500), lastRun)
+}
@@ -0,0 +1,61 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+const LeaseCollection = "ss|ls"
+
+// acquireLeaseScript sets the lease to the holder if no one holds it, or extends the lease if the holder already holds
+// it, and returns the holder of the lease
+var acquireLeaseScript = redis.NewScript(1, `
+local holder = redis.call('GET', KEYS[1])
+if holder == ARGV[1] then
+	redis.call('PEXPIRE', KEYS[1], ARGV[2])
+	return holder
+end
+if holder then
+	return holder
+end
+redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+return ARGV[1]
+`)
+
+// releaseLeaseScript deletes the lease if the holder holds it
+var releaseLeaseScript = redis.NewScript(1, `
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+// leaseStoredKey return the lease's stored key which combines the collection name and lease name
+func leaseStoredKey(name string) string {
+	return CreateKey(LeaseCollection, name)
+}
+
+// acquireLease acquires or renews the lease for the holder, which holds it for the duration unless renewing it, and
+// returns the holder of the lease, which is another holder if the lease is already held
+func acquireLease(conn redis.Conn, name string, holder string, duration time.Duration) (string, errors.EdgeX) {
+	current, err := redis.String(acquireLeaseScript.Do(conn, leaseStoredKey(name), holder, duration.Milliseconds()))
+	if err != nil {
+		return "", errors.NewCommonEdgeX(errors.KindDatabaseError, "lease acquisition failed", err)
+	}
+	return current, nil
+}
+
+// releaseLease releases the lease if the holder holds it
+func releaseLease(conn redis.Conn, name string, holder string) errors.EdgeX {
+	_, err := releaseLeaseScript.Do(conn, leaseStoredKey(name), holder)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "lease release failed", err)
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// MigrationsCollection holds the names of the migrations which completed on the database
+const MigrationsCollection = "edgex|migrations"
+
+// migration updates the data stored by an earlier version, e.g. to index the existing objects by a new index.  The
+// migrations run again until they complete, so they must be idempotent.  run returns the number of migrated objects.
+type migration struct {
+	name string
+	run  func(conn redis.Conn, batchSize int) (int, errors.EdgeX)
+}
+
+var migrations = []migration{
+	{name: "events-profile-name-index", run: func(conn redis.Conn, batchSize int) (int, errors.EdgeX) {
+		return indexByProfileName(conn, EventsCollectionOrigin, EventsCollectionProfileName, batchSize)
+	}},
+	{name: "readings-profile-name-index", run: func(conn redis.Conn, batchSize int) (int, errors.EdgeX) {
+		return indexByProfileName(conn, ReadingsCollectionOrigin, ReadingsCollectionProfileName, batchSize)
+	}},
+	{name: "devices-label-key-index", run: func(conn redis.Conn, batchSize int) (int, errors.EdgeX) {
+		return indexByLabelKeys(conn, DeviceCollection, DeviceCollectionLabelKey, batchSize)
+	}},
+	{name: "device-profiles-label-key-index", run: func(conn redis.Conn, batchSize int) (int, errors.EdgeX) {
+		return indexByLabelKeys(conn, DeviceProfileCollection, DeviceProfileCollectionLabelKey, batchSize)
+	}},
+	{name: "device-services-label-key-index", run: func(conn redis.Conn, batchSize int) (int, errors.EdgeX) {
+		return indexByLabelKeys(conn, DeviceServiceCollection, DeviceServiceCollectionLabelKey, batchSize)
+	}},
+	{name: "provision-watchers-label-key-index", run: func(conn redis.Conn, batchSize int) (int, errors.EdgeX) {
+		return indexByLabelKeys(conn, ProvisionWatcherCollection, ProvisionWatcherCollectionLabelKey, batchSize)
+	}},
+}
+
+// migrate runs the migrations which haven't completed yet.  A failed migration is logged and runs again at the next
+// start, the data it didn't migrate being missed by the queries relying on it meanwhile.
+func migrate(conn redis.Conn, batchSize int, lc logger.LoggingClient) {
+	for _, m := range migrations {
+		completed, err := redis.Bool(conn.Do(SISMEMBER, MigrationsCollection, m.name))
+		if err != nil {
+			lc.Errorf("failed to check whether the database migration %s completed: %v", m.name, err)
+			return
+		}
+		if completed {
+			continue
+		}
+
+		count, edgeXerr := m.run(conn, batchSize)
+		if edgeXerr != nil {
+			lc.Errorf("database migration %s failed after migrating %d objects, it will run again at the next start: %v", m.name, count, edgeXerr)
+			continue
+		}
+		if _, err = conn.Do(SADD, MigrationsCollection, m.name); err != nil {
+			lc.Errorf("failed to record the completion of the database migration %s: %v", m.name, err)
+			continue
+		}
+		lc.Infof("database migration %s completed, %d objects migrated", m.name, count)
+	}
+}
+
+// indexByProfileName adds the objects of the collection, scored by origin, to the index of their profile name
+func indexByProfileName(conn redis.Conn, originCollection string, profileNameCollection string, batchSize int) (int, errors.EdgeX) {
+	return indexObjects(conn, originCollection, batchSize, func(storedKey string, score string, object []byte) bool {
+		var o struct {
+			ProfileName string
+		}
+		if err := json.Unmarshal(object, &o); err != nil || o.ProfileName == "" {
+			return false
+		}
+		_ = conn.Send(ZADD, CreateKey(profileNameCollection, o.ProfileName), score, storedKey)
+		return true
+	})
+}
+
+// indexByLabelKeys adds the labelled objects of the collection to the indexes of the keys of their labels
+func indexByLabelKeys(conn redis.Conn, collection string, labelKeyCollection string, batchSize int) (int, errors.EdgeX) {
+	return indexObjects(conn, collection, batchSize, func(storedKey string, _ string, object []byte) bool {
+		var o struct {
+			Labels   []string
+			Modified int64
+		}
+		if err := json.Unmarshal(object, &o); err != nil || len(o.Labels) == 0 {
+			return false
+		}
+		sendAddLabelKeysCmd(conn, labelKeyCollection, o.Labels, o.Modified, storedKey)
+		return true
+	})
+}
+
+// indexObjects calls sendIndex with each object of the collection, its stored key and its score in the collection,
+// to send the commands adding the object to an index.  sendIndex returns whether it sent commands.  The number of
+// indexed objects is returned.
+func indexObjects(conn redis.Conn, collection string, batchSize int, sendIndex func(storedKey string, score string, object []byte) bool) (int, errors.EdgeX) {
+	count := 0
+	err := scanSortedSet(conn, collection, batchSize, func(storedKeys []string, scores []string) errors.EdgeX {
+		args := make([]any, len(storedKeys))
+		for i, storedKey := range storedKeys {
+			args[i] = storedKey
+		}
+		objects, err := redis.ByteSlices(conn.Do(MGET, args...))
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("failed to get the objects of %s", collection), err)
+		}
+
+		pending := 0
+		for i, object := range objects {
+			// the object was deleted since the scan
+			if object == nil {
+				continue
+			}
+			if sendIndex(storedKeys[i], scores[i], object) {
+				pending++
+			}
+		}
+		if pending == 0 {
+			return nil
+		}
+		if _, err = conn.Do(""); err != nil {
+			return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("failed to index the objects of %s", collection), err)
+		}
+		count += pending
+		return nil
+	})
+	return count, err
+}
+
+// scanSortedSet calls handle with the members of the sorted set and their scores, by batches of about batchSize
+// members.  The members added or removed during the scan may or may not be handled.
+func scanSortedSet(conn redis.Conn, key string, batchSize int, handle func(members []string, scores []string) errors.EdgeX) errors.EdgeX {
+	cursor := "0"
+	for {
+		values, err := redis.Values(conn.Do(ZSCAN, key, cursor, COUNT, batchSize))
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("failed to scan %s", key), err)
+		}
+		var pairs []string
+		if _, err = redis.Scan(values, &cursor, &pairs); err != nil {
+			return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("failed to scan %s", key), err)
+		}
+
+		if len(pairs) > 0 {
+			members := make([]string, 0, len(pairs)/2)
+			scores := make([]string, 0, len(pairs)/2)
+			for i := 0; i+1 < len(pairs); i += 2 {
+				members = append(members, pairs[i])
+				scores = append(scores, pairs[i+1])
+			}
+			if edgeXerr := handle(members, scores); edgeXerr != nil {
+				return errors.NewCommonEdgeXWrapper(edgeXerr)
+			}
+		}
+		if cursor == "0" {
+			return nil
+		}
+	}
+}
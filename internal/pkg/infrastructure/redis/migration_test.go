@@ -0,0 +1,83 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexByProfileName(t *testing.T) {
+	conn := newFakeConn()
+	events := []models.Event{
+		{Id: "1", DeviceName: testDeviceName, ProfileName: testProfileName, Origin: 3},
+		{Id: "2", DeviceName: testDeviceName, ProfileName: "otherProfile", Origin: 1},
+		{Id: "3", DeviceName: testDeviceName, ProfileName: testProfileName, Origin: 2},
+	}
+	for _, e := range events {
+		blob, err := json.Marshal(e)
+		require.NoError(t, err)
+		conn.strings[eventStoredKey(e.Id)] = blob
+		_ = conn.execute(ZADD, EventsCollectionOrigin, e.Origin, eventStoredKey(e.Id))
+	}
+	// an event deleted after being indexed by origin is skipped
+	_ = conn.execute(ZADD, EventsCollectionOrigin, 4, eventStoredKey("4"))
+
+	count, err := indexByProfileName(conn, EventsCollectionOrigin, EventsCollectionProfileName, 2)
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, count)
+	assert.Equal(t, []string{eventStoredKey("3"), eventStoredKey("1")}, conn.zsetMembers(CreateKey(EventsCollectionProfileName, testProfileName)))
+	assert.Equal(t, []string{eventStoredKey("2")}, conn.zsetMembers(CreateKey(EventsCollectionProfileName, "otherProfile")))
+	assert.Equal(t, float64(3), conn.zsets[CreateKey(EventsCollectionProfileName, testProfileName)][eventStoredKey("1")])
+}
+
+func TestIndexByLabelKeys(t *testing.T) {
+	conn := newFakeConn()
+	devices := []models.Device{
+		{Id: "1", Name: "device1", Labels: []string{"floor=1", "sensor"}, DBTimestamp: models.DBTimestamp{Modified: 3}},
+		{Id: "2", Name: "device2", DBTimestamp: models.DBTimestamp{Modified: 1}},
+		{Id: "3", Name: "device3", Labels: []string{"floor=2"}, DBTimestamp: models.DBTimestamp{Modified: 2}},
+	}
+	for _, d := range devices {
+		blob, err := json.Marshal(d)
+		require.NoError(t, err)
+		conn.strings[deviceStoredKey(d.Id)] = blob
+		_ = conn.execute(ZADD, DeviceCollection, 0, deviceStoredKey(d.Id))
+	}
+
+	count, err := indexByLabelKeys(conn, DeviceCollection, DeviceCollectionLabelKey, 2)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, count)
+	assert.Equal(t, []string{deviceStoredKey("3"), deviceStoredKey("1")}, conn.zsetMembers(CreateKey(DeviceCollectionLabelKey, "floor")))
+	assert.Equal(t, []string{deviceStoredKey("1")}, conn.zsetMembers(CreateKey(DeviceCollectionLabelKey, "sensor")))
+	assert.Equal(t, float64(3), conn.zsets[CreateKey(DeviceCollectionLabelKey, "floor")][deviceStoredKey("1")])
+}
+
+func TestMigrate(t *testing.T) {
+	conn := newFakeConn()
+	reading := simpleReadingData()
+	blob, err := json.Marshal(reading)
+	require.NoError(t, err)
+	conn.strings[readingStoredKey(reading.Id)] = blob
+	_ = conn.execute(ZADD, ReadingsCollectionOrigin, reading.Origin, readingStoredKey(reading.Id))
+
+	migrate(conn, 10, logger.NewMockClient())
+
+	assert.Equal(t, []string{readingStoredKey(reading.Id)}, conn.zsetMembers(CreateKey(ReadingsCollectionProfileName, testProfileName)))
+	for _, m := range migrations {
+		assert.True(t, conn.sets[MigrationsCollection][m.name], "migration %s should be recorded as completed", m.name)
+	}
+
+	// the completed migrations don't run again
+	conn.commands = nil
+	migrate(conn, 10, logger.NewMockClient())
+	assert.NotContains(t, conn.commands, ZSCAN)
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 
 	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/labelselector"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
@@ -21,6 +22,7 @@ const (
 	ProvisionWatcherCollection            = "md|pw"
 	ProvisionWatcherCollectionName        = ProvisionWatcherCollection + DBKeySeparator + common.Name
 	ProvisionWatcherCollectionLabel       = ProvisionWatcherCollection + DBKeySeparator + common.Label
+	ProvisionWatcherCollectionLabelKey    = ProvisionWatcherCollection + DBKeySeparator + LabelKey
 	ProvisionWatcherCollectionServiceName = ProvisionWatcherCollectionName + DBKeySeparator + common.Service + DBKeySeparator + common.Name
 	ProvisionWatcherCollectionProfileName = ProvisionWatcherCollectionName + DBKeySeparator + common.Profile + DBKeySeparator + common.Name
 )
@@ -44,6 +46,7 @@ func sendAddProvisionWatcherCmd(conn redis.Conn, storedKey string, pw models.Pro
 	for _, label := range pw.Labels {
 		_ = conn.Send(ZADD, CreateKey(ProvisionWatcherCollectionLabel, label), pw.Modified, storedKey)
 	}
+	sendAddLabelKeysCmd(conn, ProvisionWatcherCollectionLabelKey, pw.Labels, pw.Modified, storedKey)
 	return nil
 }
 
@@ -196,6 +199,7 @@ func sendDeleteProvisionWatcherCmd(conn redis.Conn, storedKey string, pw models.
 	for _, label := range pw.Labels {
 		_ = conn.Send(ZREM, CreateKey(ProvisionWatcherCollectionLabel, label), storedKey)
 	}
+	sendDeleteLabelKeysCmd(conn, ProvisionWatcherCollectionLabelKey, pw.Labels, storedKey)
 }
 
 // deleteProvisionWatcher deletes a provision watcher
@@ -241,3 +245,23 @@ func updateProvisionWatcher(conn redis.Conn, pw models.ProvisionWatcher) errors.
 
 	return nil
 }
+
+// provisionWatchersByLabelSelector query provision watchers whose labels satisfy the label selector with offset and limit, and returns the total count of
+// the selected provision watchers
+func provisionWatchersByLabelSelector(conn redis.Conn, offset int, limit int, selector labelselector.Selector) (provisionWatchers []models.ProvisionWatcher, totalCount uint32, edgeXerr errors.EdgeX) {
+	objects, totalCount, edgeXerr := getObjectsByLabelSelector(conn, ZREVRANGE, ProvisionWatcherCollection, selector, offset, limit)
+	if edgeXerr != nil {
+		return provisionWatchers, totalCount, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	provisionWatchers = make([]models.ProvisionWatcher, len(objects))
+	for i, in := range objects {
+		o := models.ProvisionWatcher{}
+		err := json.Unmarshal(in, &o)
+		if err != nil {
+			return []models.ProvisionWatcher{}, totalCount, errors.NewCommonEdgeX(errors.KindDatabaseError, "provision watcher format parsing failed from the database", err)
+		}
+		provisionWatchers[i] = o
+	}
+	return provisionWatchers, totalCount, nil
+}
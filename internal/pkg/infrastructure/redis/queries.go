@@ -12,6 +12,8 @@ import (
 	"strings"
 
 	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/labelselector"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 
@@ -108,6 +110,144 @@ func getObjectsByScoreRange(conn redis.Conn, key string, start int, end int, off
 	return getObjectsByIds(conn, pkgCommon.ConvertStringsToInterfaces(objIds))
 }
 
+// getObjectIdsAfterKeyset retrieves, in ascending order of score then member, at most limit members of a sorted set
+// whose score is within start and end.  When member isn't empty, only the members positioned after member with the
+// score start are retrieved, so that walking through a sorted set isn't affected by members being added or removed.
+// The scores of the retrieved members are returned along with them.
+func getObjectIdsAfterKeyset(conn redis.Conn, key string, start int64, end int64, member string, limit int) (ids []string, scores []int64, edgeXerr errors.EdgeX) {
+	if limit <= 0 {
+		return nil, nil, nil
+	}
+	min := strconv.FormatInt(start, 10)
+	if member != "" {
+		// members sharing the same score are sorted lexicographically
+		values, err := redis.Strings(conn.Do(ZRANGEBYSCORE, key, start, start))
+		if err != nil {
+			return nil, nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "query object ids from database failed", err)
+		}
+		for _, v := range values {
+			if v > member && len(ids) < limit {
+				ids = append(ids, v)
+				scores = append(scores, start)
+			}
+		}
+		if len(ids) == limit {
+			return ids, scores, nil
+		}
+		min = "(" + min
+	}
+
+	values, err := redis.Strings(conn.Do(ZRANGEBYSCORE, key, min, end, WITHSCORES, LIMIT, 0, limit-len(ids)))
+	if err != nil {
+		return nil, nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "query object ids from database failed", err)
+	}
+	for i := 0; i+1 < len(values); i += 2 {
+		score, err := strconv.ParseInt(values[i+1], 10, 64)
+		if err != nil {
+			return nil, nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("failed to parse the score of %s", values[i]), err)
+		}
+		ids = append(ids, values[i])
+		scores = append(scores, score)
+	}
+	return ids, scores, nil
+}
+
+// getObjectIdsBeforeKeyset retrieves, in descending order of score then member, at most limit members of a sorted set.
+// When member isn't empty, only the members positioned after member with the score start in that order are retrieved.
+// The scores of the retrieved members are returned along with them.
+func getObjectIdsBeforeKeyset(conn redis.Conn, key string, start int64, member string, limit int) (ids []string, scores []int64, edgeXerr errors.EdgeX) {
+	if limit <= 0 {
+		return nil, nil, nil
+	}
+	max := InfiniteMax
+	if member != "" {
+		// members sharing the same score are sorted lexicographically, so in reverse order here
+		values, err := redis.Strings(conn.Do(ZREVRANGEBYSCORE, key, start, start))
+		if err != nil {
+			return nil, nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "query object ids from database failed", err)
+		}
+		for _, v := range values {
+			if v < member && len(ids) < limit {
+				ids = append(ids, v)
+				scores = append(scores, start)
+			}
+		}
+		if len(ids) == limit {
+			return ids, scores, nil
+		}
+		max = "(" + strconv.FormatInt(start, 10)
+	}
+
+	values, err := redis.Strings(conn.Do(ZREVRANGEBYSCORE, key, max, InfiniteMin, WITHSCORES, LIMIT, 0, limit-len(ids)))
+	if err != nil {
+		return nil, nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "query object ids from database failed", err)
+	}
+	for i := 0; i+1 < len(values); i += 2 {
+		score, err := strconv.ParseInt(values[i+1], 10, 64)
+		if err != nil {
+			return nil, nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("failed to parse the score of %s", values[i]), err)
+		}
+		ids = append(ids, values[i])
+		scores = append(scores, score)
+	}
+	return ids, scores, nil
+}
+
+// getObjectsBeforeCursor retrieves, in descending order of score then id, at most limit entries enumerated in a sorted
+// set and positioned after the cursor.  The members of the sorted set are expected to be the keys of the entries in the
+// collection.  The returned cursor is positioned on the last entry visited, or is the zero cursor once every entry has
+// been visited.
+func getObjectsBeforeCursor(conn redis.Conn, key string, collection string, cursor pkgModels.Cursor, limit int) ([][]byte, pkgModels.Cursor, errors.EdgeX) {
+	member := ""
+	if !cursor.IsZero() {
+		member = CreateKey(collection, cursor.Id)
+	}
+	ids, scores, edgeXerr := getObjectIdsBeforeKeyset(conn, key, cursor.Score, member, limit)
+	if edgeXerr != nil {
+		return nil, cursor, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return getObjectsAtKeyset(conn, collection, ids, scores, limit)
+}
+
+// getObjectsBeforeMemberCursor is getObjectsBeforeCursor for the sorted sets whose members all share the same score,
+// which are walked through in reverse lexicographical order of member without scanning the members sharing the score.
+func getObjectsBeforeMemberCursor(conn redis.Conn, key string, collection string, cursor pkgModels.Cursor, limit int) ([][]byte, pkgModels.Cursor, errors.EdgeX) {
+	if limit <= 0 {
+		return nil, pkgModels.Cursor{}, nil
+	}
+	max := InfiniteLexMax
+	if !cursor.IsZero() {
+		max = "(" + CreateKey(collection, cursor.Id)
+	}
+	ids, err := redis.Strings(conn.Do(ZREVRANGEBYLEX, key, max, InfiniteLexMin, LIMIT, 0, limit))
+	if err != nil {
+		return nil, cursor, errors.NewCommonEdgeX(errors.KindDatabaseError, "query object ids from database failed", err)
+	}
+	scores := make([]int64, len(ids))
+	for i := range scores {
+		scores[i] = cursor.Score
+	}
+	return getObjectsAtKeyset(conn, collection, ids, scores, limit)
+}
+
+// getObjectsAtKeyset retrieves the entries of the ids queried from a keyset, and returns the cursor positioned on the
+// last id, or the zero cursor when less than limit ids were queried as every entry has then been visited.  The cursor
+// is positioned on the last id rather than the last entry, as the entries deleted in the meantime are skipped.
+func getObjectsAtKeyset(conn redis.Conn, collection string, ids []string, scores []int64, limit int) ([][]byte, pkgModels.Cursor, errors.EdgeX) {
+	if len(ids) == 0 {
+		return nil, pkgModels.Cursor{}, nil
+	}
+	objects, edgeXerr := getObjectsByIds(conn, pkgCommon.ConvertStringsToInterfaces(ids))
+	if edgeXerr != nil {
+		return nil, pkgModels.Cursor{}, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if len(ids) < limit {
+		return objects, pkgModels.Cursor{}, nil
+	}
+	last := len(ids) - 1
+	return objects, pkgModels.Cursor{Score: scores[last], Id: strings.TrimPrefix(ids[last], collection+DBKeySeparator)}, nil
+}
+
 // getObjectsByLabelsAndSomeRange retrieves the entries for keys enumerated in a sorted set using the specified Redis range
 // command (i.e. RANGE, REVRANGE). The entries are retrieved in the order specified by the supplied Redis command.
 func getObjectsByLabelsAndSomeRange(conn redis.Conn, command string, key string, labels []string, offset int, limit int) ([][]byte, errors.EdgeX) {
@@ -145,6 +285,99 @@ func getObjectsByLabelsAndSomeRange(conn redis.Conn, command string, key string,
 	return getObjectsByIds(conn, pkgCommon.ConvertStringsToInterfaces(commonIds))
 }
 
+// getObjectsByLabelSelector query objects of the collection whose labels satisfy the label selector with offset and
+// limit, and returns the total count of the selected objects.  The candidate objects are read from the label indexes of
+// the requirements which can only be satisfied by labels of their key, or from the whole collection when the selector
+// has no such requirement, and are then matched against all the requirements.
+func getObjectsByLabelSelector(conn redis.Conn, command string, collection string, selector labelselector.Selector, offset int, limit int) ([][]byte, uint32, errors.EdgeX) {
+	var idsSlice [][]string
+	for _, r := range selector {
+		if !r.IsIndexed() {
+			continue
+		}
+		var indexKeys []string
+		if r.Operator == labelselector.Exists {
+			indexKeys = []string{CreateKey(collection, LabelKey, r.Key)}
+		} else {
+			for _, v := range r.Values {
+				indexKeys = append(indexKeys, CreateKey(collection, common.Label, labelselector.Label(r.Key, v)))
+			}
+		}
+		var ids []string
+		idSet := make(map[string]bool)
+		for _, indexKey := range indexKeys {
+			indexIds, err := redis.Strings(conn.Do(command, indexKey, 0, -1))
+			if err != nil {
+				return nil, 0, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query object ids by label index %s from database failed", indexKey), err)
+			}
+			for _, id := range indexIds {
+				if !idSet[id] {
+					idSet[id] = true
+					ids = append(ids, id)
+				}
+			}
+		}
+		idsSlice = append(idsSlice, ids)
+	}
+
+	var candidateIds []string
+	if len(idsSlice) == 0 {
+		ids, err := redis.Strings(conn.Do(command, collection, 0, -1))
+		if err != nil {
+			return nil, 0, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query object ids of %s from database failed", collection), err)
+		}
+		candidateIds = ids
+	} else {
+		candidateIds = pkgCommon.FindCommonStrings(idsSlice...)
+	}
+	candidates, edgeXerr := getObjectsByIds(conn, pkgCommon.ConvertStringsToInterfaces(candidateIds))
+	if edgeXerr != nil {
+		return nil, 0, edgeXerr
+	}
+
+	var objects [][]byte
+	for _, candidate := range candidates {
+		if candidate == nil {
+			continue
+		}
+		var labeled struct {
+			Labels []string
+		}
+		if err := json.Unmarshal(candidate, &labeled); err != nil {
+			return nil, 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to unmarshal the labels of the object", err)
+		}
+		if selector.Matches(labeled.Labels) {
+			objects = append(objects, candidate)
+		}
+	}
+
+	count := len(objects)
+	if offset > count {
+		return nil, 0, errors.NewCommonEdgeX(errors.KindRangeNotSatisfiable, fmt.Sprintf("query objects bounds out of range. length:%v", count), nil)
+	}
+	end := offset + limit
+	if limit < 0 || end > count {
+		end = count
+	}
+	return objects[offset:end], uint32(count), nil
+}
+
+// sendAddLabelKeysCmd send redis command for adding the stored key into the indexes of the keys of the labels
+func sendAddLabelKeysCmd(conn redis.Conn, labelKeyCollection string, labels []string, score int64, storedKey string) {
+	for _, label := range labels {
+		key, _ := labelselector.KeyValue(label)
+		_ = conn.Send(ZADD, CreateKey(labelKeyCollection, key), score, storedKey)
+	}
+}
+
+// sendDeleteLabelKeysCmd send redis command for deleting the stored key from the indexes of the keys of the labels
+func sendDeleteLabelKeysCmd(conn redis.Conn, labelKeyCollection string, labels []string, storedKey string) {
+	for _, label := range labels {
+		key, _ := labelselector.KeyValue(label)
+		_ = conn.Send(ZREM, CreateKey(labelKeyCollection, key), storedKey)
+	}
+}
+
 // getObjectsByIds retrieves the entries with Ids
 func getObjectsByIds(conn redis.Conn, ids []interface{}) ([][]byte, errors.EdgeX) {
 	var result [][]byte
@@ -0,0 +1,42 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"testing"
+
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetObjectsBeforeMemberCursor(t *testing.T) {
+	conn := newFakeConn()
+	for _, id := range []string{"a", "b", "c", "d", "e"} {
+		storedKey := CreateKey(DeviceCollection, id)
+		_ = conn.execute(ZADD, DeviceCollection, 0, storedKey)
+		// the device d is deleted while the devices are walked through
+		if id != "d" {
+			conn.strings[storedKey] = []byte(`"` + id + `"`)
+		}
+	}
+
+	objects, next, err := getObjectsBeforeMemberCursor(conn, DeviceCollection, DeviceCollection, pkgModels.Cursor{}, 2)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte(`"e"`)}, objects)
+	assert.Equal(t, pkgModels.Cursor{Id: "d"}, next, "a page missing deleted devices is not the last page")
+
+	objects, next, err = getObjectsBeforeMemberCursor(conn, DeviceCollection, DeviceCollection, next, 2)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte(`"c"`), []byte(`"b"`)}, objects)
+	assert.Equal(t, pkgModels.Cursor{Id: "b"}, next)
+
+	objects, next, err = getObjectsBeforeMemberCursor(conn, DeviceCollection, DeviceCollection, next, 2)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte(`"a"`)}, objects)
+	assert.True(t, next.IsZero(), "the cursor should be reset once every device has been visited")
+
+	assert.NotContains(t, conn.commands, ZREVRANGEBYSCORE, "the devices sharing the score should not be scanned")
+}
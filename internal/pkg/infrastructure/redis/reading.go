@@ -8,12 +8,14 @@ package redis
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
 
 	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 
 	"github.com/gomodule/redigo/redis"
 	"github.com/google/uuid"
@@ -25,6 +27,7 @@ const (
 	ReadingsCollectionDeviceName             = ReadingsCollection + DBKeySeparator + common.DeviceName
 	ReadingsCollectionResourceName           = ReadingsCollection + DBKeySeparator + common.ResourceName
 	ReadingsCollectionDeviceNameResourceName = ReadingsCollection + DBKeySeparator + common.DeviceName + DBKeySeparator + common.ResourceName
+	ReadingsCollectionProfileName            = ReadingsCollection + DBKeySeparator + common.ProfileName
 )
 
 var emptyBinaryValue = make([]byte, 0)
@@ -61,6 +64,7 @@ func (c *Client) asyncDeleteReadingsByIds(readingIds []string) {
 		_ = conn.Send(ZREM, CreateKey(ReadingsCollectionDeviceName, r.DeviceName), storedKey)
 		_ = conn.Send(ZREM, CreateKey(ReadingsCollectionResourceName, r.ResourceName), storedKey)
 		_ = conn.Send(ZREM, CreateKey(ReadingsCollectionDeviceNameResourceName, r.DeviceName, r.ResourceName), storedKey)
+		_ = conn.Send(ZREM, CreateKey(ReadingsCollectionProfileName, r.ProfileName), storedKey)
 		queriesInQueue++
 
 		if queriesInQueue >= c.BatchSize {
@@ -91,8 +95,14 @@ func readingStoredKey(id string) string {
 	return CreateKey(ReadingsCollection, id)
 }
 
-// Add a reading to the database
-func addReading(conn redis.Conn, r models.Reading) (reading models.Reading, edgeXerr errors.EdgeX) {
+// storedReading holds a reading marshalled for storage
+type storedReading struct {
+	base models.BaseReading
+	blob []byte
+}
+
+// newStoredReading validates and marshals the reading, returning the reading as it is stored
+func newStoredReading(r models.Reading) (reading models.Reading, stored storedReading, edgeXerr errors.EdgeX) {
 	var m []byte
 	var err error
 	var baseReading *models.BaseReading
@@ -103,41 +113,45 @@ func addReading(conn redis.Conn, r models.Reading) (reading models.Reading, edge
 
 		baseReading = &newReading.BaseReading
 		if err = checkReadingValue(baseReading); err != nil {
-			return nil, errors.NewCommonEdgeXWrapper(err)
+			return nil, stored, errors.NewCommonEdgeXWrapper(err)
 		}
 		m, err = json.Marshal(newReading)
 		reading = newReading
 	case models.SimpleReading:
 		baseReading = &newReading.BaseReading
 		if err = checkReadingValue(baseReading); err != nil {
-			return nil, errors.NewCommonEdgeXWrapper(err)
+			return nil, stored, errors.NewCommonEdgeXWrapper(err)
 		}
 		m, err = json.Marshal(newReading)
 		reading = newReading
 	case models.ObjectReading:
 		baseReading = &newReading.BaseReading
 		if err = checkReadingValue(baseReading); err != nil {
-			return nil, errors.NewCommonEdgeXWrapper(err)
+			return nil, stored, errors.NewCommonEdgeXWrapper(err)
 		}
 		m, err = json.Marshal(newReading)
 		reading = newReading
 	default:
-		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "unsupported reading type", nil)
+		return nil, stored, errors.NewCommonEdgeX(errors.KindContractInvalid, "unsupported reading type", nil)
 	}
 
 	if err != nil {
-		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "reading parsing failed", err)
+		return nil, stored, errors.NewCommonEdgeX(errors.KindContractInvalid, "reading parsing failed", err)
 	}
-	storedKey := readingStoredKey(baseReading.Id)
+	return reading, storedReading{base: *baseReading, blob: m}, nil
+}
+
+// send queues the commands saving the reading and its indexes
+func (r storedReading) send(conn redis.Conn) {
+	storedKey := readingStoredKey(r.base.Id)
 	// use the SET command to save reading as blob
-	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(SET, storedKey, r.blob)
 	_ = conn.Send(ZADD, ReadingsCollection, 0, storedKey)
-	_ = conn.Send(ZADD, ReadingsCollectionOrigin, baseReading.Origin, storedKey)
-	_ = conn.Send(ZADD, CreateKey(ReadingsCollectionDeviceName, baseReading.DeviceName), baseReading.Origin, storedKey)
-	_ = conn.Send(ZADD, CreateKey(ReadingsCollectionResourceName, baseReading.ResourceName), baseReading.Origin, storedKey)
-	_ = conn.Send(ZADD, CreateKey(ReadingsCollectionDeviceNameResourceName, baseReading.DeviceName, baseReading.ResourceName), baseReading.Origin, storedKey)
-
-	return reading, nil
+	_ = conn.Send(ZADD, ReadingsCollectionOrigin, r.base.Origin, storedKey)
+	_ = conn.Send(ZADD, CreateKey(ReadingsCollectionDeviceName, r.base.DeviceName), r.base.Origin, storedKey)
+	_ = conn.Send(ZADD, CreateKey(ReadingsCollectionResourceName, r.base.ResourceName), r.base.Origin, storedKey)
+	_ = conn.Send(ZADD, CreateKey(ReadingsCollectionDeviceNameResourceName, r.base.DeviceName, r.base.ResourceName), r.base.Origin, storedKey)
+	_ = conn.Send(ZADD, CreateKey(ReadingsCollectionProfileName, r.base.ProfileName), r.base.Origin, storedKey)
 }
 
 // Remove a reading out of the database
@@ -156,6 +170,7 @@ func deleteReadingById(conn redis.Conn, id string) (edgeXerr errors.EdgeX) {
 	_ = conn.Send(ZREM, CreateKey(ReadingsCollectionDeviceName, r.DeviceName), storedKey)
 	_ = conn.Send(ZREM, CreateKey(ReadingsCollectionResourceName, r.ResourceName), storedKey)
 	_ = conn.Send(ZREM, CreateKey(ReadingsCollectionDeviceNameResourceName, r.DeviceName, r.ResourceName), storedKey)
+	_ = conn.Send(ZREM, CreateKey(ReadingsCollectionProfileName, r.ProfileName), storedKey)
 	_, err := conn.Do(EXEC)
 	if err != nil {
 		return errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("reading[id:%s] delete failed", id), err)
@@ -274,6 +289,64 @@ func readingsByDeviceNameAndTimeRange(conn redis.Conn, deviceName string, startT
 	return convertObjectsToReadings(objects)
 }
 
+// readingsByCursor queries, in ascending order of origin then id, at most limit readings matching the filter and
+// positioned after the cursor.  The returned cursor is positioned on the last reading visited, so it is left
+// unchanged once every reading has been visited.
+func readingsByCursor(conn redis.Conn, filter pkgModels.ReadingFilter, cursor pkgModels.Cursor, limit int) (readings []models.Reading, next pkgModels.Cursor, edgeXerr errors.EdgeX) {
+	key := ReadingsCollectionOrigin
+	switch {
+	case filter.DeviceName != "" && filter.ResourceName != "":
+		key = CreateKey(ReadingsCollectionDeviceNameResourceName, filter.DeviceName, filter.ResourceName)
+	case filter.DeviceName != "":
+		key = CreateKey(ReadingsCollectionDeviceName, filter.DeviceName)
+	case filter.ResourceName != "":
+		key = CreateKey(ReadingsCollectionResourceName, filter.ResourceName)
+	}
+
+	start, member := filter.Start, ""
+	if cursor.Id != "" {
+		start, member = cursor.Score, readingStoredKey(cursor.Id)
+	}
+	ids, scores, edgeXerr := getObjectIdsAfterKeyset(conn, key, start, filter.End, member, limit)
+	if edgeXerr != nil {
+		return nil, cursor, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if len(ids) == 0 {
+		return nil, cursor, nil
+	}
+	objects, edgeXerr := getObjectsByIds(conn, pkgCommon.ConvertStringsToInterfaces(ids))
+	if edgeXerr != nil {
+		return nil, cursor, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	readings, edgeXerr = convertObjectsToReadings(objects)
+	if edgeXerr != nil {
+		return nil, cursor, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	// the cursor is positioned on the last id rather than the last reading, as readings deleted in the meantime are skipped
+	last := len(ids) - 1
+	next = pkgModels.Cursor{Score: scores[last], Id: strings.TrimPrefix(ids[last], ReadingsCollection+DBKeySeparator)}
+	return readings, next, nil
+}
+
+// readingsBeforeCursor query, in descending order of origin then id, at most limit readings positioned after the
+// cursor.  The readings of every device are queried when name is empty.
+func readingsBeforeCursor(conn redis.Conn, name string, cursor pkgModels.Cursor, limit int) (readings []models.Reading, next pkgModels.Cursor, edgeXerr errors.EdgeX) {
+	key := ReadingsCollectionOrigin
+	if name != "" {
+		key = CreateKey(ReadingsCollectionDeviceName, name)
+	}
+	objects, next, edgeXerr := getObjectsBeforeCursor(conn, key, ReadingsCollection, cursor, limit)
+	if edgeXerr != nil {
+		return nil, cursor, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	readings, edgeXerr = convertObjectsToReadings(objects)
+	if edgeXerr != nil {
+		return nil, cursor, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return readings, next, nil
+}
+
 func convertObjectsToReadings(objects [][]byte) (readings []models.Reading, edgeXerr errors.EdgeX) {
 	readings = make([]models.Reading, len(objects))
 	var alias struct {
@@ -310,8 +383,10 @@ func convertObjectsToReadings(objects [][]byte) (readings []models.Reading, edge
 	return readings, nil
 }
 
-func latestReadingByOffset(conn redis.Conn, offset int) (reading models.Reading, edgeXerr errors.EdgeX) {
-	objects, err := getObjectsByRevRange(conn, ReadingsCollectionOrigin, offset, 1)
+// latestReadingByOffset returns the reading at the offset of the readings indexed by key, sorted from the latest to
+// the oldest
+func latestReadingByOffset(conn redis.Conn, key string, offset int) (reading models.Reading, edgeXerr errors.EdgeX) {
+	objects, err := getObjectsByRevRange(conn, key, offset, 1)
 	if err != nil {
 		return nil, errors.NewCommonEdgeXWrapper(err)
 	}
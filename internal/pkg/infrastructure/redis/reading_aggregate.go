@@ -0,0 +1,177 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/gomodule/redigo/redis"
+)
+
+// windowAccumulator keeps the running state of a single aggregation window
+type windowAccumulator struct {
+	min, max, sum float64
+	first, last   float64
+	count         int
+}
+
+// readingAggregator downsamples readings into epoch-aligned windows.  Readings must be added in ascending origin
+// order so that the first and last values of each window are tracked correctly.
+type readingAggregator struct {
+	deviceName   string
+	resourceName string
+	window       int64
+	functions    []string
+	windows      map[int64]*windowAccumulator
+}
+
+func newReadingAggregator(deviceName string, resourceName string, window int64, functions []string) *readingAggregator {
+	return &readingAggregator{
+		deviceName:   deviceName,
+		resourceName: resourceName,
+		window:       window,
+		functions:    functions,
+		windows:      make(map[int64]*windowAccumulator),
+	}
+}
+
+// add accumulates the reading into its window.  Readings which don't carry a numeric value are ignored.
+func (a *readingAggregator) add(r models.Reading) {
+	simpleReading, ok := r.(models.SimpleReading)
+	if !ok || !isNumericValueType(simpleReading.ValueType) {
+		return
+	}
+	value, err := strconv.ParseFloat(simpleReading.Value, 64)
+	if err != nil || math.IsNaN(value) {
+		return
+	}
+
+	start := pkgModels.AggregateWindowStart(simpleReading.Origin, a.window)
+	acc, exists := a.windows[start]
+	if !exists {
+		a.windows[start] = &windowAccumulator{min: value, max: value, sum: value, first: value, last: value, count: 1}
+		return
+	}
+	acc.min = math.Min(acc.min, value)
+	acc.max = math.Max(acc.max, value)
+	acc.sum += value
+	acc.last = value
+	acc.count++
+}
+
+// result returns the aggregated windows sorted in ascending order of window start
+func (a *readingAggregator) result() []pkgModels.ReadingAggregate {
+	starts := make([]int64, 0, len(a.windows))
+	for start := range a.windows {
+		starts = append(starts, start)
+	}
+	sort.Slice(starts, func(i, j int) bool { return starts[i] < starts[j] })
+
+	aggregates := make([]pkgModels.ReadingAggregate, len(starts))
+	for i, start := range starts {
+		acc := a.windows[start]
+		values := make(map[string]float64, len(a.functions))
+		for _, f := range a.functions {
+			switch f {
+			case pkgModels.AggregateMin:
+				values[f] = acc.min
+			case pkgModels.AggregateMax:
+				values[f] = acc.max
+			case pkgModels.AggregateMean:
+				values[f] = acc.sum / float64(acc.count)
+			case pkgModels.AggregateSum:
+				values[f] = acc.sum
+			case pkgModels.AggregateCount:
+				values[f] = float64(acc.count)
+			case pkgModels.AggregateFirst:
+				values[f] = acc.first
+			case pkgModels.AggregateLast:
+				values[f] = acc.last
+			}
+		}
+		aggregates[i] = pkgModels.ReadingAggregate{
+			DeviceName:   a.deviceName,
+			ResourceName: a.resourceName,
+			Start:        start,
+			End:          start + a.window,
+			Values:       values,
+		}
+	}
+	return aggregates
+}
+
+func isNumericValueType(valueType string) bool {
+	switch valueType {
+	case common.ValueTypeUint8, common.ValueTypeUint16, common.ValueTypeUint32, common.ValueTypeUint64,
+		common.ValueTypeInt8, common.ValueTypeInt16, common.ValueTypeInt32, common.ValueTypeInt64,
+		common.ValueTypeFloat32, common.ValueTypeFloat64:
+		return true
+	}
+	return false
+}
+
+// aggregateReadingsByDeviceNameAndResourceNameAndTimeRange downsamples the readings of the specified device resource
+// within the time range.  The readings are scanned in ascending origin order, batchSize readings at a time, so the
+// memory usage doesn't grow with the size of the time range.  Each batch starts from the origin of the last reading of
+// the previous batch, skipping the readings of that origin already aggregated, rather than from an offset from the
+// start of the range, so that the readings purged or added meanwhile are neither aggregated twice nor skipped.
+func aggregateReadingsByDeviceNameAndResourceNameAndTimeRange(conn redis.Conn, deviceName string, resourceName string, start int, end int, window int64, functions []string, batchSize int) ([]pkgModels.ReadingAggregate, errors.EdgeX) {
+	key := CreateKey(ReadingsCollectionDeviceNameResourceName, deviceName, resourceName)
+	aggregator := newReadingAggregator(deviceName, resourceName, window, functions)
+	from := strconv.Itoa(start)
+	// aggregated holds the readings of the origin the batch starts from which are already aggregated
+	aggregated := make(map[string]bool)
+	for {
+		count := batchSize + len(aggregated)
+		// ZRANGEBYSCORE key min max WITHSCORES LIMIT offset count
+		values, err := redis.Strings(conn.Do(ZRANGEBYSCORE, key, from, end, WITHSCORES, LIMIT, 0, count))
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query reading ids from %s failed", key), err)
+		}
+		if len(values) == 0 {
+			break
+		}
+		lastScore := values[len(values)-1]
+		lastAggregated := make(map[string]bool)
+		if lastScore == from {
+			lastAggregated = aggregated
+		}
+		ids := make([]interface{}, 0, len(values)/2)
+		for i := 0; i < len(values); i += 2 {
+			id, score := values[i], values[i+1]
+			if score == from && aggregated[id] {
+				continue
+			}
+			ids = append(ids, id)
+			if score == lastScore {
+				lastAggregated[id] = true
+			}
+		}
+		objects, edgeXerr := getObjectsByIds(conn, ids)
+		if edgeXerr != nil {
+			return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		readings, edgeXerr := convertObjectsToReadings(objects)
+		if edgeXerr != nil {
+			return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		for _, r := range readings {
+			aggregator.add(r)
+		}
+		if len(values)/2 < count {
+			break
+		}
+		from, aggregated = lastScore, lastAggregated
+	}
+	return aggregator.result(), nil
+}
@@ -0,0 +1,113 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+)
+
+func numericReading(origin int64, valueType string, value string) models.SimpleReading {
+	r := simpleReadingData()
+	r.Origin = origin
+	r.ValueType = valueType
+	r.Value = value
+	return r
+}
+
+func TestReadingAggregator(t *testing.T) {
+	window := int64(10)
+	aggregator := newReadingAggregator(testDeviceName, testResourceName, window, pkgModels.AggregateFunctions)
+	aggregator.add(numericReading(100, common.ValueTypeInt32, "4"))
+	aggregator.add(numericReading(103, common.ValueTypeFloat64, "2.5e+00"))
+	aggregator.add(numericReading(109, common.ValueTypeUint8, "6"))
+	aggregator.add(numericReading(115, common.ValueTypeInt64, "-1"))
+	// non-numeric readings are ignored
+	aggregator.add(numericReading(104, common.ValueTypeString, "abc"))
+	aggregator.add(numericReading(105, common.ValueTypeBool, "true"))
+	aggregator.add(binaryReadingData())
+	aggregator.add(objectReadingData())
+
+	result := aggregator.result()
+	require.Len(t, result, 2)
+
+	assert.Equal(t, int64(100), result[0].Start)
+	assert.Equal(t, int64(110), result[0].End)
+	assert.Equal(t, testDeviceName, result[0].DeviceName)
+	assert.Equal(t, testResourceName, result[0].ResourceName)
+	assert.Equal(t, map[string]float64{
+		pkgModels.AggregateMin:   2.5,
+		pkgModels.AggregateMax:   6,
+		pkgModels.AggregateMean:  12.5 / 3,
+		pkgModels.AggregateSum:   12.5,
+		pkgModels.AggregateCount: 3,
+		pkgModels.AggregateFirst: 4,
+		pkgModels.AggregateLast:  6,
+	}, result[0].Values)
+
+	assert.Equal(t, int64(110), result[1].Start)
+	assert.Equal(t, float64(1), result[1].Values[pkgModels.AggregateCount])
+	assert.Equal(t, float64(-1), result[1].Values[pkgModels.AggregateMean])
+}
+
+func TestReadingAggregatorSelectedFunctions(t *testing.T) {
+	aggregator := newReadingAggregator(testDeviceName, testResourceName, 1000, []string{pkgModels.AggregateMax, pkgModels.AggregateCount})
+	aggregator.add(numericReading(1, common.ValueTypeInt8, "1"))
+	aggregator.add(numericReading(2, common.ValueTypeInt8, "7"))
+
+	result := aggregator.result()
+	require.Len(t, result, 1)
+	assert.Equal(t, map[string]float64{pkgModels.AggregateMax: 7, pkgModels.AggregateCount: 2}, result[0].Values)
+}
+
+// purgingConn purges the oldest reading of the sorted set once the first batch of readings is queried
+type purgingConn struct {
+	*fakeConn
+	key    string
+	purged bool
+}
+
+func (c *purgingConn) Do(commandName string, args ...any) (any, error) {
+	reply, err := c.fakeConn.Do(commandName, args...)
+	if commandName == MGET && !c.purged {
+		c.purged = true
+		delete(c.zsets[c.key], c.zsetMembers(c.key)[0])
+	}
+	return reply, err
+}
+
+func TestAggregateReadingsByDeviceNameAndResourceNameAndTimeRange(t *testing.T) {
+	key := CreateKey(ReadingsCollectionDeviceNameResourceName, testDeviceName, testResourceName)
+	conn := &purgingConn{fakeConn: newFakeConn(), key: key}
+	// the readings of the same origin span the batches
+	origins := []int64{100, 100, 100, 105, 112}
+	for i, origin := range origins {
+		r := numericReading(origin, common.ValueTypeInt32, strconv.Itoa(i+1))
+		r.Id = fmt.Sprintf("reading-%d", i)
+		m, err := json.Marshal(r)
+		require.NoError(t, err)
+		_, err = conn.Do(SET, readingStoredKey(r.Id), m)
+		require.NoError(t, err)
+		_, err = conn.Do(ZADD, key, origin, readingStoredKey(r.Id))
+		require.NoError(t, err)
+	}
+
+	result, err := aggregateReadingsByDeviceNameAndResourceNameAndTimeRange(conn, testDeviceName, testResourceName, 0, 200, 10,
+		[]string{pkgModels.AggregateCount, pkgModels.AggregateSum}, 2)
+	require.NoError(t, err)
+
+	// the reading purged after being aggregated doesn't shift the next batches
+	require.Len(t, result, 2)
+	assert.Equal(t, map[string]float64{pkgModels.AggregateCount: 4, pkgModels.AggregateSum: 10}, result[0].Values)
+	assert.Equal(t, map[string]float64{pkgModels.AggregateCount: 1, pkgModels.AggregateSum: 5}, result[1].Values)
+}
@@ -0,0 +1,194 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package labelselector implements the Kubernetes style label selectors, e.g. `env=prod,line in (a,b)`, over the
+// labels of the EdgeX objects.  A label `key=value` is the key/value pair key and value, and a label without `=` is
+// the key with an empty value.
+package labelselector
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// Operator is the operator of a requirement
+type Operator string
+
+const (
+	Equals       Operator = "="
+	NotEquals    Operator = "!="
+	In           Operator = "in"
+	NotIn        Operator = "notin"
+	Exists       Operator = "exists"
+	DoesNotExist Operator = "!"
+)
+
+const keyValueSeparator = "="
+
+// Requirement is a requirement on the value of the label key.  Values holds the single value of the Equals and the
+// NotEquals operators and is empty for the Exists and the DoesNotExist operators.
+type Requirement struct {
+	Key      string
+	Operator Operator
+	Values   []string
+}
+
+// Selector is a set of requirements which are all satisfied by the selected labels
+type Selector []Requirement
+
+// KeyValue splits the label into its key and value
+func KeyValue(label string) (key string, value string) {
+	key, value, _ = strings.Cut(label, keyValueSeparator)
+	return key, value
+}
+
+// Label returns the label of the key/value pair, i.e. the key alone when the value is empty
+func Label(key string, value string) string {
+	if value == "" {
+		return key
+	}
+	return key + keyValueSeparator + value
+}
+
+// Parse parses the selector, whose requirements are separated by commas and are one of `key`, `!key`, `key=value`,
+// `key==value`, `key!=value`, `key in (value1,value2)` and `key notin (value1,value2)`
+func Parse(s string) (Selector, errors.EdgeX) {
+	var selector Selector
+	for _, r := range splitRequirements(s) {
+		requirement, err := parseRequirement(strings.TrimSpace(r))
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid label selector '%s'", s), err)
+		}
+		selector = append(selector, requirement)
+	}
+	if len(selector) == 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "label selector is empty", nil)
+	}
+	return selector, nil
+}
+
+// splitRequirements splits the selector by the commas which are not enclosed in parentheses
+func splitRequirements(s string) []string {
+	var requirements []string
+	depth, start := 0, 0
+	for i, c := range s {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				requirements = append(requirements, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if strings.TrimSpace(s) != "" {
+		requirements = append(requirements, s[start:])
+	}
+	return requirements
+}
+
+func parseRequirement(s string) (Requirement, error) {
+	if s == "" {
+		return Requirement{}, fmt.Errorf("empty requirement")
+	}
+	if strings.HasPrefix(s, "!") && !strings.Contains(s, "=") {
+		return newRequirement(strings.TrimSpace(s[1:]), DoesNotExist, nil)
+	}
+	if key, value, ok := strings.Cut(s, "!="); ok {
+		return newRequirement(strings.TrimSpace(key), NotEquals, []string{strings.TrimSpace(value)})
+	}
+	if key, value, ok := strings.Cut(s, "=="); ok {
+		return newRequirement(strings.TrimSpace(key), Equals, []string{strings.TrimSpace(value)})
+	}
+	if key, value, ok := strings.Cut(s, "="); ok {
+		return newRequirement(strings.TrimSpace(key), Equals, []string{strings.TrimSpace(value)})
+	}
+	fields := strings.Fields(s)
+	if len(fields) == 1 {
+		return newRequirement(fields[0], Exists, nil)
+	}
+	key, rest, _ := strings.Cut(s, " ")
+	rest = strings.TrimSpace(rest)
+	var operator Operator
+	switch {
+	case strings.HasPrefix(rest, string(NotIn)):
+		operator = NotIn
+	case strings.HasPrefix(rest, string(In)):
+		operator = In
+	default:
+		return Requirement{}, fmt.Errorf("unknown operator in requirement '%s'", s)
+	}
+	set := strings.TrimSpace(strings.TrimPrefix(rest, string(operator)))
+	if !strings.HasPrefix(set, "(") || !strings.HasSuffix(set, ")") {
+		return Requirement{}, fmt.Errorf("values of requirement '%s' are not enclosed in parentheses", s)
+	}
+	var values []string
+	for _, v := range strings.Split(set[1:len(set)-1], ",") {
+		values = append(values, strings.TrimSpace(v))
+	}
+	return newRequirement(key, operator, values)
+}
+
+func newRequirement(key string, operator Operator, values []string) (Requirement, error) {
+	if key == "" || strings.ContainsAny(key, " !=(),") {
+		return Requirement{}, fmt.Errorf("invalid key '%s'", key)
+	}
+	for _, v := range values {
+		if strings.ContainsAny(v, " !=(),") {
+			return Requirement{}, fmt.Errorf("invalid value '%s' of key '%s'", v, key)
+		}
+	}
+	return Requirement{Key: key, Operator: operator, Values: values}, nil
+}
+
+// IsIndexed checks whether the requirement selects the labels of a label index, i.e. only the objects with a label of
+// the key can satisfy the requirement
+func (r Requirement) IsIndexed() bool {
+	return r.Operator == Equals || r.Operator == In || r.Operator == Exists
+}
+
+// Matches checks whether the labels satisfy the requirement
+func (r Requirement) Matches(labels []string) bool {
+	values := make(map[string]bool)
+	for _, label := range labels {
+		if key, value := KeyValue(label); key == r.Key {
+			values[value] = true
+		}
+	}
+	switch r.Operator {
+	case Exists:
+		return len(values) > 0
+	case DoesNotExist:
+		return len(values) == 0
+	case Equals, In:
+		for _, v := range r.Values {
+			if values[v] {
+				return true
+			}
+		}
+		return false
+	case NotEquals, NotIn:
+		for _, v := range r.Values {
+			if values[v] {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// Matches checks whether the labels satisfy all the requirements of the selector
+func (s Selector) Matches(labels []string) bool {
+	for _, r := range s {
+		if !r.Matches(labels) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,76 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package labelselector
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name          string
+		selector      string
+		expected      Selector
+		errorExpected bool
+	}{
+		{"equals", "env=prod", Selector{{Key: "env", Operator: Equals, Values: []string{"prod"}}}, false},
+		{"double equals", "env==prod", Selector{{Key: "env", Operator: Equals, Values: []string{"prod"}}}, false},
+		{"not equals", "env!=prod", Selector{{Key: "env", Operator: NotEquals, Values: []string{"prod"}}}, false},
+		{"exists", "modbus", Selector{{Key: "modbus", Operator: Exists}}, false},
+		{"does not exist", "!modbus", Selector{{Key: "modbus", Operator: DoesNotExist}}, false},
+		{"in", "line in (a, b)", Selector{{Key: "line", Operator: In, Values: []string{"a", "b"}}}, false},
+		{"not in", "line notin (a,b)", Selector{{Key: "line", Operator: NotIn, Values: []string{"a", "b"}}}, false},
+		{"multiple requirements", "env=prod, line in (a,b),!test", Selector{
+			{Key: "env", Operator: Equals, Values: []string{"prod"}},
+			{Key: "line", Operator: In, Values: []string{"a", "b"}},
+			{Key: "test", Operator: DoesNotExist},
+		}, false},
+		{"invalid - empty", "", nil, true},
+		{"invalid - empty requirement", "env=prod,,line", nil, true},
+		{"invalid - unknown operator", "line within (a,b)", nil, true},
+		{"invalid - no parentheses", "line in a,b", nil, true},
+		{"invalid - empty key", "=prod", nil, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			selector, err := Parse(testCase.selector)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, selector)
+		})
+	}
+}
+
+func TestSelectorMatches(t *testing.T) {
+	labels := []string{"env=prod", "line=a", "modbus"}
+	tests := []struct {
+		selector string
+		expected bool
+	}{
+		{"env=prod", true},
+		{"env=dev", false},
+		{"env!=dev", true},
+		{"owner!=me", true},
+		{"line in (a,b)", true},
+		{"line notin (a,b)", false},
+		{"modbus", true},
+		{"!modbus", false},
+		{"!test", true},
+		{"env=prod,line in (b,c)", false},
+		{"env=prod,line in (a,c),modbus", true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.selector, func(t *testing.T) {
+			selector, err := Parse(testCase.selector)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, selector.Matches(labels))
+		})
+	}
+}
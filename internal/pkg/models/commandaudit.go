@@ -0,0 +1,31 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// Constants related to the transports a command reaches core-command through
+const (
+	CommandAuditTransportREST               = "REST"
+	CommandAuditTransportInternalMessageBus = "InternalMessageBus"
+	CommandAuditTransportExternalMQTT       = "ExternalMQTT"
+	CommandAuditTransportCommandJob         = "CommandJob"
+)
+
+// CommandAuditRecord records a command issued to a device through core-command.  Created is the Unix timestamp in
+// milliseconds the command was received at, and Latency the milliseconds it took to complete.  Caller is the identity
+// of the client issuing the command, when known.
+type CommandAuditRecord struct {
+	Id            string
+	Created       int64
+	DeviceName    string
+	CommandName   string
+	Method        string
+	QueryParams   map[string]string
+	Settings      map[string]any
+	CorrelationId string
+	Caller        string
+	Transport     string
+	StatusCode    int
+	Message       string
+	Latency       int64
+}
@@ -0,0 +1,42 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// Constants related to the status of a command job
+const (
+	CommandJobStatusScheduled = "SCHEDULED"
+	CommandJobStatusCompleted = "COMPLETED"
+)
+
+// CommandJob is a device command issued by core-command at a later time, once or repeatedly.  The command is first
+// issued at Start and then every Interval until End, Start, End and NextRun being Unix timestamps in milliseconds.  A
+// job without Interval is only issued once, and a repeating job without End is issued until it is deleted.
+type CommandJob struct {
+	Created     int64
+	Modified    int64
+	Id          string
+	Name        string
+	DeviceName  string
+	CommandName string
+	Method      string
+	QueryParams map[string]string
+	Settings    map[string]any
+	Start       int64
+	End         int64
+	Interval    string
+	Status      string
+	NextRun     int64
+	RunCount    int64
+	LastResult  *CommandJobResult
+}
+
+// CommandJobResult is the outcome of the last run of a command job.  EventId and Readings are the id of the event read
+// by a get command and the values of its readings by resource name, when the event is returned.
+type CommandJobResult struct {
+	Timestamp  int64
+	StatusCode int
+	Message    string
+	EventId    string
+	Readings   map[string]string
+}
@@ -0,0 +1,67 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+)
+
+// ReadingFilter selects the readings of a device and/or a device resource within a time range.  An empty DeviceName or
+// ResourceName matches every device or resource.  Start and End are Unix timestamps in nanoseconds.
+type ReadingFilter struct {
+	DeviceName   string
+	ResourceName string
+	Start        int64
+	End          int64
+}
+
+// Cursor is a keyset position within entities ordered by a score, then by id.  The score is the origin of events and
+// readings, or the score the entities are indexed with in the database otherwise.  Unlike an offset, the position is
+// not shifted by entities being added or deleted while the entities are walked through.  The zero value is positioned
+// before the first entity.
+type Cursor struct {
+	Score int64
+	Id    string
+}
+
+// IsZero reports whether the cursor is positioned before the first entity
+func (c Cursor) IsZero() bool {
+	return c.Id == ""
+}
+
+// Token encodes the cursor as the opaque continuation token exposed to the clients
+func (c Cursor) Token() string {
+	if c.IsZero() {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(c.Score, 10) + ":" + c.Id))
+}
+
+// ParseCursorToken decodes a continuation token created by Cursor.Token, an empty token being the zero cursor
+func ParseCursorToken(token string) (Cursor, errors.EdgeX) {
+	if token == "" {
+		return Cursor{}, nil
+	}
+	invalid := func(err error) errors.EdgeX {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid cursor %s", token), err)
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return Cursor{}, invalid(err)
+	}
+	score, id, found := strings.Cut(string(decoded), ":")
+	if !found || id == "" {
+		return Cursor{}, invalid(nil)
+	}
+	c := Cursor{Id: id}
+	if c.Score, err = strconv.ParseInt(score, 10, 64); err != nil {
+		return Cursor{}, invalid(err)
+	}
+	return c, nil
+}
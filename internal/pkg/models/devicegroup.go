@@ -0,0 +1,16 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// DeviceGroup is a named group of devices targeted as a whole by the other services.  The members of the group are
+// the devices listed by name in DeviceNames and the devices whose labels satisfy the LabelSelector, if any.
+type DeviceGroup struct {
+	Created       int64
+	Modified      int64
+	Id            string
+	Name          string
+	Description   string
+	DeviceNames   []string
+	LabelSelector string
+}
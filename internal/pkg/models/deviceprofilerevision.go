@@ -0,0 +1,41 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+// The actions which create a device profile revision.  A baseline revision records a profile, added before the
+// revisions were recorded, as it was before its first update.
+const (
+	DeviceProfileRevisionActionAdd      = "add"
+	DeviceProfileRevisionActionUpdate   = "update"
+	DeviceProfileRevisionActionRollback = "rollback"
+	DeviceProfileRevisionActionBaseline = "baseline"
+)
+
+// DeviceProfileRevision is an immutable revision of a device profile, i.e. the profile as it was added, updated or
+// rolled back to by Author along with the changes from the previous revision.  The revisions of a profile are numbered
+// from 1 by Revision, and RolledBackTo is the number of the revision a rollback revision restores.
+type DeviceProfileRevision struct {
+	Created      int64
+	Id           string
+	ProfileName  string
+	Revision     int64
+	Author       string
+	Action       string
+	RolledBackTo int64
+	Profile      models.DeviceProfile
+	Changes      []DeviceProfileChange
+}
+
+// DeviceProfileChange is the change of a value of a device profile, identified by its JSON path in the profile DTO
+// e.g. `deviceResources[temperature].properties.units`.  Previous is nil for an added value and Current is nil for a
+// removed value.
+type DeviceProfileChange struct {
+	Path     string
+	Previous any
+	Current  any
+}
@@ -0,0 +1,62 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"sort"
+	"strconv"
+)
+
+// DeviceTwin is the desired and the reported state of a device, by device resource name.  The reported values are the
+// values of the latest readings of the device resources.
+type DeviceTwin struct {
+	Created    int64
+	Modified   int64
+	Id         string
+	DeviceName string
+	Properties map[string]TwinProperty
+}
+
+// TwinProperty is the desired and the reported value of a device resource, DesiredModified and ReportedOrigin being
+// the Unix timestamps in milliseconds the desired value was set at and the reported value was read at.  A value is
+// unset when its timestamp is 0.
+type TwinProperty struct {
+	Desired         string
+	DesiredModified int64
+	Reported        string
+	ReportedOrigin  int64
+}
+
+// IsDiverged checks whether the property has a desired value which has not been reported
+func (p TwinProperty) IsDiverged() bool {
+	if p.DesiredModified == 0 {
+		return false
+	}
+	if p.ReportedOrigin == 0 {
+		return true
+	}
+	return !twinValuesEqual(p.Desired, p.Reported)
+}
+
+// twinValuesEqual compares the values numerically when both are numbers, so that e.g. 28.5 and 28.50 are equal
+func twinValuesEqual(a, b string) bool {
+	if a == b {
+		return true
+	}
+	x, errA := strconv.ParseFloat(a, 64)
+	y, errB := strconv.ParseFloat(b, 64)
+	return errA == nil && errB == nil && x == y
+}
+
+// DivergedProperties returns the names of the diverged properties of the twin, sorted
+func (t DeviceTwin) DivergedProperties() []string {
+	var names []string
+	for name, p := range t.Properties {
+		if p.IsDiverged() {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
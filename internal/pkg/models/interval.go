@@ -0,0 +1,31 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+)
+
+// Constants related to the misfire policies, which define how the scheduler handles the times an interval was due to
+// run but did not, e.g. while the scheduler was down or after the system clock jumped
+const (
+	// MisfirePolicySkip skips the missed runs, which is the default policy
+	MisfirePolicySkip = "SKIP"
+	// MisfirePolicyFireOnce runs the interval once for all the missed runs
+	MisfirePolicyFireOnce = "FIRE_ONCE"
+	// MisfirePolicyCatchUpAll runs the interval for each missed run
+	MisfirePolicyCatchUpAll = "CATCH_UP_ALL"
+)
+
+// Interval extends the Interval of go-mod-core-contracts with the schedules other than a fixed frequency.  An interval
+// is scheduled by exactly one of Interval, the frequency from Start, Cron, the cron expression, and RunOnce, the single
+// run at Start.  Start, End and Cron are in the IANA Timezone, the local timezone of the service if empty.
+// MisfirePolicy applies to the missed runs, MisfirePolicySkip if empty.
+type Interval struct {
+	models.Interval
+	Cron          string
+	RunOnce       bool
+	Timezone      string
+	MisfirePolicy string
+}
@@ -0,0 +1,143 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+// IntervalAction is the IntervalAction of go-mod-core-contracts whose Address may also be a MessageBusAddress or a
+// DeviceCommandAddress, and whose execution may be retried.  A failed action is retried RetryCount times, after
+// RetryBackoff before the first retry and doubling it before each following retry.  Timeout bounds each attempt of a
+// REST or device command action, which succeeds with one of the ExpectedStatusCodes, or any status code below 400 if
+// none is expected.
+type IntervalAction struct {
+	models.DBTimestamp
+	Id                  string
+	Name                string
+	IntervalName        string
+	Content             string
+	ContentType         string
+	Address             models.Address
+	AdminState          models.AdminState
+	AuthMethod          models.AuthMethod
+	RetryCount          int
+	RetryBackoff        string
+	Timeout             string
+	ExpectedStatusCodes []int
+}
+
+// MessageBusAddress publishes the Content of the interval action to the Topic of the EdgeX message bus, relative to
+// the base topic prefix of the service
+type MessageBusAddress struct {
+	models.BaseAddress
+	Topic string
+}
+
+func (a MessageBusAddress) GetBaseAddress() models.BaseAddress { return a.BaseAddress }
+
+// DeviceCommandAddress issues the command CommandName of the device DeviceName through core-command.  A GET command
+// pushes the read event to the EdgeX system if PushEvent is set, and a SET command writes the Content of the interval
+// action, which is a JSON object of the values by device resource name.
+type DeviceCommandAddress struct {
+	models.BaseAddress
+	DeviceName  string
+	CommandName string
+	Method      string
+	PushEvent   bool
+}
+
+func (a DeviceCommandAddress) GetBaseAddress() models.BaseAddress { return a.BaseAddress }
+
+func (intervalAction *IntervalAction) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		models.DBTimestamp
+		Id                  string
+		Name                string
+		IntervalName        string
+		Content             string
+		ContentType         string
+		Address             json.RawMessage
+		AdminState          models.AdminState
+		AuthMethod          models.AuthMethod
+		RetryCount          int
+		RetryBackoff        string
+		Timeout             string
+		ExpectedStatusCodes []int
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal intervalAction.", err)
+	}
+	address, err := unmarshalAddress(alias.Address)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	*intervalAction = IntervalAction{
+		DBTimestamp:         alias.DBTimestamp,
+		Id:                  alias.Id,
+		Name:                alias.Name,
+		IntervalName:        alias.IntervalName,
+		Content:             alias.Content,
+		ContentType:         alias.ContentType,
+		Address:             address,
+		AdminState:          alias.AdminState,
+		AuthMethod:          alias.AuthMethod,
+		RetryCount:          alias.RetryCount,
+		RetryBackoff:        alias.RetryBackoff,
+		Timeout:             alias.Timeout,
+		ExpectedStatusCodes: alias.ExpectedStatusCodes,
+	}
+	return nil
+}
+
+func unmarshalAddress(b []byte) (address models.Address, err error) {
+	var alias struct {
+		Type string
+	}
+	if err = json.Unmarshal(b, &alias); err != nil {
+		return address, errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal address.", err)
+	}
+	switch alias.Type {
+	case common.REST:
+		var rest models.RESTAddress
+		if err = json.Unmarshal(b, &rest); err != nil {
+			return address, errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal REST address.", err)
+		}
+		address = rest
+	case common.MQTT:
+		var mqtt models.MQTTPubAddress
+		if err = json.Unmarshal(b, &mqtt); err != nil {
+			return address, errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal MQTT address.", err)
+		}
+		address = mqtt
+	case common.EMAIL:
+		var mail models.EmailAddress
+		if err = json.Unmarshal(b, &mail); err != nil {
+			return address, errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal Email address.", err)
+		}
+		address = mail
+	case pkgCommon.MESSAGEBUS:
+		var messageBus MessageBusAddress
+		if err = json.Unmarshal(b, &messageBus); err != nil {
+			return address, errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal message bus address.", err)
+		}
+		address = messageBus
+	case pkgCommon.DEVICECOMMAND:
+		var command DeviceCommandAddress
+		if err = json.Unmarshal(b, &command); err != nil {
+			return address, errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal device command address.", err)
+		}
+		address = command
+	default:
+		return address, errors.NewCommonEdgeX(errors.KindContractInvalid, "Unsupported address type", err)
+	}
+	return address, nil
+}
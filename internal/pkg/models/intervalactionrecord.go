@@ -0,0 +1,38 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// Constants related to the status of an interval action execution
+const (
+	IntervalActionRecordSucceeded = "SUCCEEDED"
+	IntervalActionRecordFailed    = "FAILED"
+	// IntervalActionRecordMissed records the runs of the interval skipped by its misfire policy
+	IntervalActionRecordMissed = "MISSED"
+)
+
+// IntervalActionRecord records an execution of an interval action by the scheduler.  ScheduledTime is the Unix
+// timestamp in milliseconds the interval was due to run at, and Start and End the timestamps the execution started and
+// ended at.  StatusCode is the status code of the response to the action, when it has one, and Message the error the
+// action failed with or the description of the missed runs.  Attempts are the outcomes of each attempt to execute the
+// action, the status code and message of the execution being those of its last attempt.
+type IntervalActionRecord struct {
+	Id            string
+	ActionName    string
+	IntervalName  string
+	ScheduledTime int64
+	Start         int64
+	End           int64
+	Status        string
+	StatusCode    int
+	Message       string
+	Attempts      []IntervalActionAttempt
+}
+
+// IntervalActionAttempt records the outcome of an attempt to execute an interval action
+type IntervalActionAttempt struct {
+	Start      int64
+	End        int64
+	StatusCode int
+	Message    string
+}
@@ -0,0 +1,19 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// Constants related to the leadership of a scheduler instance
+const (
+	LeadershipLeader  = "LEADER"
+	LeadershipStandby = "STANDBY"
+)
+
+// Leadership is the state of a scheduler instance in the election of a leader among the instances sharing the
+// database.  LeaderId is the id of the leader instance, which is empty if no instance is known to be the leader.
+type Leadership struct {
+	Enabled    bool
+	State      string
+	InstanceId string
+	LeaderId   string
+}
@@ -0,0 +1,49 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// Constants related to the aggregation functions supported when downsampling readings
+const (
+	AggregateMin   = "min"
+	AggregateMax   = "max"
+	AggregateMean  = "mean"
+	AggregateSum   = "sum"
+	AggregateCount = "count"
+	AggregateFirst = "first"
+	AggregateLast  = "last"
+)
+
+// AggregateFunctions lists every supported aggregation function in the order they are reported
+var AggregateFunctions = []string{AggregateMin, AggregateMax, AggregateMean, AggregateSum, AggregateCount, AggregateFirst, AggregateLast}
+
+// ReadingAggregate summarizes the numeric readings of a device resource within a single time window.
+// Start is the inclusive window start and End the exclusive window end, both Unix timestamps in nanoseconds.
+// Values holds one entry per requested aggregation function.
+type ReadingAggregate struct {
+	DeviceName   string
+	ResourceName string
+	Start        int64
+	End          int64
+	Values       map[string]float64
+}
+
+// IsValidAggregateFunction checks whether the given name is one of the supported aggregation functions
+func IsValidAggregateFunction(name string) bool {
+	for _, f := range AggregateFunctions {
+		if f == name {
+			return true
+		}
+	}
+	return false
+}
+
+// AggregateWindowStart returns the start of the window containing the given timestamp.  Windows are aligned
+// to the Unix epoch so that every database implementation produces the same buckets.
+func AggregateWindowStart(timestamp int64, window int64) int64 {
+	start := timestamp - timestamp%window
+	if timestamp < 0 && timestamp%window != 0 {
+		start -= window
+	}
+	return start
+}
@@ -7,15 +7,22 @@ package utils
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"os"
 	"strconv"
 	"strings"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/labelselector"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/secret"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
@@ -73,6 +80,44 @@ func ParseGetAllObjectsRequestQueryString(c echo.Context, minOffset int, maxOffs
 	return offset, limit, labels, err
 }
 
+// ParseCursorQueryString parses the continuation token of a query paged by cursor.  The query is paged by cursor as soon
+// as the cursor query string is specified, an empty cursor requesting the first page.  As paging by cursor and paging by
+// offset are exclusive, an EdgeX error is returned when both a cursor and a non-zero offset are specified.
+func ParseCursorQueryString(c echo.Context, offset int) (cursor pkgModels.Cursor, pagedByCursor bool, err errors.EdgeX) {
+	if !c.QueryParams().Has(pkgCommon.Cursor) {
+		return cursor, false, nil
+	}
+	if offset != 0 {
+		return cursor, false, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("querystring %s is not allowed along with querystring %s", pkgCommon.Cursor, common.Offset), nil)
+	}
+	cursor, err = pkgModels.ParseCursorToken(c.QueryParam(pkgCommon.Cursor))
+	if err != nil {
+		return cursor, false, errors.NewCommonEdgeXWrapper(err)
+	}
+	return cursor, true, nil
+}
+
+// ParseLabelSelectorQueryString parses the label selector of a query selecting the objects by label selector.  The
+// objects are selected by label selector as soon as the label selector query string is specified.  As the label
+// selector supersedes the labels, and paging by cursor doesn't support filtering, an EdgeX error is returned when the
+// label selector is specified along with labels or a cursor.
+func ParseLabelSelectorQueryString(c echo.Context, labels []string) (selector labelselector.Selector, selected bool, err errors.EdgeX) {
+	if !c.QueryParams().Has(pkgCommon.LabelSelector) {
+		return selector, false, nil
+	}
+	if len(labels) > 0 {
+		return selector, false, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("querystring %s is not allowed along with querystring %s", pkgCommon.LabelSelector, common.Labels), nil)
+	}
+	if c.QueryParams().Has(pkgCommon.Cursor) {
+		return selector, false, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("querystring %s is not allowed along with querystring %s", pkgCommon.LabelSelector, pkgCommon.Cursor), nil)
+	}
+	selector, err = labelselector.Parse(c.QueryParam(pkgCommon.LabelSelector))
+	if err != nil {
+		return selector, false, errors.NewCommonEdgeXWrapper(err)
+	}
+	return selector, true, nil
+}
+
 // Parse the specified query string key to an integer.  If specified query string key is found more than once in the
 // http request, only the first specified query string will be parsed and converted to an integer.  If no specified
 // query string key could be found in the http request, specified default value will be returned.  EdgeX error will be
@@ -184,3 +229,75 @@ func ParseBodyToMap(r *http.Request) (map[string]interface{}, errors.EdgeX) {
 
 	return result, nil
 }
+
+// CallerIdentity returns the identity of the caller carried by the JWT of the request, which is the name claim of the
+// token or its subject otherwise.  The token is only decoded here, relying on the authentication hook of the route to
+// reject the requests with an invalid token, so no identity is returned when the hook doesn't validate the tokens,
+// i.e. when the security is disabled or the JWT validation is turned off, as the claims could then be forged.  No
+// identity is returned either when the request carries no JWT.
+func CallerIdentity(r *http.Request) string {
+	if !IsJWTValidated() {
+		return ""
+	}
+	token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !found {
+		return ""
+	}
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return ""
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return ""
+	}
+	var claims struct {
+		Name    string `json:"name"`
+		Subject string `json:"sub"`
+	}
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		return ""
+	}
+	if claims.Name != "" {
+		return claims.Name
+	}
+	return claims.Subject
+}
+
+// disableJWTValidationEnv is the environment variable turning off the JWT validation of the authentication hook
+const disableJWTValidationEnv = "EDGEX_DISABLE_JWT_VALIDATION"
+
+// IsJWTValidated checks whether the authentication hook, see handlers.AutoConfigAuthenticationFunc, validates the JWT
+// of the requests
+func IsJWTValidated() bool {
+	disableJWTValidation, _ := strconv.ParseBool(os.Getenv(disableJWTValidationEnv))
+	return secret.IsSecurityEnabled() && !disableJWTValidation
+}
+
+type callerIdentityKey struct{}
+
+// CallerIdentityMiddleware stores the identity of the caller of the request, see CallerIdentity, in the context of the
+// request so that it is available to the application layer through CallerIdentityFromContext
+func CallerIdentityMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		r := c.Request()
+		if identity := CallerIdentity(r); identity != "" {
+			c.SetRequest(r.WithContext(context.WithValue(r.Context(), callerIdentityKey{}, identity)))
+		}
+		return next(c)
+	}
+}
+
+// CallerIdentityAuthenticationFunc chains CallerIdentityMiddleware after the authentication hook so that the identity
+// of the caller is only stored in the context of the requests the hook has let through
+func CallerIdentityAuthenticationFunc(authenticationHook echo.MiddlewareFunc) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return authenticationHook(CallerIdentityMiddleware(next))
+	}
+}
+
+// CallerIdentityFromContext returns the identity of the caller stored in the context by CallerIdentityMiddleware, if any
+func CallerIdentityFromContext(ctx context.Context) string {
+	identity, _ := ctx.Value(callerIdentityKey{}).(string)
+	return identity
+}
@@ -6,11 +6,18 @@
 package utils
 
 import (
+	"encoding/base64"
 	"math"
 	"net/http"
+	"net/http/httptest"
 	"strconv"
 	"testing"
 
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/secret"
+
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 
@@ -72,3 +79,120 @@ func TestParseGetAllObjectsRequestQueryString(t *testing.T) {
 	}
 
 }
+
+func TestParseCursorQueryString(t *testing.T) {
+	cursor := pkgModels.Cursor{Score: 1616728256236000000, Id: "82eb2e26-0f24-48aa-ae4c-de9dac3fb9bc"}
+	tests := []struct {
+		name                  string
+		query                 map[string]string
+		offset                int
+		expectedCursor        pkgModels.Cursor
+		expectedPagedByCursor bool
+		expectedErrorKind     errors.ErrKind
+	}{
+		{"valid - no cursor", map[string]string{}, 5, pkgModels.Cursor{}, false, ""},
+		{"valid - empty cursor", map[string]string{pkgCommon.Cursor: ""}, 0, pkgModels.Cursor{}, true, ""},
+		{"valid - cursor", map[string]string{pkgCommon.Cursor: cursor.Token()}, 0, cursor, true, ""},
+		{"invalid - cursor along with offset", map[string]string{pkgCommon.Cursor: cursor.Token()}, 5, pkgModels.Cursor{}, false, errors.KindContractInvalid},
+		{"invalid - malformed cursor", map[string]string{pkgCommon.Cursor: "%%%"}, 0, pkgModels.Cursor{}, false, errors.KindContractInvalid},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, common.ApiAllEventRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			for k, v := range testCase.query {
+				query.Add(k, v)
+			}
+			req.URL.RawQuery = query.Encode()
+
+			c := e.NewContext(req, nil)
+			cursor, pagedByCursor, err := ParseCursorQueryString(c, testCase.offset)
+			if testCase.expectedErrorKind != "" {
+				assert.Equal(t, testCase.expectedErrorKind, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedCursor, cursor)
+			assert.Equal(t, testCase.expectedPagedByCursor, pagedByCursor)
+		})
+	}
+}
+
+func buildTestJWT(claims string) string {
+	encode := base64.RawURLEncoding.EncodeToString
+	return encode([]byte(`{"alg":"HS256"}`)) + "." + encode([]byte(claims)) + ".signature"
+}
+
+func TestCallerIdentity(t *testing.T) {
+	token := "Bearer " + buildTestJWT(`{"name":"operator","sub":"subject-id"}`)
+	tests := []struct {
+		name                 string
+		authorization        string
+		securityEnabled      string
+		disableJWTValidation string
+		expected             string
+	}{
+		{"name claim", token, "true", "", "operator"},
+		{"subject claim", "Bearer " + buildTestJWT(`{"sub":"subject-id"}`), "true", "", "subject-id"},
+		{"no authorization", "", "true", "", ""},
+		{"not a bearer token", "Basic dXNlcjpwYXNz", "true", "", ""},
+		{"malformed token", "Bearer not-a-jwt", "true", "", ""},
+		{"security disabled", token, "false", "", ""},
+		{"JWT validation disabled", token, "true", "true", ""},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Setenv(secret.EnvSecretStore, testCase.securityEnabled)
+			t.Setenv(disableJWTValidationEnv, testCase.disableJWTValidation)
+			req := httptest.NewRequest(http.MethodGet, common.ApiPingRoute, http.NoBody)
+			if testCase.authorization != "" {
+				req.Header.Set("Authorization", testCase.authorization)
+			}
+			assert.Equal(t, testCase.expected, CallerIdentity(req))
+		})
+	}
+}
+
+func TestCallerIdentityAuthenticationFunc(t *testing.T) {
+	t.Setenv(secret.EnvSecretStore, "true")
+	t.Setenv(disableJWTValidationEnv, "")
+	rejectHook := func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			return echo.NewHTTPError(http.StatusUnauthorized)
+		}
+	}
+	acceptHook := func(next echo.HandlerFunc) echo.HandlerFunc {
+		return next
+	}
+	tests := []struct {
+		name               string
+		authenticationHook echo.MiddlewareFunc
+		expectedCalled     bool
+		expectedIdentity   string
+	}{
+		{"authenticated", acceptHook, true, "operator"},
+		{"unauthenticated", rejectHook, false, ""},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, common.ApiPingRoute, http.NoBody)
+			req.Header.Set("Authorization", "Bearer "+buildTestJWT(`{"name":"operator"}`))
+			c := echo.New().NewContext(req, httptest.NewRecorder())
+
+			called := false
+			var identity string
+			handler := CallerIdentityAuthenticationFunc(testCase.authenticationHook)(func(c echo.Context) error {
+				called = true
+				identity = CallerIdentityFromContext(c.Request().Context())
+				return nil
+			})
+			err := handler(c)
+
+			assert.Equal(t, testCase.expectedCalled, called)
+			assert.Equal(t, testCase.expectedCalled, err == nil)
+			assert.Equal(t, testCase.expectedIdentity, identity)
+		})
+	}
+}
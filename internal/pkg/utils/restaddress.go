@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
@@ -28,27 +29,35 @@ var methods = map[string]struct{}{
 // SendRequestWithRESTAddress sends request with REST address
 func SendRequestWithRESTAddress(lc logger.LoggingClient, content string, contentType string,
 	address models.RESTAddress, jwtSecretProvider interfaces.AuthenticationInjector) (res string, err errors.EdgeX) {
+	res, _, err = SendRequestWithRESTAddressForStatusCode(lc, content, contentType, address, jwtSecretProvider, 0)
+	return res, err
+}
+
+// SendRequestWithRESTAddressForStatusCode sends request with REST address within the timeout, if not 0, and returns the
+// status code of the response along with its body, the status code being 0 when no response is received
+func SendRequestWithRESTAddressForStatusCode(lc logger.LoggingClient, content string, contentType string,
+	address models.RESTAddress, jwtSecretProvider interfaces.AuthenticationInjector, timeout time.Duration) (res string, statusCode int, err errors.EdgeX) {
 
 	executingUrl := getUrlStr(address)
 
 	req, err := getHttpRequest(address.HTTPMethod, executingUrl, content, contentType)
 	if err != nil {
-		return "", errors.NewCommonEdgeX(errors.KindServerError, "fail to create http request", err)
+		return "", 0, errors.NewCommonEdgeX(errors.KindServerError, "fail to create http request", err)
 	}
 
 	if jwtSecretProvider != nil {
 		if err2 := jwtSecretProvider.AddAuthenticationData(req); err2 != nil {
-			return "", errors.NewCommonEdgeXWrapper(err2)
+			return "", 0, errors.NewCommonEdgeXWrapper(err2)
 		}
 	}
 
-	client := &http.Client{}
-	res, err = sendRequestAndGetResponse(client, req)
+	client := &http.Client{Timeout: timeout}
+	res, statusCode, err = sendRequestAndGetResponse(client, req)
 	if err != nil {
-		return "", errors.NewCommonEdgeXWrapper(err)
+		return "", statusCode, errors.NewCommonEdgeXWrapper(err)
 	}
 	lc.Debugf("success to send rest request with address %v", address.BaseAddress)
-	return res, nil
+	return res, statusCode, nil
 }
 
 func getUrlStr(address models.RESTAddress) string {
@@ -94,11 +103,11 @@ func getHttpRequest(
 	return req, nil
 }
 
-func sendRequestAndGetResponse(client *http.Client, req *http.Request) (res string, edgeXerr errors.EdgeX) {
+func sendRequestAndGetResponse(client *http.Client, req *http.Request) (res string, statusCode int, edgeXerr errors.EdgeX) {
 	resp, err := client.Do(req)
 
 	if err != nil {
-		return "", errors.NewCommonEdgeX(errors.KindServerError, "fail to send the HTTP request", err)
+		return "", 0, errors.NewCommonEdgeX(errors.KindServerError, "fail to send the HTTP request", err)
 	}
 
 	defer resp.Body.Close()
@@ -106,10 +115,10 @@ func sendRequestAndGetResponse(client *http.Client, req *http.Request) (res stri
 
 	bodyBytes, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return "", errors.NewCommonEdgeX(errors.KindIOError, "fail to read the response body", err)
+		return "", resp.StatusCode, errors.NewCommonEdgeX(errors.KindIOError, "fail to read the response body", err)
 	}
 	if resp.StatusCode >= http.StatusBadRequest {
-		return "", errors.NewCommonEdgeX(errors.KindMapping(resp.StatusCode), fmt.Sprintf("request failed, status code: %d, err: %s", resp.StatusCode, string(bodyBytes)), nil)
+		return "", resp.StatusCode, errors.NewCommonEdgeX(errors.KindMapping(resp.StatusCode), fmt.Sprintf("request failed, status code: %d, err: %s", resp.StatusCode, string(bodyBytes)), nil)
 	}
-	return string(bodyBytes), nil
+	return string(bodyBytes), resp.StatusCode, nil
 }
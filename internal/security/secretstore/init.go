@@ -54,12 +54,14 @@ const (
 	addKnownSecretsEnv   = "EDGEX_ADD_KNOWN_SECRETS" // nolint:gosec
 	redisSecretName      = "redisdb"
 	messagebusSecretName = "message-bus"
+	influxSecretName     = "influxdb"
 	knownSecretSeparator = ","
 	serviceListBegin     = "["
 	serviceListEnd       = "]"
 	serviceListSeparator = ";"
 	secretBasePath       = "/v1/secret/edgex" // nolint:gosec
 	defaultMsgBusUser    = "msgbususer"
+	defaultInfluxUser    = "admin"
 )
 
 var errNotFound = errors.New("credential NOT found")
@@ -74,7 +76,7 @@ func NewBootstrap(insecureSkipVerify bool, vaultInterval int) *Bootstrap {
 	return &Bootstrap{
 		insecureSkipVerify: insecureSkipVerify,
 		vaultInterval:      vaultInterval,
-		validKnownSecrets:  map[string]bool{redisSecretName: true, messagebusSecretName: true},
+		validKnownSecrets:  map[string]bool{redisSecretName: true, messagebusSecretName: true, influxSecretName: true},
 	}
 }
 
@@ -447,6 +449,47 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, _ *sync.WaitGroup, _ s
 		return false
 	}
 
+	// InfluxDB credentials for the services using the hybrid database, such as core-data, which set up InfluxDB with
+	// them the first time they connect to it.  They are shared by all the services, which use the same InfluxDB.
+	services, ok = knownSecretsToAdd[influxSecretName]
+	if ok {
+		influxCredentials, err := getCredential("security-bootstrapper-influxdb", secretStore, influxSecretName)
+		if err != nil {
+			if err != errNotFound {
+				lc.Errorf("failed to determine if InfluxDB credentials already exist or not: %s", err.Error())
+				return false
+			}
+
+			lc.Info("Generating new password for InfluxDB")
+			influxPassword, err := secretStore.GeneratePassword(ctx)
+			if err != nil {
+				lc.Error("failed to generate password for InfluxDB")
+				return false
+			}
+
+			influxCredentials = UserPasswordPair{
+				User:     defaultInfluxUser,
+				Password: influxPassword,
+			}
+		} else {
+			lc.Info("InfluxDB credentials exist, skipping generating new password")
+		}
+
+		lc.Infof("adding any additional services using %s for knownSecrets...", influxSecretName)
+		for _, service := range services {
+			err = addServiceCredential(lc, influxSecretName, secretStore, service, influxCredentials)
+			if err != nil {
+				lc.Error(err.Error())
+				return false
+			}
+		}
+		err = storeCredential(lc, "security-bootstrapper-influxdb", secretStore, influxSecretName, influxCredentials)
+		if err != nil {
+			lc.Error(err.Error())
+			return false
+		}
+	}
+
 	// for secure message bus creds
 	var msgBusCredentials UserPasswordPair
 	if configuration.SecureMessageBus.Type != redisSecureMessageBusType &&
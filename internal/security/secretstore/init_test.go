@@ -89,6 +89,10 @@ func TestGetKnownSecretsToAdd(t *testing.T) {
 	expectedMultiServices := map[string][]string{
 		"redisdb": {"service-1", "service-2", "service-3"},
 	}
+	expectedInflux := map[string][]string{
+		"redisdb":  {"service-1"},
+		"influxdb": {"core-data"},
+	}
 
 	tests := []struct {
 		name                  string
@@ -100,6 +104,7 @@ func TestGetKnownSecretsToAdd(t *testing.T) {
 		{"valid one service", "redisdb[service-1]", expectedOneService, ""},
 		{"valid multi services", "redisdb[service-1; service-2; service-3]", expectedMultiServices, ""},
 		{"valid secret listed twice", "redisdb[service-1], redisdb[service-2; service-3]", expectedMultiServices, ""},
+		{"valid influxdb", "redisdb[service-1], influxdb[core-data]", expectedInflux, ""},
 		{"invalid no services", "redisdb[]", nil, "list for 'redisdb' is empty"},
 		{"invalid unknown secret", "messagebus[service-1; service-2; service-3]", nil, "'messagebus' is not a known secret"},
 		{"invalid known & unknown secret", "redisdb[service-1], messagebus[service-1; service-2; service-3]", nil, "'messagebus' is not a known secret"},
@@ -11,17 +11,15 @@ import (
 	"time"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/infrastructure/interfaces"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
-	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
-	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
-	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
-	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
 )
 
 // the suggested minimum duration for scheduler interval
@@ -29,7 +27,7 @@ const minSchedulerInterval = 10 * time.Millisecond
 
 // The AddInterval function accepts the new Interval model from the controller function
 // and then invokes AddInterval function of infrastructure layer to add new Interval
-func AddInterval(interval models.Interval, ctx context.Context, dic *di.Container) (id string, edgeXerr errors.EdgeX) {
+func AddInterval(interval pkgModels.Interval, ctx context.Context, dic *di.Container) (id string, edgeXerr errors.EdgeX) {
 	dbClient := container.DBClientFrom(dic.Get)
 	schedulerManager := container.SchedulerManagerFrom(dic.Get)
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
@@ -38,7 +36,7 @@ func AddInterval(interval models.Interval, ctx context.Context, dic *di.Containe
 	if err != nil {
 		return "", errors.NewCommonEdgeXWrapper(err)
 	}
-	err = schedulerManager.AddInterval(interval)
+	err = schedulerManager.AddInterval(addedInterval)
 	if err != nil {
 		return "", errors.NewCommonEdgeXWrapper(err)
 	}
@@ -47,13 +45,15 @@ func AddInterval(interval models.Interval, ctx context.Context, dic *di.Containe
 		correlation.FromContext(ctx))
 
 	// If interval is successfully created, check the interval value and display a warning if it's smaller than the suggested 10ms value
-	utils.CheckMinInterval(interval.Interval, minSchedulerInterval, lc)
+	if interval.Interval.Interval != "" {
+		utils.CheckMinInterval(interval.Interval.Interval, minSchedulerInterval, lc)
+	}
 
 	return addedInterval.Id, nil
 }
 
 // IntervalByName query the interval by name
-func IntervalByName(name string, ctx context.Context, dic *di.Container) (dto dtos.Interval, edgeXerr errors.EdgeX) {
+func IntervalByName(name string, ctx context.Context, dic *di.Container) (dto pkgDtos.Interval, edgeXerr errors.EdgeX) {
 	if name == "" {
 		return dto, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
 	}
@@ -62,12 +62,13 @@ func IntervalByName(name string, ctx context.Context, dic *di.Container) (dto dt
 	if err != nil {
 		return dto, errors.NewCommonEdgeXWrapper(err)
 	}
-	dto = dtos.FromIntervalModelToDTO(interval)
+	dto = pkgDtos.FromIntervalModelToDTO(interval)
+	setIntervalNextTime(&dto, dic)
 	return dto, nil
 }
 
 // AllIntervals query the intervals with offset and limit
-func AllIntervals(offset int, limit int, dic *di.Container) (intervalDTOs []dtos.Interval, totalCount uint32, err errors.EdgeX) {
+func AllIntervals(offset int, limit int, dic *di.Container) (intervalDTOs []pkgDtos.Interval, totalCount uint32, err errors.EdgeX) {
 	dbClient := container.DBClientFrom(dic.Get)
 	intervals, err := dbClient.AllIntervals(offset, limit)
 	if err == nil {
@@ -76,14 +77,25 @@ func AllIntervals(offset int, limit int, dic *di.Container) (intervalDTOs []dtos
 	if err != nil {
 		return intervalDTOs, totalCount, errors.NewCommonEdgeXWrapper(err)
 	}
-	intervalDTOs = make([]dtos.Interval, len(intervals))
+	intervalDTOs = make([]pkgDtos.Interval, len(intervals))
 	for i, interval := range intervals {
-		dto := dtos.FromIntervalModelToDTO(interval)
+		dto := pkgDtos.FromIntervalModelToDTO(interval)
+		setIntervalNextTime(&dto, dic)
 		intervalDTOs[i] = dto
 	}
 	return intervalDTOs, totalCount, nil
 }
 
+// setIntervalNextTime sets the time the interval will next run, if the interval is scheduled
+func setIntervalNextTime(dto *pkgDtos.Interval, dic *di.Container) {
+	schedulerManager := container.SchedulerManagerFrom(dic.Get)
+	nextTime, err := schedulerManager.IntervalNextTime(dto.Name)
+	if err != nil || nextTime.IsZero() {
+		return
+	}
+	dto.NextTime = nextTime.UnixMilli()
+}
+
 // DeleteIntervalByName delete the interval by name
 func DeleteIntervalByName(name string, ctx context.Context, dic *di.Container) errors.EdgeX {
 	if name == "" {
@@ -103,7 +115,7 @@ func DeleteIntervalByName(name string, ctx context.Context, dic *di.Container) e
 }
 
 // PatchInterval executes the PATCH operation with the DTO to replace the old data
-func PatchInterval(dto dtos.UpdateInterval, ctx context.Context, dic *di.Container) errors.EdgeX {
+func PatchInterval(dto pkgDtos.UpdateInterval, ctx context.Context, dic *di.Container) errors.EdgeX {
 	dbClient := container.DBClientFrom(dic.Get)
 	schedulerManager := container.SchedulerManagerFrom(dic.Get)
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
@@ -113,7 +125,11 @@ func PatchInterval(dto dtos.UpdateInterval, ctx context.Context, dic *di.Contain
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
-	requests.ReplaceIntervalModelFieldsWithDTO(&interval, dto)
+	pkgDtos.ReplaceIntervalModelFieldsWithDTO(&interval, dto)
+	err = pkgDtos.ValidateIntervalSchedule(interval)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 
 	err = dbClient.UpdateInterval(interval)
 	if err != nil {
@@ -125,7 +141,9 @@ func PatchInterval(dto dtos.UpdateInterval, ctx context.Context, dic *di.Contain
 	}
 
 	// If interval is successfully updated, check the interval value and display a warning if it's smaller than the suggested 10ms value
-	utils.CheckMinInterval(interval.Interval, minSchedulerInterval, lc)
+	if interval.Interval.Interval != "" {
+		utils.CheckMinInterval(interval.Interval.Interval, minSchedulerInterval, lc)
+	}
 
 	lc.Debugf(
 		"Interval patched on DB successfully. Correlation-ID: %s ",
@@ -134,7 +152,7 @@ func PatchInterval(dto dtos.UpdateInterval, ctx context.Context, dic *di.Contain
 	return nil
 }
 
-func intervalByDTO(dbClient interfaces.DBClient, dto dtos.UpdateInterval) (interval models.Interval, err errors.EdgeX) {
+func intervalByDTO(dbClient interfaces.DBClient, dto pkgDtos.UpdateInterval) (interval pkgModels.Interval, err errors.EdgeX) {
 	// The ID or Name is required by DTO and the DTO also accepts empty string ID if the Name is provided
 	if dto.Id != nil && *dto.Id != "" {
 		interval, err = dbClient.IntervalById(*dto.Id)
@@ -160,21 +178,26 @@ func LoadIntervalToSchedulerManager(dic *di.Container) errors.EdgeX {
 	// Load intervals from config to DB
 	configuration := container.ConfigurationFrom(dic.Get)
 	for i := range configuration.Intervals {
-		dto := dtos.Interval{
-			Name:     configuration.Intervals[i].Name,
-			Start:    configuration.Intervals[i].Start,
-			End:      configuration.Intervals[i].End,
-			Interval: configuration.Intervals[i].Interval,
+		dto := pkgDtos.Interval{
+			Name:          configuration.Intervals[i].Name,
+			Start:         configuration.Intervals[i].Start,
+			End:           configuration.Intervals[i].End,
+			Interval:      configuration.Intervals[i].Interval,
+			Cron:          configuration.Intervals[i].Cron,
+			RunOnce:       configuration.Intervals[i].RunOnce,
+			Timezone:      configuration.Intervals[i].Timezone,
+			MisfirePolicy: configuration.Intervals[i].MisfirePolicy,
 		}
-		validateErr := common.Validate(dto)
+		validateErr := dto.Validate()
 		if validateErr != nil {
 			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("validate pre-defined Interval %s from configuration failed", dto.Name), validateErr)
 		}
-		interval := dtos.ToIntervalModel(dto)
+		interval := pkgDtos.ToIntervalModel(dto)
 		_, err := dbClient.IntervalByName(interval.Name)
 		if errors.Kind(err) == errors.KindEntityDoesNotExist {
 			_, err = dbClient.AddInterval(interval)
-			if err != nil {
+			// Another scheduler instance sharing the database may have added it meanwhile
+			if err != nil && errors.Kind(err) != errors.KindDuplicateName {
 				return errors.NewCommonEdgeXWrapper(err)
 			}
 		} else if err != nil {
@@ -183,12 +206,12 @@ func LoadIntervalToSchedulerManager(dic *di.Container) errors.EdgeX {
 	}
 
 	// Load intervals from DB to scheduler
-	intervals, _, err := AllIntervals(0, -1, dic)
+	intervals, err := dbClient.AllIntervals(0, -1)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 	for _, interval := range intervals {
-		err = schedulerManager.AddInterval(dtos.ToIntervalModel(interval))
+		err = schedulerManager.AddInterval(interval)
 		if err != nil {
 			return errors.NewCommonEdgeXWrapper(err)
 		}
@@ -9,22 +9,24 @@ import (
 	"context"
 	"fmt"
 
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/infrastructure/interfaces"
-	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
-	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
 )
 
 // The AddIntervalAction function accepts the new IntervalAction model from the controller function
 // and then invokes AddIntervalAction function of infrastructure layer to add new IntervalAction
-func AddIntervalAction(action models.IntervalAction, ctx context.Context, dic *di.Container) (id string, edgeXerr errors.EdgeX) {
+func AddIntervalAction(action pkgModels.IntervalAction, ctx context.Context, dic *di.Container) (id string, edgeXerr errors.EdgeX) {
 	dbClient := container.DBClientFrom(dic.Get)
 	schedulerManager := container.SchedulerManagerFrom(dic.Get)
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
@@ -45,7 +47,7 @@ func AddIntervalAction(action models.IntervalAction, ctx context.Context, dic *d
 }
 
 // AllIntervalActions query the intervalActions with offset and limit
-func AllIntervalActions(offset int, limit int, dic *di.Container) (intervalActionDTOs []dtos.IntervalAction, totalCount uint32, err errors.EdgeX) {
+func AllIntervalActions(offset int, limit int, dic *di.Container) (intervalActionDTOs []pkgDtos.IntervalAction, totalCount uint32, err errors.EdgeX) {
 	dbClient := container.DBClientFrom(dic.Get)
 	intervalActions, err := dbClient.AllIntervalActions(offset, limit)
 	if err == nil {
@@ -54,16 +56,16 @@ func AllIntervalActions(offset int, limit int, dic *di.Container) (intervalActio
 	if err != nil {
 		return intervalActionDTOs, totalCount, errors.NewCommonEdgeXWrapper(err)
 	}
-	intervalActionDTOs = make([]dtos.IntervalAction, len(intervalActions))
+	intervalActionDTOs = make([]pkgDtos.IntervalAction, len(intervalActions))
 	for i, action := range intervalActions {
-		dto := dtos.FromIntervalActionModelToDTO(action)
+		dto := pkgDtos.FromIntervalActionModelToDTO(action)
 		intervalActionDTOs[i] = dto
 	}
 	return intervalActionDTOs, totalCount, nil
 }
 
 // IntervalActionByName query the intervalAction by name
-func IntervalActionByName(name string, ctx context.Context, dic *di.Container) (dto dtos.IntervalAction, edgeXerr errors.EdgeX) {
+func IntervalActionByName(name string, ctx context.Context, dic *di.Container) (dto pkgDtos.IntervalAction, edgeXerr errors.EdgeX) {
 	if name == "" {
 		return dto, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
 	}
@@ -72,7 +74,7 @@ func IntervalActionByName(name string, ctx context.Context, dic *di.Container) (
 	if edgeXerr != nil {
 		return dto, errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
-	dto = dtos.FromIntervalActionModelToDTO(action)
+	dto = pkgDtos.FromIntervalActionModelToDTO(action)
 	return dto, nil
 }
 
@@ -96,7 +98,7 @@ func DeleteIntervalActionByName(name string, ctx context.Context, dic *di.Contai
 }
 
 // PatchIntervalAction executes the PATCH operation with the DTO to replace the old data
-func PatchIntervalAction(dto dtos.UpdateIntervalAction, ctx context.Context, dic *di.Container) errors.EdgeX {
+func PatchIntervalAction(dto pkgDtos.UpdateIntervalAction, ctx context.Context, dic *di.Container) errors.EdgeX {
 	dbClient := container.DBClientFrom(dic.Get)
 	schedulerManager := container.SchedulerManagerFrom(dic.Get)
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
@@ -106,7 +108,11 @@ func PatchIntervalAction(dto dtos.UpdateIntervalAction, ctx context.Context, dic
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
-	requests.ReplaceIntervalActionModelFieldsWithDTO(&action, dto)
+	pkgDtos.ReplaceIntervalActionModelFieldsWithDTO(&action, dto)
+	err = pkgDtos.ValidateIntervalActionContent(action)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
 
 	err = dbClient.UpdateIntervalAction(action)
 	if err != nil {
@@ -123,7 +129,7 @@ func PatchIntervalAction(dto dtos.UpdateIntervalAction, ctx context.Context, dic
 	return nil
 }
 
-func intervalActionByDTO(dbClient interfaces.DBClient, dto dtos.UpdateIntervalAction) (action models.IntervalAction, err errors.EdgeX) {
+func intervalActionByDTO(dbClient interfaces.DBClient, dto pkgDtos.UpdateIntervalAction) (action pkgModels.IntervalAction, err errors.EdgeX) {
 	// The ID or Name is required by DTO and the DTO also accepts empty string ID if the Name is provided
 	if dto.Id != nil && *dto.Id != "" {
 		action, err = dbClient.IntervalActionById(*dto.Id)
@@ -149,24 +155,20 @@ func LoadIntervalActionToSchedulerManager(dic *di.Container) errors.EdgeX {
 	// Load intervalActions from config to DB
 	configuration := container.ConfigurationFrom(dic.Get)
 	for i := range configuration.IntervalActions {
-		dto := dtos.IntervalAction{
-			Name:         configuration.IntervalActions[i].Name,
-			IntervalName: configuration.IntervalActions[i].Interval,
-			Address: dtos.Address{
-				Type: common.REST,
-				Host: configuration.IntervalActions[i].Host,
-				Port: configuration.IntervalActions[i].Port,
-				RESTAddress: dtos.RESTAddress{
-					Path:       configuration.IntervalActions[i].Path,
-					HTTPMethod: configuration.IntervalActions[i].Method,
-				},
-			},
-			Content:     configuration.IntervalActions[i].Content,
-			ContentType: configuration.IntervalActions[i].ContentType,
-			AdminState:  configuration.IntervalActions[i].AdminState,
-			AuthMethod:  configuration.IntervalActions[i].AuthMethod,
+		dto := pkgDtos.IntervalAction{
+			Name:                configuration.IntervalActions[i].Name,
+			IntervalName:        configuration.IntervalActions[i].Interval,
+			Address:             intervalActionAddressFromConfig(configuration.IntervalActions[i]),
+			Content:             configuration.IntervalActions[i].Content,
+			ContentType:         configuration.IntervalActions[i].ContentType,
+			AdminState:          configuration.IntervalActions[i].AdminState,
+			AuthMethod:          configuration.IntervalActions[i].AuthMethod,
+			RetryCount:          configuration.IntervalActions[i].RetryCount,
+			RetryBackoff:        configuration.IntervalActions[i].RetryBackoff,
+			Timeout:             configuration.IntervalActions[i].Timeout,
+			ExpectedStatusCodes: configuration.IntervalActions[i].ExpectedStatusCodes,
 		}
-		validateErr := common.Validate(dto)
+		validateErr := dto.Validate()
 		if validateErr != nil {
 			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("validate pre-defined IntervalAction %s from configuration failed", dto.Name), validateErr)
 		}
@@ -174,11 +176,12 @@ func LoadIntervalActionToSchedulerManager(dic *di.Container) errors.EdgeX {
 		if err != nil {
 			return errors.NewCommonEdgeXWrapper(err)
 		}
-		action := dtos.ToIntervalActionModel(dto)
+		action := pkgDtos.ToIntervalActionModel(dto)
 		_, err = dbClient.IntervalActionByName(action.Name)
 		if errors.Kind(err) == errors.KindEntityDoesNotExist {
 			_, err = dbClient.AddIntervalAction(action)
-			if err != nil {
+			// Another scheduler instance sharing the database may have added it meanwhile
+			if err != nil && errors.Kind(err) != errors.KindDuplicateName {
 				return errors.NewCommonEdgeXWrapper(err)
 			}
 		} else if err != nil {
@@ -192,10 +195,36 @@ func LoadIntervalActionToSchedulerManager(dic *di.Container) errors.EdgeX {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 	for _, action := range actions {
-		err = schedulerManager.AddIntervalAction(dtos.ToIntervalActionModel(action))
+		err = schedulerManager.AddIntervalAction(pkgDtos.ToIntervalActionModel(action))
 		if err != nil {
 			return errors.NewCommonEdgeXWrapper(err)
 		}
 	}
 	return nil
 }
+
+// intervalActionAddressFromConfig returns the address of the pre-defined IntervalAction, which is a REST address unless
+// the configuration specifies another Type
+func intervalActionAddressFromConfig(info config.IntervalActionInfo) pkgDtos.Address {
+	address := pkgDtos.Address{Type: info.Type}
+	switch info.Type {
+	case pkgCommon.MESSAGEBUS:
+		address.Topic = info.Topic
+	case pkgCommon.DEVICECOMMAND:
+		address.DeviceCommandAddress = pkgDtos.DeviceCommandAddress{
+			DeviceName:  info.DeviceName,
+			CommandName: info.CommandName,
+			Method:      info.Method,
+			PushEvent:   info.PushEvent,
+		}
+	default:
+		address.Type = common.REST
+		address.Host = info.Host
+		address.Port = info.Port
+		address.RESTAddress = dtos.RESTAddress{
+			Path:       info.Path,
+			HTTPMethod: info.Method,
+		}
+	}
+	return address
+}
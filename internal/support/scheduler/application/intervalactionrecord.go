@@ -0,0 +1,32 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	pkgDtos "github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
+)
+
+// IntervalActionRecordsByTimeRange queries the execution records of an interval action, or of all interval actions
+// when actionName is empty, scheduled within the time range, by offset, and limit
+func IntervalActionRecordsByTimeRange(actionName string, start int, end int, offset int, limit int, dic *di.Container) (records []pkgDtos.IntervalActionRecord, totalCount uint32, err errors.EdgeX) {
+	if !container.ConfigurationFrom(dic.Get).History.Enabled {
+		return nil, 0, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "the history of the interval actions is disabled", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	actionRecords, totalCount, err := dbClient.IntervalActionRecordsByTimeRange(actionName, start, end, offset, limit)
+	if err != nil {
+		return nil, 0, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query the records of interval action '%s'", actionName), err)
+	}
+	records = make([]pkgDtos.IntervalActionRecord, len(actionRecords))
+	for i, r := range actionRecords {
+		records[i] = pkgDtos.FromIntervalActionRecordModelToDTO(r)
+	}
+	return records, totalCount, nil
+}
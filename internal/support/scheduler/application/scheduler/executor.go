@@ -11,7 +11,9 @@ import (
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
-	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/cron"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 )
 
 const (
@@ -19,20 +21,29 @@ const (
 )
 
 type Executor struct {
-	Interval           models.Interval
-	IntervalActionsMap map[string]models.IntervalAction
+	Interval           pkgModels.Interval
+	IntervalActionsMap map[string]pkgModels.IntervalAction
 	StartTime          time.Time
 	EndTime            time.Time
 	NextTime           time.Time
 	Frequency          time.Duration
-	MarkedDeleted      bool
+	// Schedule is the parsed cron expression of the interval, if any
+	Schedule      *cron.Schedule
+	MarkedDeleted bool
 }
 
 // Initialize initialize the Executor with interval. This function should be invoked after adding or updating the interval.
-func (executor *Executor) Initialize(interval models.Interval, lc logger.LoggingClient) errors.EdgeX {
+func (executor *Executor) Initialize(interval pkgModels.Interval, lc logger.LoggingClient) errors.EdgeX {
 	executor.Interval = interval
-	currentTime := time.Now()
-	loc := currentTime.Location()
+	loc := time.Local
+	if interval.Timezone != "" {
+		var err error
+		loc, err = time.LoadLocation(interval.Timezone)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("fail to load the timezone %s", interval.Timezone), err)
+		}
+	}
+	currentTime := time.Now().In(loc)
 
 	// start and end time
 	if executor.Interval.Start == "" {
@@ -56,30 +67,123 @@ func (executor *Executor) Initialize(interval models.Interval, lc logger.Logging
 		executor.EndTime = t
 	}
 
-	frequency, err := time.ParseDuration(executor.Interval.Interval)
-	if err != nil {
-		return errors.NewCommonEdgeX(errors.KindContractInvalid, "interval parse frequency error", err)
-	}
-	executor.Frequency = frequency
+	executor.Frequency = 0
+	executor.Schedule = nil
+	switch {
+	case executor.Interval.RunOnce:
+		// Run at the start time, unless it has already passed
+		executor.NextTime = executor.StartTime
+		if executor.NextTime.Before(currentTime.Truncate(time.Second)) {
+			lc.Debugf("the start time of the run-once interval %s has passed, it will not run", executor.Interval.Name)
+			executor.NextTime = time.Time{}
+		}
+	case executor.Interval.Cron != "":
+		schedule, err := cron.Parse(executor.Interval.Cron)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		executor.Schedule = &schedule
+		// The start time itself is the first time matching the cron expression, if it is in the future
+		from := currentTime
+		if executor.StartTime.After(from) {
+			from = executor.StartTime.Add(-time.Second)
+		}
+		executor.NextTime = schedule.Next(from)
+	default:
+		frequency, err := time.ParseDuration(executor.Interval.Interval.Interval)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "interval parse frequency error", err)
+		}
+		executor.Frequency = frequency
 
-	executor.NextTime = executor.StartTime
-	// Increase the NextTime by interval frequency when NextTime small than the CurrentTime
-	nowBenchmark := currentTime.Unix()
-	for executor.NextTime.Unix() <= nowBenchmark {
-		executor.NextTime = executor.NextTime.Add(executor.Frequency)
+		executor.NextTime = executor.StartTime
+		// Increase the NextTime by interval frequency when NextTime small than the CurrentTime
+		nowBenchmark := currentTime.Unix()
+		for executor.NextTime.Unix() <= nowBenchmark {
+			executor.NextTime = executor.NextTime.Add(executor.Frequency)
+		}
 	}
 	return nil
 }
 
-// IsComplete checks whether the Executor is complete
+// IsComplete checks whether the Executor is complete, i.e. whether the interval will not run again
 func (executor *Executor) IsComplete() bool {
+	if executor.NextTime.IsZero() {
+		return true
+	}
 	expired := executor.NextTime.Unix() > executor.EndTime.Unix()
 	return expired
 }
 
-// UpdateNextTime increase the NextTime by frequency if the Executor not complete
+// UpdateNextTime computes the NextTime from the schedule of the interval if the Executor not complete
 func (executor *Executor) UpdateNextTime() {
-	if !executor.IsComplete() {
-		executor.NextTime = executor.NextTime.Add(executor.Frequency)
+	if executor.IsComplete() {
+		return
+	}
+	executor.NextTime = executor.timeAfter(executor.NextTime)
+}
+
+// timeAfter returns the time the interval is due to run after t, which is the zero time for a run-once interval
+func (executor *Executor) timeAfter(t time.Time) time.Time {
+	switch {
+	case executor.Interval.RunOnce:
+		return time.Time{}
+	case executor.Schedule != nil:
+		return executor.Schedule.Next(t)
+	default:
+		return t.Add(executor.Frequency)
+	}
+}
+
+// Resume sets the NextTime to the first time the interval was due to run after its last run, so that the runs missed
+// since then are handled by the misfire policy.  The NextTime is not changed if it is earlier, or if the interval
+// never ran again.
+func (executor *Executor) Resume(lastRun time.Time) {
+	next := executor.timeAfter(lastRun.In(executor.StartTime.Location()))
+	if next.IsZero() || next.Before(executor.StartTime) || next.After(executor.EndTime) {
+		return
+	}
+	if executor.NextTime.IsZero() || next.Before(executor.NextTime) {
+		executor.NextTime = next
+	}
+}
+
+// MissedRuns describes the runs of an interval missed up to some time
+type MissedRuns struct {
+	// Count is the number of missed runs, from First to the last of Latest
+	Count int
+	First time.Time
+	// Latest are the times of the latest missed runs, in chronological order
+	Latest []time.Time
+}
+
+// SkipMissedRuns advances the NextTime past now, and returns the runs the interval missed from the NextTime up to now,
+// keeping the times of the latest runs up to maxLatest of them
+func (executor *Executor) SkipMissedRuns(now time.Time, maxLatest int) MissedRuns {
+	var missed MissedRuns
+	if executor.IsComplete() || executor.NextTime.After(now) {
+		return missed
+	}
+	missed.First = executor.NextTime
+	if executor.Schedule == nil && executor.Frequency > 0 {
+		// Skip directly to the latest runs of a frequency
+		last := now
+		if executor.EndTime.Before(last) {
+			last = executor.EndTime
+		}
+		count := int(last.Sub(executor.NextTime)/executor.Frequency) + 1
+		if skipped := count - maxLatest; skipped > 0 {
+			executor.NextTime = executor.NextTime.Add(time.Duration(skipped) * executor.Frequency)
+			missed.Count = skipped
+		}
+	}
+	for !executor.IsComplete() && !executor.NextTime.After(now) {
+		missed.Count++
+		missed.Latest = append(missed.Latest, executor.NextTime)
+		if len(missed.Latest) > maxLatest {
+			missed.Latest = missed.Latest[1:]
+		}
+		executor.UpdateNextTime()
 	}
+	return missed
 }
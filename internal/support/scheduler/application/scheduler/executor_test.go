@@ -13,6 +13,8 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
 
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -37,11 +39,11 @@ func TestInitialize(t *testing.T) {
 	for _, testCase := range tests {
 		t.Run(testCase.name, func(t *testing.T) {
 			current := time.Now()
-			interval := models.Interval{
+			interval := pkgModels.Interval{Interval: models.Interval{
 				Name:  testCase.intervalName,
 				Start: testCase.startTime, End: testCase.endTime,
 				Interval: testCase.interval,
-			}
+			}}
 			executor := Executor{}
 
 			err := executor.Initialize(interval, lc)
@@ -67,3 +69,160 @@ func TestInitialize(t *testing.T) {
 		})
 	}
 }
+
+func TestInitialize_Schedules(t *testing.T) {
+	lc := logger.NewMockClient()
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	tomorrow := time.Now().In(newYork).Add(24 * time.Hour).Truncate(time.Second)
+	yesterday := time.Now().In(newYork).Add(-24 * time.Hour)
+
+	tests := []struct {
+		name              string
+		interval          pkgModels.Interval
+		expectedNextTime  time.Time
+		expectedComplete  bool
+		expectedErrorKind errors.ErrKind
+	}{
+		{"run once",
+			pkgModels.Interval{Interval: models.Interval{Name: "once", Start: tomorrow.Format(SchedulerTimeFormat)}, RunOnce: true, Timezone: "America/New_York"},
+			tomorrow, false, ""},
+		{"run once in the past",
+			pkgModels.Interval{Interval: models.Interval{Name: "once", Start: yesterday.Format(SchedulerTimeFormat)}, RunOnce: true, Timezone: "America/New_York"},
+			time.Time{}, true, ""},
+		{"cron from the start time",
+			pkgModels.Interval{Interval: models.Interval{Name: "daily", Start: "22000101T060000"}, Cron: "0 6 * * *", Timezone: "America/New_York"},
+			time.Date(2200, 1, 1, 6, 0, 0, 0, newYork), false, ""},
+		{"cron after the end time",
+			pkgModels.Interval{Interval: models.Interval{Name: "yearly", Start: "22000101T060000", End: "22000601T000000"}, Cron: "0 0 1 7 *"},
+			time.Time{}, true, ""},
+		{"invalid cron",
+			pkgModels.Interval{Interval: models.Interval{Name: "daily"}, Cron: "0 6 * *"},
+			time.Time{}, false, errors.KindContractInvalid},
+		{"invalid timezone",
+			pkgModels.Interval{Interval: models.Interval{Name: "daily"}, Cron: "0 6 * * *", Timezone: "Mars/Olympus_Mons"},
+			time.Time{}, false, errors.KindContractInvalid},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			executor := Executor{}
+
+			err := executor.Initialize(testCase.interval, lc)
+			if testCase.expectedErrorKind != "" {
+				require.Equal(t, testCase.expectedErrorKind, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.expectedComplete, executor.IsComplete())
+			if !testCase.expectedNextTime.IsZero() {
+				assert.True(t, testCase.expectedNextTime.Equal(executor.NextTime), "expected %v, got %v", testCase.expectedNextTime, executor.NextTime)
+			}
+		})
+	}
+}
+
+func TestUpdateNextTime(t *testing.T) {
+	lc := logger.NewMockClient()
+
+	cronExecutor := Executor{}
+	err := cronExecutor.Initialize(pkgModels.Interval{Interval: models.Interval{Name: "daily", Start: "22000101T000000"}, Cron: "0 6 * * *"}, lc)
+	require.NoError(t, err)
+	cronExecutor.UpdateNextTime()
+	assert.Equal(t, time.Date(2200, 1, 2, 6, 0, 0, 0, time.Local), cronExecutor.NextTime)
+
+	runOnceExecutor := Executor{}
+	err = runOnceExecutor.Initialize(pkgModels.Interval{Interval: models.Interval{Name: "once", Start: "22000101T000000"}, RunOnce: true}, lc)
+	require.NoError(t, err)
+	require.False(t, runOnceExecutor.IsComplete())
+	runOnceExecutor.UpdateNextTime()
+	assert.True(t, runOnceExecutor.IsComplete())
+}
+
+func TestSkipMissedRuns(t *testing.T) {
+	lc := logger.NewMockClient()
+	now := time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC)
+	hours := func(hours ...int) []time.Time {
+		times := make([]time.Time, len(hours))
+		for i, h := range hours {
+			times[i] = time.Date(2024, 1, 1, h, 0, 0, 0, time.UTC)
+		}
+		return times
+	}
+
+	tests := []struct {
+		name           string
+		interval       pkgModels.Interval
+		expectedCount  int
+		expectedLatest []time.Time
+		expectedNext   time.Time
+	}{
+		{"frequency", pkgModels.Interval{Interval: models.Interval{Name: "hourly", Start: "20240101T000000", Interval: "1h"}, Timezone: "UTC"},
+			11, hours(8, 9, 10), hours(11)[0]},
+		{"cron", pkgModels.Interval{Interval: models.Interval{Name: "hourly", Start: "20240101T000000"}, Cron: "0 * * * *", Timezone: "UTC"},
+			11, hours(8, 9, 10), hours(11)[0]},
+		{"until the end", pkgModels.Interval{Interval: models.Interval{Name: "hourly", Start: "20240101T000000", End: "20240101T050000", Interval: "1h"}, Timezone: "UTC"},
+			6, hours(3, 4, 5), hours(6)[0]},
+		{"run once", pkgModels.Interval{Interval: models.Interval{Name: "once", Start: "22000101T000000"}, RunOnce: true, Timezone: "UTC"},
+			1, hours(0), time.Time{}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			executor := Executor{}
+			err := executor.Initialize(testCase.interval, lc)
+			require.NoError(t, err)
+			executor.NextTime = hours(0)[0]
+
+			missed := executor.SkipMissedRuns(now, 3)
+			assert.Equal(t, testCase.expectedCount, missed.Count)
+			assert.Equal(t, hours(0)[0], missed.First)
+			assert.Equal(t, testCase.expectedLatest, missed.Latest)
+			assert.True(t, testCase.expectedNext.Equal(executor.NextTime), "expected %v, got %v", testCase.expectedNext, executor.NextTime)
+		})
+	}
+
+	executor := Executor{}
+	err := executor.Initialize(pkgModels.Interval{Interval: models.Interval{Name: "future", Interval: "1h"}}, lc)
+	require.NoError(t, err)
+	next := executor.NextTime
+	missed := executor.SkipMissedRuns(time.Now(), 3)
+	assert.Zero(t, missed.Count)
+	assert.Equal(t, next, executor.NextTime)
+}
+
+func TestResume(t *testing.T) {
+	lc := logger.NewMockClient()
+	lastRun := time.Date(2024, 1, 1, 3, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		interval     pkgModels.Interval
+		lastRun      time.Time
+		expectedNext time.Time
+		resumed      bool
+	}{
+		{"frequency", pkgModels.Interval{Interval: models.Interval{Name: "hourly", Start: "20240101T000000", Interval: "1h"}, Timezone: "UTC"},
+			lastRun, time.Date(2024, 1, 1, 4, 0, 0, 0, time.UTC), true},
+		{"cron", pkgModels.Interval{Interval: models.Interval{Name: "daily", Start: "20240101T000000"}, Cron: "0 6 * * *", Timezone: "UTC"},
+			lastRun, time.Date(2024, 1, 1, 6, 0, 0, 0, time.UTC), true},
+		{"after the end", pkgModels.Interval{Interval: models.Interval{Name: "hourly", Start: "20240101T000000", End: "20240101T033000", Interval: "1h"}, Timezone: "UTC"},
+			lastRun, time.Time{}, false},
+		{"last run in the future", pkgModels.Interval{Interval: models.Interval{Name: "hourly", Start: "20240101T000000", Interval: "1h"}, Timezone: "UTC"},
+			time.Now().Add(24 * time.Hour), time.Time{}, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			executor := Executor{}
+			err := executor.Initialize(testCase.interval, lc)
+			require.NoError(t, err)
+			next := executor.NextTime
+
+			executor.Resume(testCase.lastRun)
+			if !testCase.resumed {
+				assert.Equal(t, next, executor.NextTime)
+				return
+			}
+			assert.True(t, testCase.expectedNext.Equal(executor.NextTime), "expected %v, got %v", testCase.expectedNext, executor.NextTime)
+		})
+	}
+}
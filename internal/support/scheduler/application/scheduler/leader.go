@@ -0,0 +1,211 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"os"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+
+	"github.com/google/uuid"
+
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/infrastructure/interfaces"
+)
+
+// leaseName is the name of the lease held by the leader of the scheduler instances in the database
+const leaseName = "support-scheduler"
+
+const (
+	defaultLeaseDuration = 15 * time.Second
+	defaultRenewInterval = 5 * time.Second
+)
+
+// leaderElector elects a leader among the scheduler instances sharing the database.  The leader holds a lease in the
+// database which it renews every renew interval, and the standby instances try to acquire the lease as often, so that
+// one of them takes over within the lease duration and renew interval of the leader failing.
+type leaderElector struct {
+	lc            logger.LoggingClient
+	dbClient      interfaces.DBClient
+	instanceId    string
+	leaseDuration time.Duration
+	renewInterval time.Duration
+	// onAcquired is called after each acquisition or renewal of the lease, elected being true when the instance just
+	// became the leader
+	onAcquired func(elected bool)
+	// onLost is called when the leader loses the leadership to another instance or as its lease expired
+	onLost func()
+
+	mutex    sync.RWMutex
+	leader   bool
+	leaderId string
+	// renewed is the time the leader last requested the renewal of its lease, which is no later than the database
+	// renewed it, so that the leader never outlives its lease
+	renewed time.Time
+	stop    chan struct{}
+	done    chan struct{}
+}
+
+func newLeaderElector(lc logger.LoggingClient, info config.LeaderElectionInfo, dbClient interfaces.DBClient, onAcquired func(elected bool), onLost func()) *leaderElector {
+	leaseDuration := parseLeaderElectionDuration(lc, "lease duration", info.LeaseDuration, defaultLeaseDuration)
+	renewInterval := parseLeaderElectionDuration(lc, "renew interval", info.RenewInterval, defaultRenewInterval)
+	if renewInterval >= leaseDuration {
+		lc.Warnf("the leader election renew interval %s is not shorter than the lease duration %s, using %s",
+			renewInterval.String(), leaseDuration.String(), (leaseDuration / 3).String())
+		renewInterval = leaseDuration / 3
+	}
+
+	instanceId := info.InstanceId
+	if instanceId == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			hostname = "support-scheduler"
+		}
+		// The random suffix tells apart the instances running on the same host
+		instanceId = hostname + "-" + uuid.NewString()[:8]
+	}
+
+	return &leaderElector{
+		lc:            lc,
+		dbClient:      dbClient,
+		instanceId:    instanceId,
+		leaseDuration: leaseDuration,
+		renewInterval: renewInterval,
+		onAcquired:    onAcquired,
+		onLost:        onLost,
+		stop:          make(chan struct{}),
+		done:          make(chan struct{}),
+	}
+}
+
+func parseLeaderElectionDuration(lc logger.LoggingClient, name string, value string, defaultValue time.Duration) time.Duration {
+	if value == "" {
+		return defaultValue
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		lc.Warnf("invalid leader election %s '%s', using the default %s", name, value, defaultValue.String())
+		return defaultValue
+	}
+	return d
+}
+
+// start runs the election until the elector is stopped
+func (e *leaderElector) start() {
+	e.lc.Infof("starting the leader election as instance %s", e.instanceId)
+	go func() {
+		defer close(e.done)
+		ticker := time.NewTicker(e.renewInterval)
+		defer ticker.Stop()
+		for {
+			e.elect()
+			select {
+			case <-ticker.C:
+			case <-e.stop:
+				return
+			}
+		}
+	}()
+}
+
+// stopElection stops the election, and releases the lease if the instance is the leader so that a standby instance
+// takes over without waiting for the lease to expire
+func (e *leaderElector) stopElection() {
+	close(e.stop)
+	<-e.done
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if !e.leader {
+		return
+	}
+	e.leader = false
+	e.leaderId = ""
+	if err := e.dbClient.ReleaseLease(leaseName, e.instanceId); err != nil {
+		e.lc.Errorf("fail to release the scheduler leadership, err: %v", err)
+		return
+	}
+	e.lc.Infof("released the scheduler leadership of instance %s", e.instanceId)
+}
+
+// elect acquires the lease, or renews it if the instance is the leader
+func (e *leaderElector) elect() {
+	requested := time.Now()
+	holder, err := e.dbClient.AcquireLease(leaseName, e.instanceId, e.leaseDuration)
+
+	e.mutex.Lock()
+	if err != nil {
+		e.lc.Errorf("fail to acquire the scheduler leadership, err: %v", err)
+		// No other instance acquires the lease before it expires, so the leader keeps the leadership until then
+		lost := e.leader && time.Since(e.renewed) >= e.leaseDuration
+		if lost {
+			e.lc.Warnf("instance %s lost the scheduler leadership, its lease expired", e.instanceId)
+			e.leader = false
+			e.leaderId = ""
+		}
+		e.mutex.Unlock()
+		if lost {
+			e.lost()
+		}
+		return
+	}
+
+	e.leaderId = holder
+	if holder != e.instanceId {
+		lost := e.leader
+		if lost {
+			e.lc.Warnf("instance %s lost the scheduler leadership to instance %s", e.instanceId, holder)
+			e.leader = false
+		}
+		e.mutex.Unlock()
+		if lost {
+			e.lost()
+		}
+		return
+	}
+	e.renewed = requested
+	elected := !e.leader
+	if elected {
+		e.lc.Infof("instance %s is elected as the scheduler leader", e.instanceId)
+		e.leader = true
+	}
+	e.mutex.Unlock()
+
+	if e.onAcquired != nil {
+		e.onAcquired(elected)
+	}
+}
+
+func (e *leaderElector) lost() {
+	if e.onLost != nil {
+		e.onLost()
+	}
+}
+
+// isLeader checks whether the instance is the leader and its lease has not expired
+func (e *leaderElector) isLeader() bool {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+	return e.leader && time.Since(e.renewed) < e.leaseDuration
+}
+
+// leadership returns the state of the instance in the election
+func (e *leaderElector) leadership() pkgModels.Leadership {
+	state := pkgModels.LeadershipStandby
+	if e.isLeader() {
+		state = pkgModels.LeadershipLeader
+	}
+
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+	return pkgModels.Leadership{
+		Enabled:    true,
+		State:      state,
+		InstanceId: e.instanceId,
+		LeaderId:   e.leaderId,
+	}
+}
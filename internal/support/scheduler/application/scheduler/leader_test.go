@@ -0,0 +1,118 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
+	dbMocks "github.com/edgexfoundry/edgex-go/internal/support/scheduler/infrastructure/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLeaderElector(t *testing.T) {
+	lc := logger.NewMockClient()
+
+	tests := []struct {
+		name                  string
+		info                  config.LeaderElectionInfo
+		expectedLeaseDuration time.Duration
+		expectedRenewInterval time.Duration
+	}{
+		{"defaults", config.LeaderElectionInfo{}, defaultLeaseDuration, defaultRenewInterval},
+		{"configured", config.LeaderElectionInfo{InstanceId: "scheduler-1", LeaseDuration: "30s", RenewInterval: "10s"}, 30 * time.Second, 10 * time.Second},
+		{"invalid durations", config.LeaderElectionInfo{LeaseDuration: "30", RenewInterval: "-1s"}, defaultLeaseDuration, defaultRenewInterval},
+		{"renew interval not shorter than lease", config.LeaderElectionInfo{LeaseDuration: "6s", RenewInterval: "6s"}, 6 * time.Second, 2 * time.Second},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := newLeaderElector(lc, testCase.info, &dbMocks.DBClient{}, nil, nil)
+			assert.Equal(t, testCase.expectedLeaseDuration, e.leaseDuration)
+			assert.Equal(t, testCase.expectedRenewInterval, e.renewInterval)
+			if testCase.info.InstanceId != "" {
+				assert.Equal(t, testCase.info.InstanceId, e.instanceId)
+			} else {
+				assert.NotEmpty(t, e.instanceId)
+			}
+		})
+	}
+}
+
+func TestLeaderElector_Elect(t *testing.T) {
+	lc := logger.NewMockClient()
+	info := config.LeaderElectionInfo{InstanceId: "scheduler-1", LeaseDuration: "15s", RenewInterval: "5s"}
+	dbError := errors.NewCommonEdgeX(errors.KindDatabaseError, "connection refused", nil)
+
+	tests := []struct {
+		name            string
+		wasLeader       bool
+		renewedAgo      time.Duration
+		holder          string
+		err             errors.EdgeX
+		expectedState   string
+		expectedLeader  string
+		expectedElected []bool
+		expectedLost    bool
+	}{
+		{"elected", false, 0, "scheduler-1", nil, pkgModels.LeadershipLeader, "scheduler-1", []bool{true}, false},
+		{"renewed", true, time.Second, "scheduler-1", nil, pkgModels.LeadershipLeader, "scheduler-1", []bool{false}, false},
+		{"standby", false, 0, "scheduler-2", nil, pkgModels.LeadershipStandby, "scheduler-2", nil, false},
+		{"lost to another instance", true, time.Second, "scheduler-2", nil, pkgModels.LeadershipStandby, "scheduler-2", nil, true},
+		{"leader until the lease expires", true, 10 * time.Second, "", dbError, pkgModels.LeadershipLeader, "scheduler-1", nil, false},
+		{"lease expired", true, 15 * time.Second, "", dbError, pkgModels.LeadershipStandby, "", nil, true},
+		{"standby on error", false, 0, "", dbError, pkgModels.LeadershipStandby, "", nil, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock := &dbMocks.DBClient{}
+			dbClientMock.On("AcquireLease", leaseName, "scheduler-1", 15*time.Second).Return(testCase.holder, testCase.err)
+			var elected []bool
+			lost := false
+			e := newLeaderElector(lc, info, dbClientMock, func(e bool) {
+				elected = append(elected, e)
+			}, func() {
+				lost = true
+			})
+			if testCase.wasLeader {
+				e.leader = true
+				e.leaderId = "scheduler-1"
+				e.renewed = time.Now().Add(-testCase.renewedAgo)
+			}
+
+			e.elect()
+
+			leadership := e.leadership()
+			assert.True(t, leadership.Enabled)
+			assert.Equal(t, testCase.expectedState, leadership.State)
+			assert.Equal(t, "scheduler-1", leadership.InstanceId)
+			assert.Equal(t, testCase.expectedLeader, leadership.LeaderId)
+			assert.Equal(t, testCase.expectedElected, elected)
+			assert.Equal(t, testCase.expectedLost, lost)
+		})
+	}
+}
+
+func TestLeaderElector_StopElection(t *testing.T) {
+	lc := logger.NewMockClient()
+	info := config.LeaderElectionInfo{InstanceId: "scheduler-1", LeaseDuration: "15s", RenewInterval: "5s"}
+	dbClientMock := &dbMocks.DBClient{}
+	dbClientMock.On("AcquireLease", leaseName, "scheduler-1", mock.Anything).Return("scheduler-1", nil)
+	dbClientMock.On("ReleaseLease", leaseName, "scheduler-1").Return(nil)
+	e := newLeaderElector(lc, info, dbClientMock, nil, nil)
+
+	e.start()
+	require.Eventually(t, e.isLeader, time.Second, 10*time.Millisecond)
+	e.stopElection()
+
+	assert.False(t, e.isLeader())
+	dbClientMock.AssertCalled(t, "ReleaseLease", leaseName, "scheduler-1")
+}
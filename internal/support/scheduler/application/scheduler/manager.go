@@ -6,9 +6,17 @@
 package scheduler
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/infrastructure/interfaces"
@@ -23,6 +31,9 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
 
+	"github.com/edgexfoundry/go-mod-messaging/v3/messaging"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
+
 	"gopkg.in/eapache/queue.v1"
 )
 
@@ -36,11 +47,52 @@ type manager struct {
 	intervalToExecutorMap map[string]*Executor
 	actionToIntervalMap   map[string]string
 	secretProvider        bootstrapInterfaces.SecretProviderExt
+	dbClient              interfaces.DBClient
+	messagingClient       messaging.MessageClient
+	commandClient         clientInterfaces.CommandClient
+	misfireThreshold      time.Duration
+	maxCatchUp            int
+	lastTick              time.Time
+	// elector is nil unless the leader election is enabled, only the leader triggering the intervals
+	elector *leaderElector
+	// reloadPending requests the reload of the intervals and interval actions from the database before triggering them
+	reloadPending atomic.Bool
+	// definitionsRevision is the revision of the intervals and interval actions in the database at the last reload
+	definitionsRevision string
+	// ctx is cancelled by StopTicker, and retryCtx, derived from ctx, is also replaced when the leadership is lost to
+	// stop the pending retries of the failed interval actions
+	ctx           context.Context
+	stop          context.CancelFunc
+	retryCtx      context.Context
+	cancelRetries context.CancelFunc
 }
 
-// NewManager creates a new scheduler manager for running the interval job
-func NewManager(lc logger.LoggingClient, config *config.ConfigurationStruct, secretProvider bootstrapInterfaces.SecretProviderExt) interfaces.SchedulerManager {
-	return &manager{
+const (
+	defaultMisfireThreshold = time.Minute
+	defaultMaxCatchUp       = 100
+	defaultRetryBackoff     = time.Second
+)
+
+// NewManager creates a new scheduler manager for running the interval job.  The database client, which may be nil,
+// keeps the history of the interval action executions, and holds the leadership when the leader election is enabled.  The messaging and command clients, which may be nil, run the
+// message bus and device command interval actions.
+func NewManager(lc logger.LoggingClient, config *config.ConfigurationStruct, secretProvider bootstrapInterfaces.SecretProviderExt,
+	dbClient interfaces.DBClient, messagingClient messaging.MessageClient, commandClient clientInterfaces.CommandClient) interfaces.SchedulerManager {
+	misfireThreshold := defaultMisfireThreshold
+	if config.Misfire.Threshold != "" {
+		threshold, err := time.ParseDuration(config.Misfire.Threshold)
+		if err != nil || threshold <= 0 {
+			lc.Warnf("invalid misfire threshold '%s', using the default %s", config.Misfire.Threshold, defaultMisfireThreshold)
+		} else {
+			misfireThreshold = threshold
+		}
+	}
+	maxCatchUp := config.Misfire.MaxCatchUp
+	if maxCatchUp <= 0 {
+		maxCatchUp = defaultMaxCatchUp
+	}
+
+	m := &manager{
 		ticker:                time.NewTicker(time.Duration(config.ScheduleIntervalTime) * time.Millisecond),
 		lc:                    lc,
 		config:                config,
@@ -48,12 +100,30 @@ func NewManager(lc logger.LoggingClient, config *config.ConfigurationStruct, sec
 		intervalToExecutorMap: make(map[string]*Executor),
 		actionToIntervalMap:   make(map[string]string),
 		secretProvider:        secretProvider,
+		dbClient:              dbClient,
+		messagingClient:       messagingClient,
+		commandClient:         commandClient,
+		misfireThreshold:      misfireThreshold,
+		maxCatchUp:            maxCatchUp,
+	}
+	m.ctx, m.stop = context.WithCancel(context.Background())
+	m.retryCtx, m.cancelRetries = context.WithCancel(m.ctx)
+	if config.LeaderElection.Enabled {
+		if dbClient == nil {
+			lc.Warn("the leader election requires the database, the instance runs the intervals alone")
+		} else {
+			m.elector = newLeaderElector(lc, config.LeaderElection, dbClient, m.syncDefinitions, m.stopRetries)
+		}
 	}
+	return m
 }
 
 // StartTicker starts infinite loop with ticker to trigger the interval job
 func (m *manager) StartTicker() {
 	m.once.Do(func() {
+		if m.elector != nil {
+			m.elector.start()
+		}
 		go func() {
 			for range m.ticker.C {
 				m.triggerInterval()
@@ -62,18 +132,53 @@ func (m *manager) StartTicker() {
 	})
 }
 
-// StopTicker stops to trigger the interval job by stopping the ticker
+// StopTicker stops to trigger the interval job by stopping the ticker and the pending retries, and releases the
+// leadership
 func (m *manager) StopTicker() {
 	m.ticker.Stop()
+	m.stop()
+	if m.elector != nil {
+		m.elector.stopElection()
+	}
+}
+
+// Leadership returns the state of the instance in the leader election
+func (m *manager) Leadership() pkgModels.Leadership {
+	if m.elector == nil {
+		return pkgModels.Leadership{}
+	}
+	return m.elector.leadership()
+}
+
+// isLeader checks whether the instance runs the intervals, i.e. it is the leader or the leader election is disabled
+func (m *manager) isLeader() bool {
+	return m.elector == nil || m.elector.isLeader()
+}
+
+// stopRetries stops the pending retries of the failed interval actions when the leadership is lost, as the new leader
+// runs the intervals
+func (m *manager) stopRetries() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.cancelRetries()
+	m.retryCtx, m.cancelRetries = context.WithCancel(m.ctx)
 }
 
 func (m *manager) triggerInterval() {
-	if m.executorQueue.Length() == 0 {
+	now := time.Now()
+	m.rescheduleAfterClockJump(now)
+	// The standby instances don't run the intervals, which the leader does
+	if !m.isLeader() {
 		return
 	}
+	if m.elector != nil && m.reloadPending.Swap(false) {
+		m.reload()
+	}
 
 	var wg sync.WaitGroup
-	nowEpoch := time.Now().Unix()
+	nowEpoch := now.Unix()
+	// The executing goroutines requeue their executors once the queue is unlocked
+	m.mutex.Lock()
 	for i := 0; i < m.executorQueue.Length(); i++ {
 		if m.executorQueue.Peek() != nil {
 			executor, ok := m.executorQueue.Remove().(*Executor)
@@ -84,6 +189,9 @@ func (m *manager) triggerInterval() {
 			if executor.MarkedDeleted {
 				m.lc.Debugf("the interval %s be marked as deleted, removing it.", executor.Interval.Name)
 				continue // really delete from the queue
+			} else if executor.IsComplete() {
+				m.lc.Debugf("the interval %s is complete, removing it.", executor.Interval.Name)
+				continue
 			} else {
 				if executor.NextTime.Unix() <= nowEpoch {
 					m.lc.Debugf(
@@ -92,37 +200,227 @@ func (m *manager) triggerInterval() {
 					wg.Add(1)
 
 					// execute it in a individual go routine
-					go m.execute(executor, &wg)
+					if now.Sub(executor.NextTime) > m.misfireThreshold {
+						go m.executeMissedRuns(executor, now, &wg)
+					} else {
+						go m.execute(executor, executor.NextTime, &wg)
+					}
 				} else {
 					m.executorQueue.Add(executor)
 				}
 			}
 		}
 	}
+	m.mutex.Unlock()
 
 	wg.Wait()
 }
 
+// rescheduleAfterClockJump reschedules the intervals when the system clock jumped backward since the last tick, as they
+// would not run until the clock caught up with their next times.  The runs jumped over by the clock jumping forward are
+// late, and are handled by the misfire policies.
+func (m *manager) rescheduleAfterClockJump(now time.Time) {
+	last := m.lastTick
+	m.lastTick = now
+	if last.IsZero() {
+		return
+	}
+	// The difference between the elapsed wall clock and monotonic clock times is the jump of the system clock
+	jump := now.Round(0).Sub(last.Round(0)) - now.Sub(last)
+	if jump >= -m.misfireThreshold {
+		return
+	}
+
+	m.lc.Warnf("the system clock jumped backward by %s, rescheduling the intervals", -jump)
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	for _, executor := range m.intervalToExecutorMap {
+		// The completed executors are not queued anymore
+		if executor.IsComplete() {
+			continue
+		}
+		if err := executor.Initialize(executor.Interval, m.lc); err != nil {
+			m.lc.Errorf("fail to reschedule the interval %s, err: %v", executor.Interval.Name, err)
+		}
+	}
+}
+
+// execute executes the run of the interval scheduled at the NextTime read from the queue.  The NextTime is only changed
+// under the mutex, as it is read by the API while the interval runs.
 func (m *manager) execute(
 	executor *Executor,
+	scheduled time.Time,
 	wg *sync.WaitGroup) {
 	defer wg.Done()
 
+	m.executeActions(executor, scheduled)
+	m.updateLastRun(executor, scheduled)
+	m.mutex.Lock()
+	executor.UpdateNextTime()
+	m.mutex.Unlock()
+	m.requeue(executor)
+}
+
+// executeMissedRuns applies the misfire policy of the interval to the runs it missed up to now
+func (m *manager) executeMissedRuns(executor *Executor, now time.Time, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	// Keep one more run than caught up, which is the last skipped run
+	m.mutex.Lock()
+	missed := executor.SkipMissedRuns(now, m.maxCatchUp+1)
+	m.mutex.Unlock()
+	if missed.Count == 0 {
+		m.requeue(executor)
+		return
+	}
+	var runs []time.Time
+	switch executor.Interval.MisfirePolicy {
+	case pkgModels.MisfirePolicyFireOnce:
+		runs = missed.Latest[len(missed.Latest)-1:]
+	case pkgModels.MisfirePolicyCatchUpAll:
+		runs = missed.Latest[max(len(missed.Latest)-m.maxCatchUp, 0):]
+	}
+
+	if skipped := missed.Count - len(runs); skipped > 0 {
+		lastSkipped := missed.Latest[len(missed.Latest)-len(runs)-1]
+		m.lc.Warnf("interval %s missed %d runs scheduled from %s to %s, skipping them",
+			executor.Interval.Name, skipped, missed.First.String(), lastSkipped.String())
+		m.recordMissedRuns(executor, missed.First, lastSkipped, skipped)
+	}
+	for _, run := range runs {
+		m.lc.Infof("executing the missed run of interval %s at %s", executor.Interval.Name, run.String())
+		m.executeActions(executor, run)
+	}
+	m.updateLastRun(executor, missed.Latest[len(missed.Latest)-1])
+	m.requeue(executor)
+}
+
+// executeActions executes the actions of the interval for its run at the scheduled time
+func (m *manager) executeActions(executor *Executor, scheduled time.Time) {
 	m.lc.Debugf("%d action need to be executed with interval %s.", len(executor.IntervalActionsMap), executor.Interval.Name)
 
 	// execute interval action one by one
 	for _, action := range executor.IntervalActionsMap {
+		// The leadership may be lost while the actions run
+		if !m.isLeader() {
+			m.lc.Warnf("the instance is not the leader anymore, skip the remaining actions of interval %s", executor.Interval.Name)
+			return
+		}
 		if action.AdminState == models.Locked {
 			m.lc.Debugf("interval action %s is locked, skip the job execution", action.Name)
 			continue
 		}
-		edgeXerr := m.executeAction(action)
-		if edgeXerr != nil {
-			m.lc.Errorf("fail to execute the interval action, err: %v", edgeXerr)
+		m.runAction(action, scheduled)
+	}
+}
+
+// runAction executes the interval action for the run of its interval at the scheduled time, and records the execution.
+// A failed action is retried in the background, so that the other actions and intervals aren't delayed by the backoff.
+func (m *manager) runAction(action pkgModels.IntervalAction, scheduled time.Time) {
+	record := pkgModels.IntervalActionRecord{
+		ActionName:    action.Name,
+		IntervalName:  action.IntervalName,
+		ScheduledTime: scheduled.UnixMilli(),
+	}
+	if m.attemptAction(action, &record) || action.RetryCount <= 0 {
+		m.addRecord(record)
+		return
+	}
+	m.mutex.Lock()
+	retryCtx := m.retryCtx
+	m.mutex.Unlock()
+	go m.retryAction(retryCtx, action, record)
+}
+
+// retryAction retries the failed interval action up to its retry count, doubling the backoff before each retry.  The
+// retries stop when ctx is cancelled, i.e. the ticker is stopped or the leadership is lost, or when the action is
+// deleted or locked meanwhile.
+func (m *manager) retryAction(ctx context.Context, action pkgModels.IntervalAction, record pkgModels.IntervalActionRecord) {
+	defer func() {
+		m.addRecord(record)
+	}()
+
+	backoff := defaultRetryBackoff
+	if action.RetryBackoff != "" {
+		if d, err := time.ParseDuration(action.RetryBackoff); err == nil {
+			backoff = d
+		}
+	}
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+	for retry := 1; retry <= action.RetryCount; retry++ {
+		m.lc.Debugf("retrying the interval action %s in %s", action.Name, backoff.String())
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			m.lc.Debugf("the retries of the interval action %s are cancelled", action.Name)
+			return
+		}
+		if !m.isLeader() {
+			m.lc.Debugf("the instance is not the leader anymore, stop retrying the interval action %s", action.Name)
+			return
+		}
+		current, ok := m.retriableAction(action.Name)
+		if !ok {
+			m.lc.Debugf("the interval action %s is deleted or locked, stop retrying it", action.Name)
+			return
+		}
+		if m.attemptAction(current, &record) {
+			return
 		}
+		backoff *= 2
+		timer.Reset(backoff)
 	}
+}
 
-	executor.UpdateNextTime()
+// retriableAction returns the current definition of the interval action, and whether the action still exists and is
+// unlocked so that it may be retried
+func (m *manager) retriableAction(name string) (pkgModels.IntervalAction, bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	executor, exists := m.intervalToExecutorMap[m.actionToIntervalMap[name]]
+	if !exists {
+		return pkgModels.IntervalAction{}, false
+	}
+	action, exists := executor.IntervalActionsMap[name]
+	return action, exists && action.AdminState != models.Locked
+}
+
+// attemptAction attempts to execute the interval action, adds the outcome of the attempt to the record of the
+// execution, and returns whether the attempt succeeded
+func (m *manager) attemptAction(action pkgModels.IntervalAction, record *pkgModels.IntervalActionRecord) bool {
+	start := time.Now()
+	statusCode, edgeXerr := m.executeAction(action)
+	attempt := pkgModels.IntervalActionAttempt{
+		Start:      start.UnixMilli(),
+		End:        time.Now().UnixMilli(),
+		StatusCode: statusCode,
+	}
+	if edgeXerr != nil {
+		m.lc.Errorf("fail to execute the interval action %s, attempt %d, err: %v", action.Name, len(record.Attempts)+1, edgeXerr)
+		attempt.Message = edgeXerr.Error()
+	}
+
+	if len(record.Attempts) == 0 {
+		record.Start = attempt.Start
+	}
+	record.Attempts = append(record.Attempts, attempt)
+	record.End = attempt.End
+	record.StatusCode = attempt.StatusCode
+	record.Message = attempt.Message
+	record.Status = pkgModels.IntervalActionRecordSucceeded
+	if edgeXerr != nil {
+		record.Status = pkgModels.IntervalActionRecordFailed
+		return false
+	}
+	return true
+}
+
+// requeue queues the executor again, unless the interval will not run anymore
+func (m *manager) requeue(executor *Executor) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
 
 	if executor.IsComplete() {
 		m.lc.Debugf("completed interval %s", executor.Interval.Name)
@@ -132,14 +430,25 @@ func (m *manager) execute(
 	}
 }
 
-func (m *manager) executeAction(action models.IntervalAction) errors.EdgeX {
+// executeAction executes the interval action, and returns the status code of the response to the action when it has
+// one, i.e. for the REST and device command actions
+func (m *manager) executeAction(action pkgModels.IntervalAction) (statusCode int, edgeXerr errors.EdgeX) {
 	m.lc.Debugf("the action with name: %s belongs to interval: %s will be executing!", action.Name, action.IntervalName)
 
+	var timeout time.Duration
+	if action.Timeout != "" {
+		var err error
+		timeout, err = time.ParseDuration(action.Timeout)
+		if err != nil {
+			return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("fail to parse the timeout %s", action.Timeout), err)
+		}
+	}
+
 	switch action.Address.GetBaseAddress().Type {
 	case common.REST:
 		restAddress, ok := action.Address.(models.RESTAddress)
 		if !ok {
-			return errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to cast Address to RESTAddress", nil)
+			return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to cast Address to RESTAddress", nil)
 		}
 
 		var jwtSecretProvider clientInterfaces.AuthenticationInjector
@@ -149,14 +458,266 @@ func (m *manager) executeAction(action models.IntervalAction) errors.EdgeX {
 			jwtSecretProvider = secret.NewJWTSecretProvider(nil)
 		}
 
-		_, err := utils.SendRequestWithRESTAddress(m.lc, action.Content, action.ContentType, restAddress, jwtSecretProvider)
-		if err != nil {
-			m.lc.Errorf("fail to send request with RESTAddress, err: %v", err)
+		_, statusCode, edgeXerr = utils.SendRequestWithRESTAddressForStatusCode(m.lc, action.Content, action.ContentType, restAddress, jwtSecretProvider, timeout)
+		edgeXerr = checkStatusCode(action, statusCode, edgeXerr)
+		if edgeXerr != nil {
+			return statusCode, errors.NewCommonEdgeX(errors.Kind(edgeXerr), "fail to send request with RESTAddress", edgeXerr)
+		}
+	case pkgCommon.MESSAGEBUS:
+		messageBusAddress, ok := action.Address.(pkgModels.MessageBusAddress)
+		if !ok {
+			return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to cast Address to MessageBusAddress", nil)
 		}
+		edgeXerr = m.publishAction(action, messageBusAddress)
+		if edgeXerr != nil {
+			return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+	case pkgCommon.DEVICECOMMAND:
+		commandAddress, ok := action.Address.(pkgModels.DeviceCommandAddress)
+		if !ok {
+			return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to cast Address to DeviceCommandAddress", nil)
+		}
+		ctx := context.Background()
+		if timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		statusCode, edgeXerr = m.issueDeviceCommand(ctx, action, commandAddress)
+		edgeXerr = checkStatusCode(action, statusCode, edgeXerr)
+		if edgeXerr != nil {
+			return statusCode, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+	case common.MQTT, common.EMAIL:
+		// The MQTT and EMAIL actions are accepted by the API, but fail so that their executions are recorded as failed
+		return 0, errors.NewCommonEdgeX(errors.KindNotImplemented,
+			fmt.Sprintf("the %s interval action %s is not supported by the scheduler, use a REST, MESSAGEBUS or DEVICECOMMAND action instead",
+				action.Address.GetBaseAddress().Type, action.Name), nil)
 	default:
-		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Unsupported address type", nil)
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, "Unsupported address type", nil)
 	}
 
 	m.lc.Debugf("success to execute the action %s with interval %s", action.Name, action.IntervalName)
+	return statusCode, nil
+}
+
+// publishAction publishes the content of the action to the topic of the address, relative to the base topic prefix
+func (m *manager) publishAction(action pkgModels.IntervalAction, address pkgModels.MessageBusAddress) errors.EdgeX {
+	if m.messagingClient == nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "the message bus is not available to publish the interval action", nil)
+	}
+
+	envelope := types.NewMessageEnvelope([]byte(action.Content), context.Background())
+	envelope.ContentType = action.ContentType
+	if envelope.ContentType == "" {
+		envelope.ContentType = common.ContentTypeJSON
+	}
+	topic := common.BuildTopic(m.config.MessageBus.GetBaseTopicPrefix(), address.Topic)
+	err := m.messagingClient.Publish(envelope, topic)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindCommunicationError, fmt.Sprintf("fail to publish the interval action to topic %s", topic), err)
+	}
 	return nil
 }
+
+// issueDeviceCommand issues the device command of the address through core-command, the content of a SET action being
+// the values to set by device resource name.  The status code is the one of the core-command response.
+func (m *manager) issueDeviceCommand(ctx context.Context, action pkgModels.IntervalAction, address pkgModels.DeviceCommandAddress) (int, errors.EdgeX) {
+	if m.commandClient == nil {
+		return 0, errors.NewCommonEdgeX(errors.KindServerError, "the core-command client is not available to issue the interval action", nil)
+	}
+
+	switch address.Method {
+	case pkgCommon.DeviceCommandGet:
+		res, edgeXerr := m.commandClient.IssueGetCommandByName(ctx, address.DeviceName, address.CommandName, address.PushEvent, false)
+		if edgeXerr != nil {
+			return edgeXerr.Code(), errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		if res == nil {
+			return http.StatusOK, nil
+		}
+		return res.StatusCode, nil
+	case pkgCommon.DeviceCommandSet:
+		var settings map[string]any
+		err := json.Unmarshal([]byte(action.Content), &settings)
+		if err != nil {
+			return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to parse the values to set from the interval action content", err)
+		}
+		res, edgeXerr := m.commandClient.IssueSetCommandByNameWithObject(ctx, address.DeviceName, address.CommandName, settings)
+		if edgeXerr != nil {
+			return edgeXerr.Code(), errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		return res.StatusCode, nil
+	default:
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported device command method %s", address.Method), nil)
+	}
+}
+
+// checkStatusCode checks the status code of the response to the action against the status codes the action expects,
+// if any, the action succeeding with one of them even if the status code is an error.  The err the action failed with
+// is returned unchanged when the action has no response or expects no status code.
+func checkStatusCode(action pkgModels.IntervalAction, statusCode int, err errors.EdgeX) errors.EdgeX {
+	if statusCode == 0 || len(action.ExpectedStatusCodes) == 0 {
+		return err
+	}
+	if slices.Contains(action.ExpectedStatusCodes, statusCode) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return errors.NewCommonEdgeX(errors.KindServerError,
+		fmt.Sprintf("unexpected status code %d, expected one of %v", statusCode, action.ExpectedStatusCodes), nil)
+}
+
+// recordMissedRuns records the runs of the interval skipped by its misfire policy, once for each action of the
+// interval, the record being scheduled at the last skipped run
+func (m *manager) recordMissedRuns(executor *Executor, first time.Time, last time.Time, count int) {
+	now := time.Now().UnixMilli()
+	for _, action := range executor.IntervalActionsMap {
+		if action.AdminState == models.Locked {
+			continue
+		}
+		m.addRecord(pkgModels.IntervalActionRecord{
+			ActionName:    action.Name,
+			IntervalName:  executor.Interval.Name,
+			ScheduledTime: last.UnixMilli(),
+			Start:         now,
+			End:           now,
+			Status:        pkgModels.IntervalActionRecordMissed,
+			Message:       fmt.Sprintf("missed %d runs scheduled from %s to %s", count, first.Format(time.RFC3339), last.Format(time.RFC3339)),
+		})
+	}
+}
+
+// addRecord adds the record to the history of the interval action executions, when the history is enabled.  The record
+// is persisted asynchronously so that the following actions aren't delayed by the database.
+func (m *manager) addRecord(record pkgModels.IntervalActionRecord) {
+	if m.dbClient == nil || !m.config.History.Enabled {
+		return
+	}
+	maxCount := m.config.History.MaxCount
+	go func() {
+		if _, err := m.dbClient.AddIntervalActionRecord(record); err != nil {
+			m.lc.Errorf("failed to record the execution of the interval action %s: %v", record.ActionName, err)
+			return
+		}
+		if maxCount <= 0 {
+			return
+		}
+		if err := m.dbClient.TrimIntervalActionRecords(record.IntervalName, maxCount); err != nil {
+			m.lc.Errorf("failed to trim the history of the actions of interval %s: %v", record.IntervalName, err)
+		}
+	}()
+}
+
+// updateLastRun stores the scheduled time of the last run of the interval, which the interval resumes from.  The last
+// run is stored whether the history is enabled or not, so that the missed runs are always handled by the misfire
+// policy.
+func (m *manager) updateLastRun(executor *Executor, scheduled time.Time) {
+	if m.dbClient == nil {
+		return
+	}
+	if err := m.dbClient.UpdateIntervalLastRun(executor.Interval.Name, scheduled.UnixMilli()); err != nil {
+		m.lc.Errorf("fail to store the last run of the interval %s, err: %v", executor.Interval.Name, err)
+	}
+}
+
+// resumeExecutor resumes the interval from its last run, so that the runs the interval missed while the scheduler was
+// down are handled by its misfire policy.  The last run of a previous interval of the same name, which was scheduled
+// before the interval was created, is ignored.
+func (m *manager) resumeExecutor(executor *Executor) {
+	if m.dbClient == nil {
+		return
+	}
+	lastRun, err := m.dbClient.IntervalLastRun(executor.Interval.Name)
+	if errors.Kind(err) == errors.KindEntityDoesNotExist {
+		return
+	} else if err != nil {
+		m.lc.Errorf("fail to query the last run of the interval %s, its missed runs are skipped, err: %v", executor.Interval.Name, err)
+		return
+	}
+	if lastRun < executor.Interval.Created {
+		return
+	}
+	executor.Resume(time.UnixMilli(lastRun))
+}
+
+// syncDefinitions requests the reload of the intervals and interval actions from the database when the instance is
+// elected as the leader, as the previous leader may have run them since they were loaded, and when the leader finds
+// them changed through the other instances
+func (m *manager) syncDefinitions(elected bool) {
+	revision, err := m.loadDefinitionsRevision()
+	if err != nil {
+		m.lc.Errorf("fail to check the intervals and interval actions for changes, err: %v", err)
+		if elected {
+			m.reloadPending.Store(true)
+		}
+		return
+	}
+	if elected || revision != m.definitionsRevision {
+		m.definitionsRevision = revision
+		m.reloadPending.Store(true)
+	}
+}
+
+// loadDefinitionsRevision returns the revision of the intervals and interval actions in the database, which changes
+// when any of them is added, updated or deleted
+func (m *manager) loadDefinitionsRevision() (string, errors.EdgeX) {
+	intervals, err := m.dbClient.AllIntervals(0, -1)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	actions, err := m.dbClient.AllIntervalActions(0, -1)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	var modified int64
+	for _, interval := range intervals {
+		modified = max(modified, interval.Modified)
+	}
+	for _, action := range actions {
+		modified = max(modified, action.Modified)
+	}
+	return fmt.Sprintf("%d/%d/%d", len(intervals), len(actions), modified), nil
+}
+
+// reload replaces the intervals and interval actions by those in the database.  The intervals resume from their last
+// runs, so that the runs missed while no instance was the leader are handled by their misfire policies.
+func (m *manager) reload() {
+	intervals, err := m.dbClient.AllIntervals(0, -1)
+	if err != nil {
+		m.lc.Errorf("fail to reload the intervals, err: %v", err)
+		m.reloadPending.Store(true)
+		return
+	}
+	actions, err := m.dbClient.AllIntervalActions(0, -1)
+	if err != nil {
+		m.lc.Errorf("fail to reload the interval actions, err: %v", err)
+		m.reloadPending.Store(true)
+		return
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	for _, executor := range m.intervalToExecutorMap {
+		// Mark as Deleted and scheduler will remove it from the queue
+		executor.MarkedDeleted = true
+	}
+	m.intervalToExecutorMap = make(map[string]*Executor)
+	m.actionToIntervalMap = make(map[string]string)
+	for _, interval := range intervals {
+		if err := m.addInterval(interval); err != nil {
+			m.lc.Errorf("fail to reload the interval %s, err: %v", interval.Name, err)
+		}
+	}
+	for _, action := range actions {
+		executor, exists := m.intervalToExecutorMap[action.IntervalName]
+		if !exists {
+			m.lc.Errorf("fail to reload the interval action %s, the interval %s does not exist", action.Name, action.IntervalName)
+			continue
+		}
+		m.addIntervalAction(executor, action)
+	}
+	m.lc.Infof("reloaded %d intervals and %d interval actions", len(intervals), len(actions))
+}
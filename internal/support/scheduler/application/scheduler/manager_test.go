@@ -6,13 +6,34 @@
 package scheduler
 
 import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/infrastructure/interfaces"
+	dbMocks "github.com/edgexfoundry/edgex-go/internal/support/scheduler/infrastructure/interfaces/mocks"
 
+	clientMocks "github.com/edgexfoundry/go-mod-core-contracts/v3/clients/interfaces/mocks"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	messagingMocks "github.com/edgexfoundry/go-mod-messaging/v3/messaging/mocks"
+	"github.com/edgexfoundry/go-mod-messaging/v3/pkg/types"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -25,6 +46,409 @@ func TestNewManager(t *testing.T) {
 		IntervalActions:      nil,
 		ScheduleIntervalTime: 500,
 	}
-	manager := NewManager(lc, config, nil)
+	manager := NewManager(lc, config, nil, nil, nil, nil)
 	require.NotNil(t, manager)
 }
+
+func TestManager_ExecuteAction(t *testing.T) {
+	lc := logger.NewMockClient()
+	config := &config.ConfigurationStruct{ScheduleIntervalTime: 500}
+	config.MessageBus.BaseTopicPrefix = "edgex"
+
+	messagingClientMock := &messagingMocks.MessageClient{}
+	messagingClientMock.On("Publish", mock.MatchedBy(func(envelope types.MessageEnvelope) bool {
+		return string(envelope.Payload) == `{"state":"on"}` && envelope.ContentType == common.ContentTypeJSON
+	}), "edgex/lights/on").Return(nil)
+	commandClientMock := &clientMocks.CommandClient{}
+	commandClientMock.On("IssueGetCommandByName", mock.Anything, "thermostat", "temperature", true, false).
+		Return(&responses.EventResponse{BaseResponse: commonDTO.BaseResponse{StatusCode: http.StatusOK}}, nil)
+	commandClientMock.On("IssueSetCommandByNameWithObject", mock.Anything, "thermostat", "setpoint", map[string]any{"setpoint": 21.5}).
+		Return(commonDTO.BaseResponse{StatusCode: http.StatusOK}, nil)
+
+	m := NewManager(lc, config, nil, nil, messagingClientMock, commandClientMock).(*manager)
+	withoutClients := NewManager(lc, config, nil, nil, nil, nil).(*manager)
+
+	publish := pkgModels.IntervalAction{
+		Name:    "publish",
+		Content: `{"state":"on"}`,
+		Address: pkgModels.MessageBusAddress{BaseAddress: models.BaseAddress{Type: pkgCommon.MESSAGEBUS}, Topic: "lights/on"},
+	}
+	get := pkgModels.IntervalAction{
+		Name: "get",
+		Address: pkgModels.DeviceCommandAddress{BaseAddress: models.BaseAddress{Type: pkgCommon.DEVICECOMMAND},
+			DeviceName: "thermostat", CommandName: "temperature", Method: pkgCommon.DeviceCommandGet, PushEvent: true},
+	}
+	set := pkgModels.IntervalAction{
+		Name:    "set",
+		Content: `{"setpoint":21.5}`,
+		Address: pkgModels.DeviceCommandAddress{BaseAddress: models.BaseAddress{Type: pkgCommon.DEVICECOMMAND},
+			DeviceName: "thermostat", CommandName: "setpoint", Method: pkgCommon.DeviceCommandSet},
+	}
+	invalidSet := set
+	invalidSet.Content = "21.5"
+	mqtt := pkgModels.IntervalAction{
+		Name:    "mqtt",
+		Address: models.MQTTPubAddress{BaseAddress: models.BaseAddress{Type: common.MQTT, Host: "localhost", Port: 1883}, Topic: "lights/on"},
+	}
+
+	tests := []struct {
+		name               string
+		manager            *manager
+		action             pkgModels.IntervalAction
+		errorExpected      bool
+		expectedStatusCode int
+	}{
+		{"valid - publish to the message bus", m, publish, false, 0},
+		{"valid - device command GET", m, get, false, http.StatusOK},
+		{"valid - device command SET", m, set, false, http.StatusOK},
+		{"invalid - device command SET content", m, invalidSet, true, 0},
+		{"invalid - MQTT not supported", m, mqtt, true, 0},
+		{"invalid - no message bus", withoutClients, publish, true, 0},
+		{"invalid - no command client", withoutClients, get, true, 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			statusCode, err := testCase.manager.executeAction(testCase.action)
+			if testCase.errorExpected {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, statusCode)
+		})
+	}
+	messagingClientMock.AssertExpectations(t)
+	commandClientMock.AssertExpectations(t)
+}
+
+func TestManager_ExecuteMissedRuns(t *testing.T) {
+	lc := logger.NewMockClient()
+	now := time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC)
+	hour := func(h int) int64 {
+		return time.Date(2024, 1, 1, h, 0, 0, 0, time.UTC).UnixMilli()
+	}
+
+	tests := []struct {
+		name             string
+		policy           string
+		expectedSkipped  string
+		expectedRuns     []int64
+		expectedLastMiss int64
+	}{
+		{"skip", pkgModels.MisfirePolicySkip, "missed 11 runs", nil, hour(10)},
+		{"default to skip", "", "missed 11 runs", nil, hour(10)},
+		{"fire once", pkgModels.MisfirePolicyFireOnce, "missed 10 runs", []int64{hour(10)}, hour(9)},
+		{"catch up all", pkgModels.MisfirePolicyCatchUpAll, "missed 8 runs", []int64{hour(8), hour(9), hour(10)}, hour(7)},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			config := &config.ConfigurationStruct{ScheduleIntervalTime: 500}
+			config.History.Enabled = true
+			config.Misfire.MaxCatchUp = 3
+
+			var mutex sync.Mutex
+			var records []pkgModels.IntervalActionRecord
+			dbClientMock := &dbMocks.DBClient{}
+			dbClientMock.On("AddIntervalActionRecord", mock.Anything).Run(func(args mock.Arguments) {
+				mutex.Lock()
+				defer mutex.Unlock()
+				records = append(records, args.Get(0).(pkgModels.IntervalActionRecord))
+			}).Return(pkgModels.IntervalActionRecord{}, nil)
+			dbClientMock.On("UpdateIntervalLastRun", "hourly", hour(10)).Return(nil)
+			messagingClientMock := &messagingMocks.MessageClient{}
+			messagingClientMock.On("Publish", mock.Anything, mock.Anything).Return(nil)
+			m := NewManager(lc, config, nil, dbClientMock, messagingClientMock, nil).(*manager)
+
+			executor := &Executor{IntervalActionsMap: map[string]pkgModels.IntervalAction{}}
+			err := executor.Initialize(pkgModels.Interval{
+				Interval:      models.Interval{Name: "hourly", Start: "20240101T000000", Interval: "1h"},
+				Timezone:      "UTC",
+				MisfirePolicy: testCase.policy,
+			}, lc)
+			require.NoError(t, err)
+			executor.NextTime = time.UnixMilli(hour(0))
+			executor.IntervalActionsMap["publish"] = pkgModels.IntervalAction{
+				Name:         "publish",
+				IntervalName: "hourly",
+				Address:      pkgModels.MessageBusAddress{BaseAddress: models.BaseAddress{Type: pkgCommon.MESSAGEBUS}, Topic: "hourly"},
+				AdminState:   models.Unlocked,
+			}
+
+			var wg sync.WaitGroup
+			wg.Add(1)
+			m.executeMissedRuns(executor, now, &wg)
+			wg.Wait()
+
+			// the skipped runs are recorded once
+			expectedCount := 1 + len(testCase.expectedRuns)
+			require.Eventually(t, func() bool {
+				mutex.Lock()
+				defer mutex.Unlock()
+				return len(records) == expectedCount
+			}, time.Second, 10*time.Millisecond)
+
+			var runs []int64
+			for _, r := range records {
+				switch r.Status {
+				case pkgModels.IntervalActionRecordMissed:
+					assert.Equal(t, testCase.expectedLastMiss, r.ScheduledTime)
+					assert.True(t, strings.HasPrefix(r.Message, testCase.expectedSkipped), r.Message)
+				case pkgModels.IntervalActionRecordSucceeded:
+					runs = append(runs, r.ScheduledTime)
+				default:
+					assert.Fail(t, "unexpected record status", r.Status)
+				}
+				assert.Equal(t, "publish", r.ActionName)
+			}
+			assert.ElementsMatch(t, testCase.expectedRuns, runs)
+			messagingClientMock.AssertNumberOfCalls(t, "Publish", len(testCase.expectedRuns))
+			assert.True(t, time.UnixMilli(hour(11)).Equal(executor.NextTime))
+			assert.Equal(t, 1, m.executorQueue.Length())
+			// the interval resumes from the last missed run
+			dbClientMock.AssertCalled(t, "UpdateIntervalLastRun", "hourly", hour(10))
+		})
+	}
+}
+
+func TestManager_RunAction(t *testing.T) {
+	lc := logger.NewMockClient()
+	scheduled := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name               string
+		statusCodes        []int
+		delay              time.Duration
+		retryCount         int
+		timeout            string
+		expectedCodes      []int
+		expectedStatus     string
+		expectedStatusCode int
+		expectedAttempts   int
+	}{
+		{"succeeded", []int{http.StatusOK}, 0, 3, "", nil, pkgModels.IntervalActionRecordSucceeded, http.StatusOK, 1},
+		{"succeeded after retries", []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK}, 0, 3, "", nil, pkgModels.IntervalActionRecordSucceeded, http.StatusOK, 3},
+		{"failed after retries", []int{http.StatusServiceUnavailable}, 0, 2, "", nil, pkgModels.IntervalActionRecordFailed, http.StatusServiceUnavailable, 3},
+		{"failed without retry", []int{http.StatusServiceUnavailable}, 0, 0, "", nil, pkgModels.IntervalActionRecordFailed, http.StatusServiceUnavailable, 1},
+		{"succeeded with expected error status code", []int{http.StatusNotFound}, 0, 3, "", []int{http.StatusOK, http.StatusNotFound}, pkgModels.IntervalActionRecordSucceeded, http.StatusNotFound, 1},
+		{"retried on unexpected status code", []int{http.StatusOK, http.StatusAccepted}, 0, 3, "", []int{http.StatusAccepted}, pkgModels.IntervalActionRecordSucceeded, http.StatusAccepted, 2},
+		{"failed on timeout", []int{http.StatusOK}, 500 * time.Millisecond, 0, "50ms", nil, pkgModels.IntervalActionRecordFailed, 0, 1},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			var requests int
+			var requestsMutex sync.Mutex
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestsMutex.Lock()
+				statusCode := testCase.statusCodes[min(requests, len(testCase.statusCodes)-1)]
+				requests++
+				requestsMutex.Unlock()
+				time.Sleep(testCase.delay)
+				w.WriteHeader(statusCode)
+			}))
+			defer server.Close()
+			serverURL, err := url.Parse(server.URL)
+			require.NoError(t, err)
+			port, err := strconv.Atoi(serverURL.Port())
+			require.NoError(t, err)
+
+			config := &config.ConfigurationStruct{ScheduleIntervalTime: 500}
+			config.History.Enabled = true
+			records := make(chan pkgModels.IntervalActionRecord, 1)
+			dbClientMock := &dbMocks.DBClient{}
+			dbClientMock.On("AddIntervalActionRecord", mock.Anything).Run(func(args mock.Arguments) {
+				records <- args.Get(0).(pkgModels.IntervalActionRecord)
+			}).Return(pkgModels.IntervalActionRecord{}, nil)
+			dbClientMock.On("IntervalLastRun", "midnight").Return(int64(0), errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "", nil))
+			m := NewManager(lc, config, nil, dbClientMock, nil, nil).(*manager)
+
+			// the action is retried as long as it is defined
+			action := pkgModels.IntervalAction{
+				Name:         "purge",
+				IntervalName: "midnight",
+				Address: models.RESTAddress{
+					BaseAddress: models.BaseAddress{Type: common.REST, Host: serverURL.Hostname(), Port: port},
+					Path:        "/api/v3/event/age/0",
+					HTTPMethod:  http.MethodDelete,
+				},
+				AdminState:          models.Unlocked,
+				RetryCount:          testCase.retryCount,
+				RetryBackoff:        "1ms",
+				Timeout:             testCase.timeout,
+				ExpectedStatusCodes: testCase.expectedCodes,
+			}
+			require.NoError(t, m.AddInterval(pkgModels.Interval{Interval: models.Interval{Name: "midnight", Start: "20240101T000000", Interval: "24h"}, Timezone: "UTC"}))
+			require.NoError(t, m.AddIntervalAction(action))
+			m.runAction(action, scheduled)
+
+			var record pkgModels.IntervalActionRecord
+			select {
+			case record = <-records:
+			case <-time.After(5 * time.Second):
+				require.Fail(t, "the execution of the interval action is not recorded")
+			}
+			assert.Equal(t, testCase.expectedStatus, record.Status)
+			assert.Equal(t, testCase.expectedStatusCode, record.StatusCode)
+			assert.Equal(t, scheduled.UnixMilli(), record.ScheduledTime)
+			require.Len(t, record.Attempts, testCase.expectedAttempts)
+			assert.Equal(t, record.Attempts[0].Start, record.Start)
+			assert.Equal(t, record.Attempts[len(record.Attempts)-1].End, record.End)
+			for i, attempt := range record.Attempts[:len(record.Attempts)-1] {
+				assert.NotEmpty(t, attempt.Message, "attempt %d", i)
+			}
+			if testCase.expectedStatus == pkgModels.IntervalActionRecordFailed {
+				assert.NotEmpty(t, record.Message)
+			}
+		})
+	}
+}
+
+func TestManager_RetryAction_Stopped(t *testing.T) {
+	lc := logger.NewMockClient()
+	interval := pkgModels.Interval{Interval: models.Interval{Name: "hourly", Start: "20240101T000000", Interval: "1h"}, Timezone: "UTC"}
+	action := pkgModels.IntervalAction{
+		Name:         "publish",
+		IntervalName: "hourly",
+		Address:      pkgModels.MessageBusAddress{BaseAddress: models.BaseAddress{Type: pkgCommon.MESSAGEBUS}, Topic: "hourly"},
+		AdminState:   models.Unlocked,
+		RetryCount:   3,
+		RetryBackoff: "50ms",
+	}
+	locked := action
+	locked.AdminState = models.Locked
+
+	tests := []struct {
+		name           string
+		leaderElection bool
+		stop           func(m *manager) error
+	}{
+		{"ticker stopped", false, func(m *manager) error {
+			m.StopTicker()
+			return nil
+		}},
+		{"action locked", false, func(m *manager) error {
+			return m.UpdateIntervalAction(locked)
+		}},
+		{"action deleted", false, func(m *manager) error {
+			return m.DeleteIntervalActionByName(action.Name)
+		}},
+		{"leadership lost", true, func(m *manager) error {
+			m.elector.elect()
+			return nil
+		}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			config := &config.ConfigurationStruct{ScheduleIntervalTime: 500}
+			config.History.Enabled = true
+			config.LeaderElection.Enabled = testCase.leaderElection
+			config.LeaderElection.InstanceId = "scheduler-1"
+			records := make(chan pkgModels.IntervalActionRecord, 1)
+			dbClientMock := &dbMocks.DBClient{}
+			dbClientMock.On("AcquireLease", leaseName, "scheduler-1", mock.Anything).Return("scheduler-1", nil).Once()
+			dbClientMock.On("AcquireLease", leaseName, "scheduler-1", mock.Anything).Return("scheduler-2", nil)
+			dbClientMock.On("AllIntervals", 0, -1).Return([]pkgModels.Interval{interval}, nil)
+			dbClientMock.On("AllIntervalActions", 0, -1).Return([]pkgModels.IntervalAction{action}, nil)
+			dbClientMock.On("IntervalLastRun", "hourly").Return(int64(0), errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "", nil))
+			dbClientMock.On("AddIntervalActionRecord", mock.Anything).Run(func(args mock.Arguments) {
+				records <- args.Get(0).(pkgModels.IntervalActionRecord)
+			}).Return(pkgModels.IntervalActionRecord{}, nil)
+			messagingClientMock := &messagingMocks.MessageClient{}
+			messagingClientMock.On("Publish", mock.Anything, mock.Anything).Return(errors.NewCommonEdgeX(errors.KindServerError, "unavailable", nil))
+			m := NewManager(lc, config, nil, dbClientMock, messagingClientMock, nil).(*manager)
+			require.NoError(t, m.AddInterval(interval))
+			require.NoError(t, m.AddIntervalAction(action))
+			if m.elector != nil {
+				m.elector.elect()
+				require.True(t, m.isLeader())
+			}
+
+			m.runAction(action, time.Now())
+			require.NoError(t, testCase.stop(m))
+
+			var record pkgModels.IntervalActionRecord
+			select {
+			case record = <-records:
+			case <-time.After(5 * time.Second):
+				require.Fail(t, "the execution of the interval action is not recorded")
+			}
+			assert.Equal(t, pkgModels.IntervalActionRecordFailed, record.Status)
+			assert.Len(t, record.Attempts, 1)
+			messagingClientMock.AssertNumberOfCalls(t, "Publish", 1)
+		})
+	}
+}
+
+func TestManager_LeaderElection(t *testing.T) {
+	lc := logger.NewMockClient()
+	config := &config.ConfigurationStruct{ScheduleIntervalTime: 500}
+	config.LeaderElection.Enabled = true
+	config.LeaderElection.InstanceId = "scheduler-1"
+
+	interval := pkgModels.Interval{
+		Interval: models.Interval{DBTimestamp: models.DBTimestamp{Modified: 1}, Name: "hourly", Start: "20240101T000000", Interval: "1h"},
+		Timezone: "UTC",
+	}
+	action := pkgModels.IntervalAction{
+		DBTimestamp:  models.DBTimestamp{Modified: 2},
+		Name:         "publish",
+		IntervalName: "hourly",
+		Address:      pkgModels.MessageBusAddress{BaseAddress: models.BaseAddress{Type: pkgCommon.MESSAGEBUS}, Topic: "hourly"},
+		AdminState:   models.Unlocked,
+	}
+	dbClientMock := &dbMocks.DBClient{}
+	dbClientMock.On("AcquireLease", leaseName, "scheduler-1", mock.Anything).Return("scheduler-2", nil).Once()
+	dbClientMock.On("AcquireLease", leaseName, "scheduler-1", mock.Anything).Return("scheduler-1", nil)
+	dbClientMock.On("AllIntervals", 0, -1).Return([]pkgModels.Interval{interval}, nil)
+	dbClientMock.On("AllIntervalActions", 0, -1).Return([]pkgModels.IntervalAction{action}, nil)
+	dbClientMock.On("IntervalLastRun", "hourly").Return(int64(0), errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "", nil))
+	dbClientMock.On("UpdateIntervalLastRun", "hourly", mock.Anything).Return(nil)
+	messagingClientMock := &messagingMocks.MessageClient{}
+	messagingClientMock.On("Publish", mock.Anything, mock.Anything).Return(nil)
+	m := NewManager(lc, config, nil, dbClientMock, messagingClientMock, nil).(*manager)
+	require.NotNil(t, m.elector)
+
+	// The interval was loaded before the election, and is due
+	require.NoError(t, m.AddInterval(interval))
+	require.NoError(t, m.AddIntervalAction(action))
+	loaded := m.intervalToExecutorMap["hourly"]
+	loaded.NextTime = time.Now().Add(-time.Second)
+
+	// The standby instance doesn't run the interval
+	m.elector.elect()
+	assert.Equal(t, pkgModels.LeadershipStandby, m.Leadership().State)
+	assert.Equal(t, "scheduler-2", m.Leadership().LeaderId)
+	m.triggerInterval()
+	messagingClientMock.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+
+	// The elected instance reloads the interval from the database before running it
+	m.elector.elect()
+	assert.Equal(t, pkgModels.LeadershipLeader, m.Leadership().State)
+	m.triggerInterval()
+	assert.True(t, loaded.MarkedDeleted)
+	reloaded := m.intervalToExecutorMap["hourly"]
+	require.NotNil(t, reloaded)
+	assert.NotSame(t, loaded, reloaded)
+	assert.Contains(t, reloaded.IntervalActionsMap, "publish")
+	messagingClientMock.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+
+	reloaded.NextTime = time.Now().Add(-time.Second)
+	m.triggerInterval()
+	messagingClientMock.AssertNumberOfCalls(t, "Publish", 1)
+
+	// The leader reloads the interval only when it changed in the database
+	m.elector.elect()
+	assert.False(t, m.reloadPending.Load())
+	interval.Modified = 3
+	dbClientMock.ExpectedCalls = slices.DeleteFunc(dbClientMock.ExpectedCalls, func(call *mock.Call) bool {
+		return call.Method == "AllIntervals"
+	})
+	dbClientMock.On("AllIntervals", 0, -1).Return([]pkgModels.Interval{interval}, nil)
+	m.elector.elect()
+	assert.True(t, m.reloadPending.Load())
+}
+
+func TestManager_Leadership_Disabled(t *testing.T) {
+	m := NewManager(logger.NewMockClient(), &config.ConfigurationStruct{ScheduleIntervalTime: 500}, nil, &dbMocks.DBClient{}, nil, nil).(*manager)
+	assert.Nil(t, m.elector)
+	assert.False(t, m.Leadership().Enabled)
+}
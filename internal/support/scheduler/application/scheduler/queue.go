@@ -7,36 +7,48 @@ package scheduler
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
-	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 )
 
-func (m *manager) addIntervalAction(e *Executor, action models.IntervalAction) {
+func (m *manager) addIntervalAction(e *Executor, action pkgModels.IntervalAction) {
 	e.IntervalActionsMap[action.Name] = action
 	m.actionToIntervalMap[action.Name] = e.Interval.Name
 }
 
 // AddInterval adds a new interval executor to the SchedulerManager's job queue
-func (m *manager) AddInterval(interval models.Interval) errors.EdgeX {
+func (m *manager) AddInterval(interval pkgModels.Interval) errors.EdgeX {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
+	return m.addInterval(interval)
+}
+
+// addInterval adds a new interval executor to the job queue, the caller holding the mutex
+func (m *manager) addInterval(interval pkgModels.Interval) errors.EdgeX {
 	if _, exists := m.intervalToExecutorMap[interval.Name]; exists {
 		return errors.NewCommonEdgeX(errors.KindStatusConflict,
 			fmt.Sprintf("the executor with interval name : %s already exists", interval.Name), nil)
 	}
 
 	executor := Executor{
-		IntervalActionsMap: make(map[string]models.IntervalAction),
+		IntervalActionsMap: make(map[string]pkgModels.IntervalAction),
 		MarkedDeleted:      false,
 	}
 	err := executor.Initialize(interval, m.lc)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	m.resumeExecutor(&executor)
 
 	m.intervalToExecutorMap[interval.Name] = &executor
+	if executor.IsComplete() {
+		m.lc.Infof("interval %s will not run anymore, it is not added into the scheduler queue", interval.Name)
+		return nil
+	}
 	m.executorQueue.Add(&executor)
 
 	m.lc.Infof("added interval %s executor into the scheduler queue", interval.Name)
@@ -44,7 +56,7 @@ func (m *manager) AddInterval(interval models.Interval) errors.EdgeX {
 }
 
 // UpdateInterval updates interval executor to the SchedulerManager's job queue
-func (m *manager) UpdateInterval(interval models.Interval) errors.EdgeX {
+func (m *manager) UpdateInterval(interval pkgModels.Interval) errors.EdgeX {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
@@ -53,14 +65,35 @@ func (m *manager) UpdateInterval(interval models.Interval) errors.EdgeX {
 		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist,
 			fmt.Sprintf("the executor with interval name %s does not exist", interval.Name), nil)
 	}
+	// The completed executor was removed from the queue, so it must be queued again if it has to run again
+	wasComplete := executor.IsComplete()
 	err := executor.Initialize(interval, m.lc)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	if wasComplete && !executor.IsComplete() {
+		m.executorQueue.Add(executor)
+	}
 	m.lc.Infof("updated the interval %s executor in the scheduler queue", interval.Name)
 	return nil
 }
 
+// IntervalNextTime returns the next time the interval will run, which is the zero time if it will not run anymore
+func (m *manager) IntervalNextTime(intervalName string) (time.Time, errors.EdgeX) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	executor, exists := m.intervalToExecutorMap[intervalName]
+	if !exists {
+		return time.Time{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist,
+			fmt.Sprintf("the executor with interval name %s does not exist", intervalName), nil)
+	}
+	if executor.IsComplete() {
+		return time.Time{}, nil
+	}
+	return executor.NextTime, nil
+}
+
 // DeleteIntervalByName deletes interval executor by intervalName
 func (m *manager) DeleteIntervalByName(intervalName string) errors.EdgeX {
 	m.mutex.Lock()
@@ -82,7 +115,7 @@ func (m *manager) DeleteIntervalByName(intervalName string) errors.EdgeX {
 }
 
 // AddIntervalAction adds intervalAction to the specified executor
-func (m *manager) AddIntervalAction(action models.IntervalAction) errors.EdgeX {
+func (m *manager) AddIntervalAction(action pkgModels.IntervalAction) errors.EdgeX {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
@@ -104,7 +137,7 @@ func (m *manager) AddIntervalAction(action models.IntervalAction) errors.EdgeX {
 }
 
 // UpdateIntervalAction updates intervalAction to the specified executor
-func (m *manager) UpdateIntervalAction(action models.IntervalAction) errors.EdgeX {
+func (m *manager) UpdateIntervalAction(action pkgModels.IntervalAction) errors.EdgeX {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 	m.lc.Debugf("updating the intervalAction with name: %s ", action.Name)
@@ -6,16 +6,20 @@
 package scheduler
 
 import (
+	"sync"
 	"testing"
 	"time"
 
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/infrastructure/interfaces"
+	dbMocks "github.com/edgexfoundry/edgex-go/internal/support/scheduler/infrastructure/interfaces/mocks"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/eapache/queue.v1"
 )
@@ -42,17 +46,17 @@ func testManager() interfaces.SchedulerManager {
 	}
 }
 
-func intervalData() models.Interval {
-	return models.Interval{
+func intervalData() pkgModels.Interval {
+	return pkgModels.Interval{Interval: models.Interval{
 		Name:     testIntervalName,
 		Start:    "",
 		End:      "",
 		Interval: "10s",
-	}
+	}}
 }
 
-func intervalActionData() models.IntervalAction {
-	return models.IntervalAction{
+func intervalActionData() pkgModels.IntervalAction {
+	return pkgModels.IntervalAction{
 		Name:         testIntervalActionName,
 		IntervalName: testIntervalName,
 		Address:      models.RESTAddress{},
@@ -70,12 +74,12 @@ func TestManager_AddInterval(t *testing.T) {
 	invalidEndTime := intervalData()
 	invalidEndTime.End = "20060102T"
 	invalidInterval := intervalData()
-	invalidInterval.Interval = "10"
+	invalidInterval.Interval.Interval = "10"
 
 	tests := []struct {
 		name              string
 		manager           interfaces.SchedulerManager
-		interval          models.Interval
+		interval          pkgModels.Interval
 		expectedErrorKind errors.ErrKind
 	}{
 		{"valid", testManager(), interval, ""},
@@ -96,6 +100,43 @@ func TestManager_AddInterval(t *testing.T) {
 	}
 }
 
+func TestManager_AddInterval_Resume(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := pkgModels.Interval{Interval: models.Interval{Name: testIntervalName, Start: "20240101T000000", Interval: "1h"}, Timezone: "UTC"}
+	interval.Created = created.UnixMilli()
+
+	tests := []struct {
+		name           string
+		historyEnabled bool
+		lastRun        int64
+		dbError        errors.EdgeX
+		expectedNext   time.Time
+	}{
+		{"resumed from the last run", true, created.Add(3 * time.Hour).UnixMilli(), nil, created.Add(4 * time.Hour)},
+		{"resumed with the history disabled", false, created.Add(3 * time.Hour).UnixMilli(), nil, created.Add(4 * time.Hour)},
+		{"no last run", true, 0, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "", nil), time.Time{}},
+		{"last run of a previous interval", true, created.Add(-time.Hour).UnixMilli(), nil, time.Time{}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dbClientMock := &dbMocks.DBClient{}
+			dbClientMock.On("IntervalLastRun", testIntervalName).Return(testCase.lastRun, testCase.dbError)
+			m := testManager().(*manager)
+			m.config.History.Enabled = testCase.historyEnabled
+			m.dbClient = dbClientMock
+
+			err := m.AddInterval(interval)
+			require.NoError(t, err)
+			nextTime := m.intervalToExecutorMap[testIntervalName].NextTime
+			if testCase.expectedNext.IsZero() {
+				assert.True(t, nextTime.After(time.Now()))
+				return
+			}
+			assert.True(t, testCase.expectedNext.Equal(nextTime), "expected %v, got %v", testCase.expectedNext, nextTime)
+		})
+	}
+}
+
 func TestManager_UpdateInterval(t *testing.T) {
 	interval := intervalData()
 	m := testManager()
@@ -107,12 +148,12 @@ func TestManager_UpdateInterval(t *testing.T) {
 	invalidEndTime := intervalData()
 	invalidEndTime.End = "20060102T"
 	invalidInterval := intervalData()
-	invalidInterval.Interval = "10"
+	invalidInterval.Interval.Interval = "10"
 
 	tests := []struct {
 		name              string
 		manager           interfaces.SchedulerManager
-		interval          models.Interval
+		interval          pkgModels.Interval
 		expectedErrorKind errors.ErrKind
 	}{
 		{"valid", m, interval, ""},
@@ -133,6 +174,64 @@ func TestManager_UpdateInterval(t *testing.T) {
 	}
 }
 
+func TestManager_UpdateInterval_Requeue(t *testing.T) {
+	m := testManager()
+	once := intervalData()
+	once.Interval.Interval = ""
+	once.Start = "20000101T000000"
+	once.RunOnce = true
+	err := m.AddInterval(once)
+	require.NoError(t, err)
+	nextTime, err := m.IntervalNextTime(once.Name)
+	require.NoError(t, err)
+	assert.True(t, nextTime.IsZero())
+
+	once.Start = "22000101T000000"
+	err = m.UpdateInterval(once)
+	require.NoError(t, err)
+	nextTime, err = m.IntervalNextTime(once.Name)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2200, 1, 1, 0, 0, 0, 0, time.Local), nextTime)
+	assert.Equal(t, 1, m.(*manager).executorQueue.Length())
+}
+
+func TestManager_IntervalNextTime(t *testing.T) {
+	interval := intervalData()
+	m := testManager()
+	err := m.AddInterval(interval)
+	require.NoError(t, err)
+
+	nextTime, err := m.IntervalNextTime(interval.Name)
+	require.NoError(t, err)
+	assert.True(t, nextTime.After(time.Now()))
+
+	_, err = testManager().IntervalNextTime(interval.Name)
+	require.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+}
+
+func TestManager_IntervalNextTime_Executing(t *testing.T) {
+	interval := intervalData()
+	m := testManager().(*manager)
+	err := m.AddInterval(interval)
+	require.NoError(t, err)
+	executor := m.intervalToExecutorMap[interval.Name]
+	scheduled := executor.NextTime
+
+	// the next time is read by the API while the interval runs
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go m.execute(executor, scheduled, &wg)
+	for i := 0; i < 100; i++ {
+		_, err = m.IntervalNextTime(interval.Name)
+		require.NoError(t, err)
+	}
+	wg.Wait()
+
+	nextTime, err := m.IntervalNextTime(interval.Name)
+	require.NoError(t, err)
+	assert.Equal(t, scheduled.Add(10*time.Second), nextTime)
+}
+
 func TestManager_DeleteIntervalByName(t *testing.T) {
 	interval := intervalData()
 	m := testManager()
@@ -177,7 +276,7 @@ func TestManager_AddIntervalAction(t *testing.T) {
 	tests := []struct {
 		name              string
 		manager           interfaces.SchedulerManager
-		action            models.IntervalAction
+		action            pkgModels.IntervalAction
 		expectedErrorKind errors.ErrKind
 	}{
 		{"valid", m, action, ""},
@@ -213,7 +312,7 @@ func TestManager_UpdateIntervalAction(t *testing.T) {
 	tests := []struct {
 		name              string
 		manager           interfaces.SchedulerManager
-		action            models.IntervalAction
+		action            pkgModels.IntervalAction
 		expectedErrorKind errors.ErrKind
 	}{
 		{"valid", m, action, ""},
@@ -28,10 +28,47 @@ type ConfigurationStruct struct {
 	Registry        bootstrapConfig.RegistryInfo
 	Service         bootstrapConfig.ServiceInfo
 	MessageBus      bootstrapConfig.MessageBusInfo
+	Clients         bootstrapConfig.ClientsCollection
 	Intervals       map[string]IntervalInfo
 	IntervalActions map[string]IntervalActionInfo
 	// ScheduleIntervalTime is a time(Millisecond) to create a ticker to delay the scheduler loop
 	ScheduleIntervalTime int
+	History              HistoryInfo
+	Misfire              MisfireInfo
+	LeaderElection       LeaderElectionInfo
+}
+
+// HistoryInfo contains the configuration properties of the history of the interval action executions, which is kept in
+// the database
+type HistoryInfo struct {
+	Enabled bool
+	// MaxCount is the number of records kept for each interval, the records of the interval with the oldest scheduled
+	// times being deleted beyond it
+	MaxCount int
+}
+
+// MisfireInfo contains the configuration properties of the handling of the missed runs of the intervals, i.e. the
+// times an interval was due to run while the scheduler was down, or which were jumped over by the system clock
+type MisfireInfo struct {
+	// Threshold is how late a run may start before it is missed and handled by the misfire policy of the interval
+	Threshold string
+	// MaxCatchUp is the maximum number of missed runs of an interval the CATCH_UP_ALL misfire policy runs at once, the
+	// earliest missed runs being skipped beyond it
+	MaxCatchUp int
+}
+
+// LeaderElectionInfo contains the configuration properties of the election of a leader among the scheduler instances
+// sharing the database, only the leader triggering the intervals
+type LeaderElectionInfo struct {
+	Enabled bool
+	// InstanceId identifies the instance in the election, default to the hostname followed by a random suffix
+	InstanceId string
+	// LeaseDuration is how long the leader holds the leadership without renewing it, so that a standby instance takes
+	// over within LeaseDuration and RenewInterval of the leader failing
+	LeaseDuration string
+	// RenewInterval is how often the leader renews the leadership, and the standby instances try to take over, which
+	// must be shorter than LeaseDuration
+	RenewInterval string
 }
 
 type WritableInfo struct {
@@ -54,9 +91,15 @@ type IntervalInfo struct {
 	Cron string
 	// Boolean indicating that this schedules runs one time - at the time indicated by the start
 	RunOnce bool
+	// IANA name of the timezone of the start, end and cron schedule, e.g. America/New_York, default to the local time
+	Timezone string
+	// Policy applied to the missed runs, SKIP (default), FIRE_ONCE or CATCH_UP_ALL
+	MisfirePolicy string
 }
 
 type IntervalActionInfo struct {
+	// Type of the action, REST (default), MESSAGEBUS or DEVICECOMMAND
+	Type string
 	// Host is the hostname or IP address of a service.
 	Host string
 	// Port defines the port on which to access a given service
@@ -65,7 +108,7 @@ type IntervalActionInfo struct {
 	Protocol string
 	// Action name
 	Name string
-	// Action http method *const prob*
+	// Action http method *const prob*, or GET or SET for a DEVICECOMMAND action
 	Method string
 	// Action target parameters
 	Parameters string
@@ -83,6 +126,22 @@ type IntervalActionInfo struct {
 	AdminState string
 	// AuthMethod indicates how to authenticate the outbound URL -- "none" (default) or "jwt"
 	AuthMethod string
+	// Topic the MESSAGEBUS action publishes the Content to, relative to the base topic prefix
+	Topic string
+	// DeviceName and CommandName of the command issued by the DEVICECOMMAND action
+	DeviceName  string
+	CommandName string
+	// PushEvent pushes the event read by a DEVICECOMMAND GET action to the EdgeX system
+	PushEvent bool
+	// RetryCount is the number of times a failed action is retried, the backoff before each retry starting at
+	// RetryBackoff (default 1s) and doubling at each retry
+	RetryCount   int
+	RetryBackoff string
+	// Timeout of the REST and DEVICECOMMAND action requests, e.g. 30s, no timeout by default
+	Timeout string
+	// ExpectedStatusCodes are the status codes of the responses to the REST and DEVICECOMMAND actions for which the
+	// action succeeds, by default any status code below 400
+	ExpectedStatusCodes []int
 }
 
 const (
@@ -133,6 +192,7 @@ func (c *ConfigurationStruct) UpdateWritableFromRaw(rawWritable interface{}) boo
 func (c *ConfigurationStruct) GetBootstrap() bootstrapConfig.BootstrapConfiguration {
 	// temporary until we can make backwards-breaking configuration.yaml change
 	return bootstrapConfig.BootstrapConfiguration{
+		Clients:    &c.Clients,
 		Service:    &c.Service,
 		Registry:   &c.Registry,
 		MessageBus: &c.MessageBus,
@@ -12,7 +12,12 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos/requests"
+	responseDTO "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/support/scheduler/infrastructure/interfaces/mocks"
@@ -22,10 +27,7 @@ import (
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
-	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
 	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
-	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
-	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
 
@@ -104,6 +106,19 @@ func TestAddInterval(t *testing.T) {
 	noName.Interval.Name = ""
 	noRequestId := addIntervalRequestData()
 	noRequestId.RequestId = ""
+	intervalAndCron := addIntervalRequestData()
+	intervalAndCron.Interval.Cron = "0 6 * * MON-FRI"
+	invalidCron := addIntervalRequestData()
+	invalidCron.Interval.Interval = ""
+	invalidCron.Interval.Cron = "0 6 * *"
+	invalidTimezone := addIntervalRequestData()
+	invalidTimezone.Interval.Timezone = "Mars/Olympus_Mons"
+	invalidMisfirePolicy := addIntervalRequestData()
+	invalidMisfirePolicy.Interval.MisfirePolicy = "RETRY"
+	runOnceWithoutStart := addIntervalRequestData()
+	runOnceWithoutStart.Interval.Interval = ""
+	runOnceWithoutStart.Interval.Start = ""
+	runOnceWithoutStart.Interval.RunOnce = true
 
 	duplicatedName := addIntervalRequestData()
 	duplicatedName.Interval.Name = "duplicatedName"
@@ -128,6 +143,11 @@ func TestAddInterval(t *testing.T) {
 		{"Valid", []requests.AddIntervalRequest{valid}, http.StatusCreated},
 		{"Valid - no request Id", []requests.AddIntervalRequest{noRequestId}, http.StatusCreated},
 		{"Invalid - no name", []requests.AddIntervalRequest{noName}, http.StatusBadRequest},
+		{"Invalid - both interval and cron", []requests.AddIntervalRequest{intervalAndCron}, http.StatusBadRequest},
+		{"Invalid - cron expression", []requests.AddIntervalRequest{invalidCron}, http.StatusBadRequest},
+		{"Invalid - timezone", []requests.AddIntervalRequest{invalidTimezone}, http.StatusBadRequest},
+		{"Invalid - misfire policy", []requests.AddIntervalRequest{invalidMisfirePolicy}, http.StatusBadRequest},
+		{"Invalid - run once without start", []requests.AddIntervalRequest{runOnceWithoutStart}, http.StatusBadRequest},
 		{"Invalid - duplicated name", []requests.AddIntervalRequest{duplicatedName}, http.StatusConflict},
 	}
 	for _, testCase := range tests {
@@ -180,11 +200,17 @@ func TestIntervalByName(t *testing.T) {
 	dic := mockDic()
 	dbClientMock := &dbMock.DBClient{}
 	dbClientMock.On("IntervalByName", interval.Name).Return(interval, nil)
-	dbClientMock.On("IntervalByName", notFoundName).Return(models.Interval{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "interval doesn't exist in the database", nil))
+	dbClientMock.On("IntervalByName", notFoundName).Return(pkgModels.Interval{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "interval doesn't exist in the database", nil))
+	nextTime := time.Date(2200, 1, 1, 0, 0, 0, 0, time.UTC)
+	schedulerManagerMock := &dbMock.SchedulerManager{}
+	schedulerManagerMock.On("IntervalNextTime", interval.Name).Return(nextTime, nil)
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
 		},
+		container.SchedulerManagerName: func(get di.Get) interface{} {
+			return schedulerManagerMock
+		},
 	})
 
 	controller := NewIntervalController(dic)
@@ -232,6 +258,7 @@ func TestIntervalByName(t *testing.T) {
 				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
 				assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
 				assert.Equal(t, testCase.intervalName, res.Interval.Name, "Name not as expected")
+				assert.Equal(t, nextTime.UnixMilli(), res.Interval.NextTime, "NextTime not as expected")
 				assert.Empty(t, res.Message, "Message should be empty when it is successful")
 			}
 		})
@@ -243,8 +270,8 @@ func TestAllIntervals(t *testing.T) {
 	dic := mockDic()
 	dbClientMock := &dbMock.DBClient{}
 	dbClientMock.On("IntervalTotalCount").Return(expectedTotalIntervalCount, nil)
-	dbClientMock.On("AllIntervals", 0, 20).Return([]models.Interval{}, nil)
-	dbClientMock.On("AllIntervals", 0, 1).Return([]models.Interval{}, nil)
+	dbClientMock.On("AllIntervals", 0, 20).Return([]pkgModels.Interval{}, nil)
+	dbClientMock.On("AllIntervals", 0, 1).Return([]pkgModels.Interval{}, nil)
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -380,11 +407,11 @@ func TestPatchInterval(t *testing.T) {
 	dbClientMock := &dbMock.DBClient{}
 	schedulerManagerMock := &dbMock.SchedulerManager{}
 	testReq := updateIntervalRequestData()
-	model := models.Interval{
+	model := pkgModels.Interval{Interval: models.Interval{
 		Id:       *testReq.Interval.Id,
 		Name:     *testReq.Interval.Name,
 		Interval: *testReq.Interval.Interval,
-	}
+	}}
 
 	valid := testReq
 	dbClientMock.On("IntervalById", *valid.Interval.Id).Return(model, nil)
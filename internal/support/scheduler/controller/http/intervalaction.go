@@ -12,6 +12,8 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	requestDTO "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/requests"
+	responseDTO "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/application"
 	schedulerContainer "github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
@@ -20,8 +22,6 @@ import (
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
-	requestDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
-	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
 
 	"github.com/labstack/echo/v4"
 )
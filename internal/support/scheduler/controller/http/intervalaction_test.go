@@ -13,15 +13,18 @@ import (
 	"strings"
 	"testing"
 
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos/requests"
+	responseDTO "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/support/scheduler/infrastructure/interfaces/mocks"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
-	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
+	contractsDtos "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos"
 	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/common"
-	"github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/requests"
-	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v3/dtos/responses"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
 
@@ -30,17 +33,32 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func restAddressData() dtos.Address {
+	return dtos.Address{
+		Type:        common.REST,
+		Host:        TestHost,
+		Port:        TestPort,
+		RESTAddress: contractsDtos.RESTAddress{HTTPMethod: TestHTTPMethod},
+	}
+}
+
 func addIntervalActionRequestData() requests.AddIntervalActionRequest {
-	restAddress := dtos.NewRESTAddress(TestHost, TestPort, TestHTTPMethod)
-	intervalAction := dtos.NewIntervalAction(TestIntervalActionName, TestIntervalName, restAddress)
-	return requests.NewAddIntervalActionRequest(intervalAction)
+	return requests.AddIntervalActionRequest{
+		BaseRequest: commonDTO.NewBaseRequest(),
+		Action: dtos.IntervalAction{
+			Name:         TestIntervalActionName,
+			IntervalName: TestIntervalName,
+			Address:      restAddressData(),
+			AdminState:   models.Unlocked,
+		},
+	}
 }
 
 func updateIntervalActionRequestData() requests.UpdateIntervalActionRequest {
 	testUUID := ExampleUUID
 	testIntervalActionName := TestIntervalActionName
 	testIntervalName := TestIntervalName
-	restAddress := dtos.NewRESTAddress(TestHost, TestPort, TestHTTPMethod)
+	restAddress := restAddressData()
 	var req = requests.UpdateIntervalActionRequest{
 		BaseRequest: commonDTO.BaseRequest{
 			RequestId:   ExampleUUID,
@@ -64,9 +82,56 @@ func TestAddIntervalAction(t *testing.T) {
 
 	valid := addIntervalActionRequestData()
 	model := dtos.ToIntervalActionModel(valid.Action)
-	dbClientMock.On("IntervalByName", model.IntervalName).Return(models.Interval{}, nil)
+	dbClientMock.On("IntervalByName", model.IntervalName).Return(pkgModels.Interval{}, nil)
+	dbClientMock.On("AddIntervalAction", model).Return(model, nil)
+	schedulerManagerMock.On("AddIntervalAction", model).Return(nil)
+
+	messageBus := addIntervalActionRequestData()
+	messageBus.Action.Name = "messageBusAction"
+	messageBus.Action.Address = dtos.Address{Type: pkgCommon.MESSAGEBUS}
+	messageBus.Action.Address.Topic = "lights/on"
+	messageBus.Action.Content = `{"state":"on"}`
+	model = dtos.ToIntervalActionModel(messageBus.Action)
+	dbClientMock.On("AddIntervalAction", model).Return(model, nil)
+	schedulerManagerMock.On("AddIntervalAction", model).Return(nil)
+	deviceCommand := addIntervalActionRequestData()
+	deviceCommand.Action.Name = "deviceCommandAction"
+	deviceCommand.Action.Address = dtos.Address{
+		Type: pkgCommon.DEVICECOMMAND,
+		DeviceCommandAddress: dtos.DeviceCommandAddress{
+			DeviceName:  "thermostat",
+			CommandName: "setpoint",
+			Method:      pkgCommon.DeviceCommandSet,
+		},
+	}
+	deviceCommand.Action.Content = `{"setpoint":21.5}`
+	model = dtos.ToIntervalActionModel(deviceCommand.Action)
+	dbClientMock.On("AddIntervalAction", model).Return(model, nil)
+	schedulerManagerMock.On("AddIntervalAction", model).Return(nil)
+	noTopic := messageBus
+	noTopic.Action.Address = dtos.Address{Type: pkgCommon.MESSAGEBUS}
+	invalidSetContent := deviceCommand
+	invalidSetContent.Action.Content = "21.5"
+	invalidCommandMethod := deviceCommand
+	invalidCommandMethod.Action.Address.Method = "PUT"
+
+	retried := valid
+	retried.Action.Name = "retried"
+	retried.Action.RetryCount = 3
+	retried.Action.RetryBackoff = "10s"
+	retried.Action.Timeout = "30s"
+	retried.Action.ExpectedStatusCodes = []int{http.StatusAccepted, http.StatusNotFound}
+	model = dtos.ToIntervalActionModel(retried.Action)
 	dbClientMock.On("AddIntervalAction", model).Return(model, nil)
 	schedulerManagerMock.On("AddIntervalAction", model).Return(nil)
+	invalidRetryCount := retried
+	invalidRetryCount.Action.RetryCount = 11
+	invalidRetryBackoff := retried
+	invalidRetryBackoff.Action.RetryBackoff = "10"
+	invalidTimeout := retried
+	invalidTimeout.Action.Timeout = "-"
+	invalidExpectedStatusCode := retried
+	invalidExpectedStatusCode.Action.ExpectedStatusCodes = []int{http.StatusOK, 1000}
 
 	noName := valid
 	noName.Action.Name = ""
@@ -101,7 +166,17 @@ func TestAddIntervalAction(t *testing.T) {
 	}{
 		{"Valid", []requests.AddIntervalActionRequest{valid}, http.StatusCreated},
 		{"Valid - no request Id", []requests.AddIntervalActionRequest{noRequestId}, http.StatusCreated},
+		{"Valid - message bus", []requests.AddIntervalActionRequest{messageBus}, http.StatusCreated},
+		{"Valid - device command", []requests.AddIntervalActionRequest{deviceCommand}, http.StatusCreated},
+		{"Valid - retries", []requests.AddIntervalActionRequest{retried}, http.StatusCreated},
 		{"Invalid - no name", []requests.AddIntervalActionRequest{noName}, http.StatusBadRequest},
+		{"Invalid - message bus without topic", []requests.AddIntervalActionRequest{noTopic}, http.StatusBadRequest},
+		{"Invalid - device command SET content", []requests.AddIntervalActionRequest{invalidSetContent}, http.StatusBadRequest},
+		{"Invalid - device command method", []requests.AddIntervalActionRequest{invalidCommandMethod}, http.StatusBadRequest},
+		{"Invalid - retry count", []requests.AddIntervalActionRequest{invalidRetryCount}, http.StatusBadRequest},
+		{"Invalid - retry backoff", []requests.AddIntervalActionRequest{invalidRetryBackoff}, http.StatusBadRequest},
+		{"Invalid - timeout", []requests.AddIntervalActionRequest{invalidTimeout}, http.StatusBadRequest},
+		{"Invalid - expected status code", []requests.AddIntervalActionRequest{invalidExpectedStatusCode}, http.StatusBadRequest},
 		{"Invalid - duplicated name", []requests.AddIntervalActionRequest{duplicatedName}, http.StatusConflict},
 		{"Invalid - interval not found", []requests.AddIntervalActionRequest{invalidIntervalNotFound}, http.StatusNotFound},
 	}
@@ -149,8 +224,8 @@ func TestAllIntervalActions(t *testing.T) {
 	dic := mockDic()
 	dbClientMock := &dbMock.DBClient{}
 	dbClientMock.On("IntervalActionTotalCount").Return(expectedTotalIntervalActionCount, nil)
-	dbClientMock.On("AllIntervalActions", 0, 20).Return([]models.IntervalAction{}, nil)
-	dbClientMock.On("AllIntervalActions", 0, 1).Return([]models.IntervalAction{}, nil)
+	dbClientMock.On("AllIntervalActions", 0, 20).Return([]pkgModels.IntervalAction{}, nil)
+	dbClientMock.On("AllIntervalActions", 0, 1).Return([]pkgModels.IntervalAction{}, nil)
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -223,7 +298,7 @@ func TestIntervalActionByName(t *testing.T) {
 	dic := mockDic()
 	dbClientMock := &dbMock.DBClient{}
 	dbClientMock.On("IntervalActionByName", action.Name).Return(action, nil)
-	dbClientMock.On("IntervalActionByName", notFoundName).Return(models.IntervalAction{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "intervalAction doesn't exist in the database", nil))
+	dbClientMock.On("IntervalActionByName", notFoundName).Return(pkgModels.IntervalAction{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "intervalAction doesn't exist in the database", nil))
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -350,7 +425,7 @@ func TestPatchIntervalAction(t *testing.T) {
 	dbClientMock := &dbMock.DBClient{}
 	schedulerManagerMock := &dbMock.SchedulerManager{}
 	testReq := updateIntervalActionRequestData()
-	model := models.IntervalAction{
+	model := pkgModels.IntervalAction{
 		Id:           *testReq.Action.Id,
 		Name:         *testReq.Action.Name,
 		IntervalName: *testReq.Action.IntervalName,
@@ -359,7 +434,7 @@ func TestPatchIntervalAction(t *testing.T) {
 
 	valid := testReq
 	dbClientMock.On("IntervalActionById", *valid.Action.Id).Return(model, nil)
-	dbClientMock.On("IntervalByName", *valid.Action.IntervalName).Return(models.Interval{}, nil)
+	dbClientMock.On("IntervalByName", *valid.Action.IntervalName).Return(pkgModels.Interval{}, nil)
 	dbClientMock.On("UpdateIntervalAction", model).Return(nil)
 	schedulerManagerMock.On("UpdateIntervalAction", model).Return(nil)
 	validWithNoReqID := testReq
@@ -402,7 +477,7 @@ func TestPatchIntervalAction(t *testing.T) {
 
 	intervalNotFoundName := "intervalNotFoundName"
 	intervalNotFoundNameError := errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("%s doesn't exist in the database", intervalNotFoundName), nil)
-	dbClientMock.On("IntervalByName", intervalNotFoundName).Return(models.Interval{}, intervalNotFoundNameError)
+	dbClientMock.On("IntervalByName", intervalNotFoundName).Return(pkgModels.Interval{}, intervalNotFoundNameError)
 	invalidIntervalNotFound := testReq
 	invalidIntervalNotFound.Action.IntervalName = &intervalNotFoundName
 	invalidIntervalNotFoundModel := model
@@ -0,0 +1,70 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	responseDTO "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/application"
+	schedulerContainer "github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AllIntervalActionRecords returns the execution records of all interval actions, or of the interval action specified
+// by name
+func (ic *IntervalActionController) AllIntervalActionRecords(c echo.Context) error {
+	lc := container.LoggingClientFrom(ic.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	config := schedulerContainer.ConfigurationFrom(ic.dic.Get)
+
+	// parse URL query string for offset, limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(c, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	records, totalCount, err := application.IntervalActionRecordsByTimeRange(c.Param(common.Name), 0, math.MaxInt, offset, limit, ic.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responseDTO.NewMultiIntervalActionRecordsResponse("", "", http.StatusOK, totalCount, records)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	// encode and send out the response
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// IntervalActionRecordsByTimeRange returns the execution records of all interval actions, or of the interval action
+// specified by name, scheduled within the time range
+func (ic *IntervalActionController) IntervalActionRecordsByTimeRange(c echo.Context) error {
+	lc := container.LoggingClientFrom(ic.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	config := schedulerContainer.ConfigurationFrom(ic.dic.Get)
+
+	// parse time range (start, end), offset, and limit from incoming request
+	start, end, offset, limit, err := utils.ParseTimeRangeOffsetLimit(c, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	records, totalCount, err := application.IntervalActionRecordsByTimeRange(c.Param(common.Name), start, end, offset, limit, ic.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responseDTO.NewMultiIntervalActionRecordsResponse("", "", http.StatusOK, totalCount, records)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	// encode and send out the response
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
@@ -0,0 +1,138 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	responseDTO "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/scheduler/infrastructure/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockIntervalActionRecordDic() *di.Container {
+	records := []pkgModels.IntervalActionRecord{{Id: ExampleUUID, ActionName: TestIntervalActionName, IntervalName: TestIntervalName,
+		Status: pkgModels.IntervalActionRecordSucceeded, StatusCode: http.StatusOK}}
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("IntervalActionRecordsByTimeRange", "", 0, math.MaxInt, 0, 20).Return(records, uint32(1), nil)
+	dbClientMock.On("IntervalActionRecordsByTimeRange", TestIntervalActionName, 0, math.MaxInt, 0, 1).Return(records, uint32(1), nil)
+	dbClientMock.On("IntervalActionRecordsByTimeRange", TestIntervalActionName, 1000, 2000, 0, 20).Return(records, uint32(1), nil)
+
+	dic := mockDic()
+	container.ConfigurationFrom(dic.Get).History.Enabled = true
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	return dic
+}
+
+func TestAllIntervalActionRecords(t *testing.T) {
+	dic := mockIntervalActionRecordDic()
+
+	tests := []struct {
+		name               string
+		actionName         string
+		limit              string
+		dic                *di.Container
+		errorExpected      bool
+		expectedStatusCode int
+	}{
+		{"Valid - all interval actions", "", "", dic, false, http.StatusOK},
+		{"Valid - by action name", TestIntervalActionName, "1", dic, false, http.StatusOK},
+		{"Invalid - invalid limit", "", "invalid", dic, true, http.StatusBadRequest},
+		{"Unavailable - history disabled", "", "", mockDic(), true, http.StatusServiceUnavailable},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, pkgCommon.ApiAllIntervalActionRecordRoute, http.NoBody)
+			query := req.URL.Query()
+			if testCase.limit != "" {
+				query.Add(common.Limit, testCase.limit)
+			}
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(testCase.actionName)
+			err := NewIntervalActionController(testCase.dic).AllIntervalActionRecords(c)
+			require.NoError(t, err)
+
+			// Assert
+			var res responseDTO.MultiIntervalActionRecordsResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.errorExpected {
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				return
+			}
+			assert.Equal(t, uint32(1), res.TotalCount)
+			require.Len(t, res.Records, 1)
+			assert.Equal(t, ExampleUUID, res.Records[0].Id)
+			assert.Equal(t, pkgModels.IntervalActionRecordSucceeded, res.Records[0].Status)
+		})
+	}
+}
+
+func TestIntervalActionRecordsByTimeRange(t *testing.T) {
+	dic := mockIntervalActionRecordDic()
+
+	tests := []struct {
+		name               string
+		start              string
+		end                string
+		errorExpected      bool
+		expectedStatusCode int
+	}{
+		{"Valid - by time range", "1000", "2000", false, http.StatusOK},
+		{"Invalid - invalid start", "start", "2000", true, http.StatusBadRequest},
+		{"Invalid - end before start", "2000", "1000", true, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, pkgCommon.ApiIntervalActionRecordByActionNameAndTimeRangeRoute, http.NoBody)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name, common.Start, common.End)
+			c.SetParamValues(TestIntervalActionName, testCase.start, testCase.end)
+			err := NewIntervalActionController(dic).IntervalActionRecordsByTimeRange(c)
+			require.NoError(t, err)
+
+			// Assert
+			var res responseDTO.MultiIntervalActionRecordsResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.errorExpected {
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+				return
+			}
+			assert.Equal(t, uint32(1), res.TotalCount)
+			require.Len(t, res.Records, 1)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/dtos"
+	responseDTO "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+	schedulerContainer "github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/infrastructure/interfaces"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+
+	"github.com/labstack/echo/v4"
+)
+
+// PingController replaces the ping endpoint of the common controller, to report the leadership of the instance when
+// the leader election is enabled
+type PingController struct {
+	dic         *di.Container
+	serviceName string
+}
+
+// NewPingController creates and initializes a PingController
+func NewPingController(dic *di.Container, serviceName string) *PingController {
+	return &PingController{
+		dic:         dic,
+		serviceName: serviceName,
+	}
+}
+
+// Ping handles the request to the ping endpoint, which tests that the service is working.  A standby instance is
+// working as well as the leader.
+func (pc *PingController) Ping(c echo.Context) error {
+	lc := container.LoggingClientFrom(pc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	var leadership *dtos.Leadership
+	// The scheduler manager is created after the routes are loaded
+	if manager, ok := pc.dic.Get(schedulerContainer.SchedulerManagerName).(interfaces.SchedulerManager); ok {
+		if l := manager.Leadership(); l.Enabled {
+			dto := dtos.FromLeadershipModelToDTO(l)
+			leadership = &dto
+		}
+	}
+	response := responseDTO.NewPingResponse(pc.serviceName, leadership)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	// encode and send out the response
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
@@ -0,0 +1,75 @@
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	responseDTO "github.com/edgexfoundry/edgex-go/internal/pkg/dtos/responses"
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/infrastructure/interfaces/mocks"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPing(t *testing.T) {
+	serviceName := "support-scheduler"
+	standby := pkgModels.Leadership{Enabled: true, State: pkgModels.LeadershipStandby, InstanceId: "scheduler-2", LeaderId: "scheduler-1"}
+
+	tests := []struct {
+		name               string
+		leadership         *pkgModels.Leadership
+		expectedLeadership bool
+	}{
+		{"leader election disabled", &pkgModels.Leadership{}, false},
+		{"standby", &standby, true},
+		{"no scheduler manager yet", nil, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dic := mockDic()
+			if testCase.leadership != nil {
+				schedulerManagerMock := &mocks.SchedulerManager{}
+				schedulerManagerMock.On("Leadership").Return(*testCase.leadership)
+				dic.Update(di.ServiceConstructorMap{
+					container.SchedulerManagerName: func(get di.Get) interface{} {
+						return schedulerManagerMock
+					},
+				})
+			}
+			controller := NewPingController(dic, serviceName)
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, common.ApiPingRoute, http.NoBody)
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err := controller.Ping(c)
+			require.NoError(t, err)
+
+			var res responseDTO.PingResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, recorder.Result().StatusCode)
+			assert.Equal(t, serviceName, res.ServiceName)
+			assert.Equal(t, common.ApiVersion, res.ApiVersion)
+			if !testCase.expectedLeadership {
+				assert.Nil(t, res.Leadership)
+				return
+			}
+			require.NotNil(t, res.Leadership)
+			assert.Equal(t, pkgModels.LeadershipStandby, res.Leadership.State)
+			assert.Equal(t, "scheduler-2", res.Leadership.InstanceId)
+			assert.Equal(t, "scheduler-1", res.Leadership.LeaderId)
+		})
+	}
+}
@@ -6,19 +6,27 @@
 package interfaces
 
 import (
+	"time"
+
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
-	"github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 )
 
 type SchedulerManager interface {
 	StartTicker()
 	StopTicker()
 
-	AddInterval(interval models.Interval) errors.EdgeX
-	UpdateInterval(interval models.Interval) errors.EdgeX
+	AddInterval(interval pkgModels.Interval) errors.EdgeX
+	UpdateInterval(interval pkgModels.Interval) errors.EdgeX
 	DeleteIntervalByName(name string) errors.EdgeX
+	// IntervalNextTime returns the time the interval will next run, or the zero time if the interval will not run again
+	IntervalNextTime(name string) (time.Time, errors.EdgeX)
 
-	AddIntervalAction(intervalAction models.IntervalAction) errors.EdgeX
-	UpdateIntervalAction(intervalAction models.IntervalAction) errors.EdgeX
+	AddIntervalAction(intervalAction pkgModels.IntervalAction) errors.EdgeX
+	UpdateIntervalAction(intervalAction pkgModels.IntervalAction) errors.EdgeX
 	DeleteIntervalActionByName(name string) errors.EdgeX
+
+	// Leadership returns the state of the instance in the leader election, which is not enabled by default
+	Leadership() pkgModels.Leadership
 }
@@ -6,27 +6,39 @@
 package interfaces
 
 import (
+	"time"
+
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/errors"
-	model "github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+
+	pkgModels "github.com/edgexfoundry/edgex-go/internal/pkg/models"
 )
 
 type DBClient interface {
 	CloseSession()
 
-	AddInterval(interval model.Interval) (model.Interval, errors.EdgeX)
-	IntervalById(id string) (model.Interval, errors.EdgeX)
-	IntervalByName(name string) (model.Interval, errors.EdgeX)
-	AllIntervals(offset int, limit int) ([]model.Interval, errors.EdgeX)
+	AddInterval(interval pkgModels.Interval) (pkgModels.Interval, errors.EdgeX)
+	IntervalById(id string) (pkgModels.Interval, errors.EdgeX)
+	IntervalByName(name string) (pkgModels.Interval, errors.EdgeX)
+	AllIntervals(offset int, limit int) ([]pkgModels.Interval, errors.EdgeX)
 	DeleteIntervalByName(name string) errors.EdgeX
-	UpdateInterval(interval model.Interval) errors.EdgeX
+	UpdateInterval(interval pkgModels.Interval) errors.EdgeX
 	IntervalTotalCount() (uint32, errors.EdgeX)
 
-	AddIntervalAction(e model.IntervalAction) (model.IntervalAction, errors.EdgeX)
-	AllIntervalActions(offset int, limit int) ([]model.IntervalAction, errors.EdgeX)
-	IntervalActionByName(name string) (model.IntervalAction, errors.EdgeX)
-	IntervalActionsByIntervalName(offset int, limit int, IntervalName string) ([]model.IntervalAction, errors.EdgeX)
+	AddIntervalAction(e pkgModels.IntervalAction) (pkgModels.IntervalAction, errors.EdgeX)
+	AllIntervalActions(offset int, limit int) ([]pkgModels.IntervalAction, errors.EdgeX)
+	IntervalActionByName(name string) (pkgModels.IntervalAction, errors.EdgeX)
+	IntervalActionsByIntervalName(offset int, limit int, IntervalName string) ([]pkgModels.IntervalAction, errors.EdgeX)
 	DeleteIntervalActionByName(name string) errors.EdgeX
-	IntervalActionById(id string) (model.IntervalAction, errors.EdgeX)
-	UpdateIntervalAction(action model.IntervalAction) errors.EdgeX
+	IntervalActionById(id string) (pkgModels.IntervalAction, errors.EdgeX)
+	UpdateIntervalAction(action pkgModels.IntervalAction) errors.EdgeX
 	IntervalActionTotalCount() (uint32, errors.EdgeX)
+
+	AddIntervalActionRecord(r pkgModels.IntervalActionRecord) (pkgModels.IntervalActionRecord, errors.EdgeX)
+	IntervalActionRecordsByTimeRange(actionName string, start int, end int, offset int, limit int) ([]pkgModels.IntervalActionRecord, uint32, errors.EdgeX)
+	TrimIntervalActionRecords(intervalName string, maxCount int) errors.EdgeX
+	IntervalLastRun(intervalName string) (int64, errors.EdgeX)
+	UpdateIntervalLastRun(intervalName string, scheduledTime int64) errors.EdgeX
+
+	AcquireLease(name string, holder string, duration time.Duration) (string, errors.EdgeX)
+	ReleaseLease(name string, holder string) errors.EdgeX
 }
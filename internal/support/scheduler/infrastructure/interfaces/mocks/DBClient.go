@@ -7,7 +7,9 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
-	models "github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	models "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	time "time"
 )
 
 // DBClient is an autogenerated mock type for the DBClient type
@@ -15,6 +17,29 @@ type DBClient struct {
 	mock.Mock
 }
 
+// AcquireLease provides a mock function with given fields: name, holder, duration
+func (_m *DBClient) AcquireLease(name string, holder string, duration time.Duration) (string, errors.EdgeX) {
+	ret := _m.Called(name, holder, duration)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string, time.Duration) string); ok {
+		r0 = rf(name, holder, duration)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string, string, time.Duration) errors.EdgeX); ok {
+		r1 = rf(name, holder, duration)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AddInterval provides a mock function with given fields: interval
 func (_m *DBClient) AddInterval(interval models.Interval) (models.Interval, errors.EdgeX) {
 	ret := _m.Called(interval)
@@ -61,6 +86,29 @@ func (_m *DBClient) AddIntervalAction(e models.IntervalAction) (models.IntervalA
 	return r0, r1
 }
 
+// AddIntervalActionRecord provides a mock function with given fields: r
+func (_m *DBClient) AddIntervalActionRecord(r models.IntervalActionRecord) (models.IntervalActionRecord, errors.EdgeX) {
+	ret := _m.Called(r)
+
+	var r0 models.IntervalActionRecord
+	if rf, ok := ret.Get(0).(func(models.IntervalActionRecord) models.IntervalActionRecord); ok {
+		r0 = rf(r)
+	} else {
+		r0 = ret.Get(0).(models.IntervalActionRecord)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(models.IntervalActionRecord) errors.EdgeX); ok {
+		r1 = rf(r)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AllIntervalActions provides a mock function with given fields: offset, limit
 func (_m *DBClient) AllIntervalActions(offset int, limit int) ([]models.IntervalAction, errors.EdgeX) {
 	ret := _m.Called(offset, limit)
@@ -194,6 +242,38 @@ func (_m *DBClient) IntervalActionByName(name string) (models.IntervalAction, er
 	return r0, r1
 }
 
+// IntervalActionRecordsByTimeRange provides a mock function with given fields: actionName, start, end, offset, limit
+func (_m *DBClient) IntervalActionRecordsByTimeRange(actionName string, start int, end int, offset int, limit int) ([]models.IntervalActionRecord, uint32, errors.EdgeX) {
+	ret := _m.Called(actionName, start, end, offset, limit)
+
+	var r0 []models.IntervalActionRecord
+	if rf, ok := ret.Get(0).(func(string, int, int, int, int) []models.IntervalActionRecord); ok {
+		r0 = rf(actionName, start, end, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.IntervalActionRecord)
+		}
+	}
+
+	var r1 uint32
+	if rf, ok := ret.Get(1).(func(string, int, int, int, int) uint32); ok {
+		r1 = rf(actionName, start, end, offset, limit)
+	} else {
+		r1 = ret.Get(1).(uint32)
+	}
+
+	var r2 errors.EdgeX
+	if rf, ok := ret.Get(2).(func(string, int, int, int, int) errors.EdgeX); ok {
+		r2 = rf(actionName, start, end, offset, limit)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1, r2
+}
+
 // IntervalActionTotalCount provides a mock function with given fields:
 func (_m *DBClient) IntervalActionTotalCount() (uint32, errors.EdgeX) {
 	ret := _m.Called()
@@ -288,6 +368,29 @@ func (_m *DBClient) IntervalByName(name string) (models.Interval, errors.EdgeX)
 	return r0, r1
 }
 
+// IntervalLastRun provides a mock function with given fields: intervalName
+func (_m *DBClient) IntervalLastRun(intervalName string) (int64, errors.EdgeX) {
+	ret := _m.Called(intervalName)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(intervalName)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(intervalName)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // IntervalTotalCount provides a mock function with given fields:
 func (_m *DBClient) IntervalTotalCount() (uint32, errors.EdgeX) {
 	ret := _m.Called()
@@ -311,6 +414,38 @@ func (_m *DBClient) IntervalTotalCount() (uint32, errors.EdgeX) {
 	return r0, r1
 }
 
+// ReleaseLease provides a mock function with given fields: name, holder
+func (_m *DBClient) ReleaseLease(name string, holder string) errors.EdgeX {
+	ret := _m.Called(name, holder)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, string) errors.EdgeX); ok {
+		r0 = rf(name, holder)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// TrimIntervalActionRecords provides a mock function with given fields: intervalName, maxCount
+func (_m *DBClient) TrimIntervalActionRecords(intervalName string, maxCount int) errors.EdgeX {
+	ret := _m.Called(intervalName, maxCount)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, int) errors.EdgeX); ok {
+		r0 = rf(intervalName, maxCount)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // UpdateInterval provides a mock function with given fields: interval
 func (_m *DBClient) UpdateInterval(interval models.Interval) errors.EdgeX {
 	ret := _m.Called(interval)
@@ -348,6 +483,22 @@ type mockConstructorTestingTNewDBClient interface {
 	Cleanup(func())
 }
 
+// UpdateIntervalLastRun provides a mock function with given fields: intervalName, scheduledTime
+func (_m *DBClient) UpdateIntervalLastRun(intervalName string, scheduledTime int64) errors.EdgeX {
+	ret := _m.Called(intervalName, scheduledTime)
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, int64) errors.EdgeX); ok {
+		r0 = rf(intervalName, scheduledTime)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // NewDBClient creates a new instance of DBClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 func NewDBClient(t mockConstructorTestingTNewDBClient) *DBClient {
 	mock := &DBClient{}
@@ -7,7 +7,9 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
-	models "github.com/edgexfoundry/go-mod-core-contracts/v3/models"
+	models "github.com/edgexfoundry/edgex-go/internal/pkg/models"
+
+	time "time"
 )
 
 // SchedulerManager is an autogenerated mock type for the SchedulerManager type
@@ -79,6 +81,43 @@ func (_m *SchedulerManager) DeleteIntervalByName(name string) errors.EdgeX {
 	return r0
 }
 
+// IntervalNextTime provides a mock function with given fields: name
+func (_m *SchedulerManager) IntervalNextTime(name string) (time.Time, errors.EdgeX) {
+	ret := _m.Called(name)
+
+	var r0 time.Time
+	if rf, ok := ret.Get(0).(func(string) time.Time); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// Leadership provides a mock function with given fields:
+func (_m *SchedulerManager) Leadership() models.Leadership {
+	ret := _m.Called()
+
+	var r0 models.Leadership
+	if rf, ok := ret.Get(0).(func() models.Leadership); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(models.Leadership)
+	}
+
+	return r0
+}
+
 // StartTicker provides a mock function with given fields:
 func (_m *SchedulerManager) StartTicker() {
 	_m.Called()
@@ -52,7 +52,12 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _
 	secretProvider := bootstrapContainer.SecretProviderExtFrom(dic.Get)
 	configuration := container.ConfigurationFrom(dic.Get)
 
-	schedulerManager := scheduler.NewManager(lc, configuration, secretProvider)
+	messagingClient := bootstrapContainer.MessagingClientFrom(dic.Get)
+	commandClient := bootstrapContainer.CommandClientFrom(dic.Get)
+
+	dbClient := container.DBClientFrom(dic.Get)
+
+	schedulerManager := scheduler.NewManager(lc, configuration, secretProvider, dbClient, messagingClient, commandClient)
 	dic.Update(di.ServiceConstructorMap{
 		container.SchedulerManagerName: func(get di.Get) interface{} {
 			return schedulerManager
@@ -73,6 +73,7 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *echo.Echo) {
 		[]interfaces.BootstrapHandler{
 			pkgHandlers.NewDatabase(httpServer, configuration, container.DBClientInterfaceName).BootstrapHandler, // add db client bootstrap handler
 			handlers.MessagingBootstrapHandler,
+			handlers.NewClientsBootstrap().BootstrapHandler,                                // Must be after Messaging, the core-command client may use it
 			handlers.NewServiceMetrics(common.SupportSchedulerServiceKey).BootstrapHandler, // Must be after Messaging
 			NewBootstrap(router, common.SupportSchedulerServiceKey).BootstrapHandler,
 			httpServer.BootstrapHandler,
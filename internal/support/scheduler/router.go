@@ -13,6 +13,7 @@ import (
 	"github.com/edgexfoundry/go-mod-bootstrap/v3/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v3/common"
 
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	schedulerController "github.com/edgexfoundry/edgex-go/internal/support/scheduler/controller/http"
 
 	"github.com/labstack/echo/v4"
@@ -25,6 +26,9 @@ func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 
 	// Common
 	_ = controller.NewCommonController(dic, r, serviceName, edgex.Version)
+	// Replace the common ping to report the leadership of the instance
+	ping := schedulerController.NewPingController(dic, serviceName)
+	r.GET(common.ApiPingRoute, ping.Ping) // Health check is always unauthenticated
 
 	// Interval
 	interval := schedulerController.NewIntervalController(dic)
@@ -41,4 +45,10 @@ func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 	r.GET(common.ApiIntervalActionByNameEchoRoute, action.IntervalActionByName, authenticationHook)
 	r.DELETE(common.ApiIntervalActionByNameEchoRoute, action.DeleteIntervalActionByName, authenticationHook)
 	r.PATCH(common.ApiIntervalActionRoute, action.PatchIntervalAction, authenticationHook)
+
+	// IntervalAction records
+	r.GET(pkgCommon.ApiAllIntervalActionRecordRoute, action.AllIntervalActionRecords, authenticationHook)
+	r.GET(pkgCommon.ApiIntervalActionRecordByTimeRangeEchoRoute, action.IntervalActionRecordsByTimeRange, authenticationHook)
+	r.GET(pkgCommon.ApiIntervalActionRecordByActionNameEchoRoute, action.AllIntervalActionRecords, authenticationHook)
+	r.GET(pkgCommon.ApiIntervalActionRecordByActionNameAndTimeRangeEchoRoute, action.IntervalActionRecordsByTimeRange, authenticationHook)
 }